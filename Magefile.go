@@ -24,7 +24,7 @@ func GoGen() error {
 
 // Run launches the service
 func Run() error {
-	return sh.RunV("go", "run", "cmd/district/main.go")
+	return sh.RunV("go", "run", "./cmd/district", "serve")
 }
 
 // Dev launches the service using the local kubernetes config
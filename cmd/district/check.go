@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/girlguidingstaplehurst/district/internal/config"
+	"github.com/girlguidingstaplehurst/district/internal/content"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+)
+
+func checkConfig(ctx context.Context, configPath string, args []string) error {
+	if len(args) > 0 {
+		return errUsage
+	}
+
+	effective, err := config.Effective(configPath)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stdout.Write(effective); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	return nil
+}
+
+func checkContent(ctx context.Context, configPath string, args []string) error {
+	if len(args) > 0 {
+		return errUsage
+	}
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	if err := required(map[string]string{"content.url": cfg.Content.URL, "content.token": cfg.Content.Token}); err != nil {
+		return err
+	}
+
+	cm := content.NewManager(cfg.Content.URL, cfg.Content.Token)
+
+	var errs []error
+	for _, key := range rest.EmailTemplates {
+		if err := cm.CheckEmail(ctx, key); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+
+		fmt.Printf("ok      %s\n", key)
+	}
+
+	return errors.Join(errs...)
+}
+
+func loadConfig(configPath string) (*config.Config, error) {
+	cfg := new(config.Config)
+	if err := config.Load(cfg, configPath); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// required checks the settings a command needs, for those that don't need the whole config to be valid.
+func required(settings map[string]string) error {
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(settings)) {
+		if settings[key] == "" {
+			errs = append(errs, fmt.Errorf("%s is required, set it with %s", key, config.EnvVar(key)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/database"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// grantCommissioner makes someone a district commissioner. Roles are otherwise only given through the admin API, which
+// needs a district commissioner, so a new database needs its first one added this way.
+func grantCommissioner(ctx context.Context, configPath string, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	if err := required(map[string]string{"database.url": cfg.Database.URL}); err != nil {
+		return err
+	}
+
+	pool, err := database.NewPool(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	role := rest.RoleAssignmentInput{Email: openapi_types.Email(args[0]), Role: rest.DistrictCommissioner}
+
+	_, err = database.NewDatabase(pool).AddRoleAssignment(ctx, role, "district grant-commissioner")
+	switch {
+	case errors.Is(err, consts.ErrConflict):
+		fmt.Printf("%s is already a district commissioner\n", args[0])
+		return nil
+	case err != nil:
+		return fmt.Errorf("granting role: %w", err)
+	}
+
+	fmt.Printf("%s is now a district commissioner\n", args[0])
+	return nil
+}
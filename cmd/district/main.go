@@ -2,16 +2,103 @@ package main
 
 import (
 	"context"
-	"log/slog"
-
-	"github.com/girlguidingstaplehurst/district/internal/service"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
 )
 
+// command is one of district's subcommands.
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(ctx context.Context, configPath string, args []string) error
+}
+
+var commands = []command{
+	{"serve", "", "run the service (the default)", serve},
+	{"migrate", "up|down|status", "apply, roll back or list the database migrations", migrate},
+	{"check-config", "", "print the effective config, with secrets redacted, and check it's valid", checkConfig},
+	{"check-content", "", "check the Contentful email templates exist and parse", checkContent},
+	{"seed", "", "load demo data into the database", seed},
+	{"grant-commissioner", "email", "make someone a district commissioner, such as the first on a new database", grantCommissioner},
+	{"version", "", "print the version and build info", version},
+}
+
+// errUsage is returned by commands given the wrong arguments.
+var errUsage = errors.New("invalid arguments")
+
 func main() {
-	svc := service.NewService()
+	// Kubernetes sends SIGTERM to stop the pod, and Ctrl+C sends SIGINT, either of which ends the context so the
+	// command can finish what it's doing.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	go func() {
+		// A second signal kills the command without waiting.
+		<-ctx.Done()
+		stop()
+	}()
+
+	code := run(ctx, os.Args[1:])
+	stop()
+	os.Exit(code)
+}
+
+// run runs the command named in args, returning the exit code: 0 on success, 1 if it failed, and 2 if it was used
+// wrongly.
+func run(ctx context.Context, args []string) int {
+	flags := flag.NewFlagSet("district", flag.ContinueOnError)
+	configPath := flags.String("config", "", "YAML file to load over the default config, instead of the one named by BOOKING_CONFIG")
+	flags.Usage = func() { usage(flags) }
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
 
-	err := svc.Run(context.Background())
-	if err != nil {
-		slog.Error("error running service", "err", err)
+	name, args := "serve", flags.Args()
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
 	}
+
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+
+		err := cmd.run(ctx, *configPath, args)
+		switch {
+		case errors.Is(err, errUsage):
+			fmt.Fprintf(os.Stderr, "usage: district [-config file] %s %s\n", cmd.name, cmd.args)
+			return 2
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "district %s: %v\n", cmd.name, err)
+			return 1
+		}
+
+		return 0
+	}
+
+	fmt.Fprintf(os.Stderr, "district: unknown command %q\n\n", name)
+	usage(flags)
+	return 2
+}
+
+func usage(flags *flag.FlagSet) {
+	out := flags.Output()
+	fmt.Fprintf(out, "usage: district [-config file] [command]\n\ncommands:\n")
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %s %s\t%s\n", cmd.name, cmd.args, cmd.summary)
+	}
+	w.Flush()
+
+	fmt.Fprintf(out, "\nflags:\n")
+	flags.PrintDefaults()
 }
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/girlguidingstaplehurst/district/db"
+	"github.com/girlguidingstaplehurst/district/internal/database"
+)
+
+func migrate(ctx context.Context, configPath string, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	if err := required(map[string]string{"database.url": cfg.Database.URL}); err != nil {
+		return err
+	}
+
+	pool, err := database.NewPool(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	migrator, err := database.NewMigrator(pool, db.Migrations)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied      %06d_%s\n", m.Version, m.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("already up to date")
+		}
+		return err
+	case "down":
+		m, ok, err := migrator.Down(ctx)
+		if ok {
+			fmt.Printf("rolled back  %06d_%s\n", m.Version, m.Name)
+		}
+		if err == nil && !ok {
+			fmt.Println("nothing to roll back")
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !slices.ContainsFunc(statuses, func(s database.MigrationStatus) bool { return s.Applied }) {
+			fmt.Println("no migrations applied")
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = "applied"
+			}
+			fmt.Fprintf(w, "%06d_%s\t%s\n", s.Version, s.Name, state)
+		}
+		return w.Flush()
+	default:
+		return errUsage
+	}
+}
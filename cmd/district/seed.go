@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/girlguidingstaplehurst/district/db"
+	"github.com/girlguidingstaplehurst/district/internal/database"
+)
+
+func seed(ctx context.Context, configPath string, args []string) error {
+	if len(args) > 0 {
+		return errUsage
+	}
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	if err := required(map[string]string{"database.url": cfg.Database.URL}); err != nil {
+		return err
+	}
+
+	pool, err := database.NewPool(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	if _, err := pool.Exec(ctx, db.Seed); err != nil {
+		return fmt.Errorf("loading demo data: %w", err)
+	}
+
+	fmt.Println("demo data loaded")
+	return nil
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"context"
+
+	"github.com/girlguidingstaplehurst/district/internal/service"
+)
+
+func serve(ctx context.Context, configPath string, args []string) error {
+	if len(args) > 0 {
+		return errUsage
+	}
+
+	return service.NewService(configPath).Run(ctx)
+}
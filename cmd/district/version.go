@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/girlguidingstaplehurst/district/internal/config"
+)
+
+func version(ctx context.Context, configPath string, args []string) error {
+	if len(args) > 0 {
+		return errUsage
+	}
+
+	fmt.Printf("district %s\n", config.Version)
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil
+	}
+
+	fmt.Printf("go        %s\n", info.GoVersion)
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			fmt.Printf("revision  %s\n", setting.Value)
+		case "vcs.time":
+			fmt.Printf("built     %s\n", setting.Value)
+		case "vcs.modified":
+			fmt.Printf("modified  %s\n", setting.Value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+// Package db holds the SQL the service runs against its database.
+package db
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrations are the schema migrations, named like 000001_create_events.up.sql and 000001_create_events.down.sql.
+var Migrations, _ = fs.Sub(migrations, "migrations")
+
+// Seed is demo data for local and review environments. It can be loaded more than once, and never into production.
+//
+//go:embed seed.sql
+var Seed string
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS events
+(
+    id          uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    title       text        NOT NULL,
+    description text,
+    location    text,
+    unit        text,
+    starts_at   timestamptz NOT NULL,
+    ends_at     timestamptz NOT NULL,
+    status      text        NOT NULL,
+    created_by  text        NOT NULL,
+    created_at  timestamptz NOT NULL DEFAULT now(),
+    updated_at  timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT events_ends_after_starts CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS events_starts_at_idx ON events (starts_at);
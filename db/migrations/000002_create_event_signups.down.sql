@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS event_signups;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS signups_enabled,
+    DROP COLUMN IF EXISTS signup_capacity,
+    DROP COLUMN IF EXISTS signup_deadline,
+    DROP COLUMN IF EXISTS signup_sections,
+    DROP COLUMN IF EXISTS signup_min_age,
+    DROP COLUMN IF EXISTS signup_max_age;
@@ -0,0 +1,27 @@
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS signups_enabled boolean NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS signup_capacity integer,
+    ADD COLUMN IF NOT EXISTS signup_deadline timestamptz,
+    ADD COLUMN IF NOT EXISTS signup_sections text[]  NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS signup_min_age  integer,
+    ADD COLUMN IF NOT EXISTS signup_max_age  integer;
+
+CREATE TABLE IF NOT EXISTS event_signups
+(
+    id                uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    event_id          uuid        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    participant_name  text        NOT NULL,
+    date_of_birth     date        NOT NULL,
+    section           text        NOT NULL,
+    unit              text,
+    contact_name      text        NOT NULL,
+    contact_email     text        NOT NULL,
+    contact_phone     text,
+    notes             text,
+    status            text        NOT NULL,
+    cancel_token_hash bytea       NOT NULL,
+    created_at        timestamptz NOT NULL DEFAULT now(),
+    updated_at        timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS event_signups_event_id_status_idx ON event_signups (event_id, status, created_at);
@@ -0,0 +1,6 @@
+ALTER TABLE events
+    DROP COLUMN IF EXISTS activity,
+    DROP COLUMN IF EXISTS adult_helpers,
+    DROP COLUMN IF EXISTS ratio_enforcement;
+
+DROP TABLE IF EXISTS ratio_rules;
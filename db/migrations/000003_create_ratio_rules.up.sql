@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS ratio_rules
+(
+    activity        text        NOT NULL,
+    section         text        NOT NULL,
+    girls_per_adult integer     NOT NULL CHECK (girls_per_adult > 0),
+    min_adults      integer     NOT NULL CHECK (min_adults >= 0),
+    updated_by      text        NOT NULL,
+    updated_at      timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (activity, section)
+);
+
+-- Starting values only. Check them against the current Girlguiding policy and update them through the admin API.
+INSERT INTO ratio_rules (activity, section, girls_per_adult, min_adults, updated_by)
+VALUES ('meeting', 'rainbows', 5, 2, 'migration'),
+       ('meeting', 'brownies', 8, 2, 'migration'),
+       ('meeting', 'guides', 12, 2, 'migration'),
+       ('meeting', 'rangers', 12, 2, 'migration'),
+       ('outing', 'rainbows', 4, 2, 'migration'),
+       ('outing', 'brownies', 6, 2, 'migration'),
+       ('outing', 'guides', 10, 2, 'migration'),
+       ('outing', 'rangers', 12, 2, 'migration'),
+       ('residential', 'rainbows', 4, 2, 'migration'),
+       ('residential', 'brownies', 6, 2, 'migration'),
+       ('residential', 'guides', 10, 2, 'migration'),
+       ('residential', 'rangers', 12, 2, 'migration')
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS activity          text    NOT NULL DEFAULT 'meeting',
+    ADD COLUMN IF NOT EXISTS adult_helpers     integer NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS ratio_enforcement text    NOT NULL DEFAULT 'flag';
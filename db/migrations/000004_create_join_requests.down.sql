@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS join_requests;
+
+DROP TABLE IF EXISTS units;
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS units
+(
+    id      text PRIMARY KEY,
+    name    text NOT NULL,
+    section text NOT NULL
+);
+
+INSERT INTO units (id, name, section)
+VALUES ('2nd-rainbows', '2nd Staplehurst Rainbows', 'rainbows'),
+       ('1st-brownies', '1st Staplehurst Brownies', 'brownies'),
+       ('4th-brownies', '4th Staplehurst Brownies', 'brownies'),
+       ('1st-guides', '1st Staplehurst Guides', 'guides'),
+       ('1st-rangers', '1st Staplehurst Rangers', 'rangers')
+ON CONFLICT (id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS join_requests
+(
+    id                uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    child_name        text        NOT NULL,
+    date_of_birth     date        NOT NULL,
+    postcode          text        NOT NULL,
+    preferred_units   text[]      NOT NULL,
+    eligible_sections text[]      NOT NULL,
+    parent_name       text        NOT NULL,
+    parent_email      text        NOT NULL,
+    parent_phone      text,
+    notes             text,
+    status            text        NOT NULL,
+    ip                text        NOT NULL,
+    created_at        timestamptz NOT NULL DEFAULT now(),
+    updated_at        timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS join_requests_ip_created_at_idx ON join_requests (ip, created_at);
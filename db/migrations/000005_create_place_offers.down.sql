@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS join_request_events;
+
+DROP TABLE IF EXISTS place_offers;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS place_offers
+(
+    id              uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    join_request_id uuid        NOT NULL REFERENCES join_requests (id) ON DELETE CASCADE,
+    unit            text        NOT NULL REFERENCES units (id),
+    status          text        NOT NULL,
+    token_hash      bytea       NOT NULL,
+    expires_at      timestamptz NOT NULL,
+    created_by      text        NOT NULL,
+    created_at      timestamptz NOT NULL DEFAULT now(),
+    responded_at    timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS place_offers_status_expires_at_idx ON place_offers (status, expires_at);
+
+-- Rows are only ever inserted, so that there is a full record of who changed what.
+CREATE TABLE IF NOT EXISTS join_request_events
+(
+    id              bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    join_request_id uuid        NOT NULL REFERENCES join_requests (id) ON DELETE CASCADE,
+    offer_id        uuid REFERENCES place_offers (id) ON DELETE CASCADE,
+    action          text        NOT NULL,
+    status          text        NOT NULL,
+    actor           text        NOT NULL,
+    created_at      timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS join_request_events_join_request_id_idx ON join_request_events (join_request_id, id);
+
+INSERT INTO join_request_events (join_request_id, action, status, actor, created_at)
+SELECT id, 'registered', 'waiting', parent_email, created_at
+FROM join_requests;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS member_transfers;
+
+DROP TABLE IF EXISTS members;
+
+ALTER TABLE units
+    DROP COLUMN IF EXISTS capacity,
+    DROP COLUMN IF EXISTS leader_email;
@@ -0,0 +1,36 @@
+ALTER TABLE units
+    ADD COLUMN IF NOT EXISTS capacity     integer CHECK (capacity >= 0),
+    ADD COLUMN IF NOT EXISTS leader_email text;
+
+CREATE TABLE IF NOT EXISTS members
+(
+    id              uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    join_request_id uuid REFERENCES join_requests (id) ON DELETE SET NULL,
+    name            text        NOT NULL,
+    date_of_birth   date        NOT NULL,
+    unit            text        NOT NULL REFERENCES units (id),
+    parent_name     text        NOT NULL,
+    parent_email    text        NOT NULL,
+    parent_phone    text,
+    joined_at       timestamptz NOT NULL DEFAULT now(),
+    updated_at      timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS members_unit_idx ON members (unit);
+
+CREATE TABLE IF NOT EXISTS member_transfers
+(
+    id             bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    member_id      uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    from_unit      text        NOT NULL REFERENCES units (id),
+    to_unit        text        NOT NULL REFERENCES units (id),
+    transferred_by text        NOT NULL,
+    created_at     timestamptz NOT NULL DEFAULT now()
+);
+
+-- Children who have already accepted a place become members of the unit they accepted.
+INSERT INTO members (join_request_id, name, date_of_birth, unit, parent_name, parent_email, parent_phone, joined_at)
+SELECT j.id, j.child_name, j.date_of_birth, o.unit, j.parent_name, j.parent_email, j.parent_phone, o.responded_at
+FROM place_offers o
+         JOIN join_requests j ON j.id = o.join_request_id
+WHERE o.status = 'accepted';
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS member_access_log;
+
+DROP TABLE IF EXISTS unit_admins;
+
+ALTER TABLE members
+    DROP COLUMN IF EXISTS sensitive,
+    DROP COLUMN IF EXISTS sensitive_key_id;
@@ -0,0 +1,23 @@
+-- Emergency contacts and medical details are encrypted by the service before they are stored. The key ID records
+-- which key encrypted them, so that keys can be rotated.
+ALTER TABLE members
+    ADD COLUMN IF NOT EXISTS sensitive        bytea,
+    ADD COLUMN IF NOT EXISTS sensitive_key_id text;
+
+CREATE TABLE IF NOT EXISTS unit_admins
+(
+    unit  text NOT NULL REFERENCES units (id) ON DELETE CASCADE,
+    email text NOT NULL,
+    PRIMARY KEY (unit, email)
+);
+
+CREATE TABLE IF NOT EXISTS member_access_log
+(
+    id          bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    member_id   uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    accessed_by text        NOT NULL,
+    ip          text,
+    accessed_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS member_access_log_member_id_idx ON member_access_log (member_id, accessed_at);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS unit_admins
+(
+    unit  text NOT NULL REFERENCES units (id) ON DELETE CASCADE,
+    email text NOT NULL,
+    PRIMARY KEY (unit, email)
+);
+
+INSERT INTO unit_admins (unit, email)
+SELECT unit, email
+FROM user_roles
+WHERE role = 'unit_leader'
+ON CONFLICT DO NOTHING;
+
+DROP TABLE IF EXISTS user_roles;
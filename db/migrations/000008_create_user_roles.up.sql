@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS user_roles
+(
+    id         uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    email      text        NOT NULL,
+    role       text        NOT NULL,
+    unit       text REFERENCES units (id) ON DELETE CASCADE,
+    created_by text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_roles_email_role_unit_idx ON user_roles (email, role, coalesce(unit, ''));
+
+-- Unit admins and leaders become unit leaders. Roles are looked up by lowercased email. The first district
+-- commissioner is added with `district grant-commissioner`.
+INSERT INTO user_roles (email, role, unit, created_by)
+SELECT lower(email), 'unit_leader', unit, 'migration'
+FROM unit_admins
+UNION
+SELECT lower(leader_email), 'unit_leader', id, 'migration'
+FROM units
+WHERE leader_email IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+DROP TABLE IF EXISTS unit_admins;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS admin_invitations
+(
+    id          uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    email       text        NOT NULL UNIQUE,
+    invited_by  text        NOT NULL,
+    created_at  timestamptz NOT NULL DEFAULT now(),
+    expires_at  timestamptz NOT NULL,
+    accepted_at timestamptz
+);
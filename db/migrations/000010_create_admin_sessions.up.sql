@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS admin_sessions
+(
+    id            uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    token_hash    bytea       NOT NULL UNIQUE,
+    email         text        NOT NULL,
+    name          text        NOT NULL DEFAULT '',
+    hosted_domain text        NOT NULL DEFAULT '',
+    csrf_token    text        NOT NULL,
+    created_at    timestamptz NOT NULL DEFAULT now(),
+    last_seen_at  timestamptz NOT NULL DEFAULT now(),
+    expires_at    timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS admin_sessions_expires_at_idx ON admin_sessions (expires_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS admin_session_revocations;
+DROP TABLE IF EXISTS admin_revocations;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS admin_revocations
+(
+    id         uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    email      text        NOT NULL UNIQUE,
+    reason     text,
+    revoked_by text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- Sessions are deleted when they're revoked, but are kept here until they would have expired so replicas can deny
+-- them from memory.
+CREATE TABLE IF NOT EXISTS admin_session_revocations
+(
+    session_id uuid PRIMARY KEY,
+    email      text        NOT NULL,
+    revoked_by text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL
+);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id           uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    name         text        NOT NULL,
+    owner        text        NOT NULL,
+    key_hash     bytea       NOT NULL UNIQUE,
+    scopes       text[]      NOT NULL,
+    created_at   timestamptz NOT NULL DEFAULT now(),
+    expires_at   timestamptz NOT NULL,
+    last_used_at timestamptz,
+    last_used_ip text
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS api_keys_owner_name_idx ON api_keys (owner, name);
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS audit_log
+(
+    id          bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    occurred_at timestamptz NOT NULL DEFAULT now(),
+    actor       text        NOT NULL,
+    credential  text,
+    ip          text,
+    action      text        NOT NULL,
+    target_type text,
+    target_id   text,
+    changes     jsonb,
+    method      text        NOT NULL,
+    path        text        NOT NULL,
+    status      integer     NOT NULL,
+    trace_id    text
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_actor_idx ON audit_log (actor, id);
+CREATE INDEX IF NOT EXISTS audit_log_target_idx ON audit_log (target_type, target_id, id);
+CREATE INDEX IF NOT EXISTS audit_log_occurred_at_idx ON audit_log (occurred_at);
+
+-- The audit log is append-only, so it can be relied on in safeguarding reviews.
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'the audit log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER audit_log_no_changes
+    BEFORE UPDATE OR DELETE
+    ON audit_log
+    FOR EACH ROW
+EXECUTE FUNCTION audit_log_append_only();
+
+CREATE OR REPLACE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE
+    ON audit_log
+    FOR EACH STATEMENT
+EXECUTE FUNCTION audit_log_append_only();
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS event_signups_contact_email_idx;
+DROP INDEX IF EXISTS members_parent_email_idx;
+DROP TABLE IF EXISTS parent_sessions;
+DROP TABLE IF EXISTS parent_logins;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS parent_logins
+(
+    id         uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    email      text        NOT NULL,
+    token_hash bytea       NOT NULL UNIQUE,
+    ip         text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL,
+    used_at    timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS parent_logins_ip_created_at_idx ON parent_logins (ip, created_at);
+
+CREATE TABLE IF NOT EXISTS parent_sessions
+(
+    id           uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    token_hash   bytea       NOT NULL UNIQUE,
+    email        text        NOT NULL,
+    csrf_token   text        NOT NULL,
+    created_at   timestamptz NOT NULL DEFAULT now(),
+    last_seen_at timestamptz NOT NULL DEFAULT now(),
+    expires_at   timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS parent_sessions_expires_at_idx ON parent_sessions (expires_at);
+
+-- Families are found by their contact email, whatever case it was given in.
+CREATE INDEX IF NOT EXISTS members_parent_email_idx ON members (lower(parent_email));
+CREATE INDEX IF NOT EXISTS event_signups_contact_email_idx ON event_signups (lower(contact_email));
@@ -0,0 +1,49 @@
+-- Demo data for local and review environments, loaded with `district seed`. Everything has a fixed ID, so loading it
+-- again changes nothing. The units themselves are created by the migrations.
+
+INSERT INTO user_roles (email, role, unit, created_by)
+VALUES ('commissioner@staplehurstguiding.org.uk', 'district_commissioner', NULL, 'seed'),
+       ('brownies@staplehurstguiding.org.uk', 'unit_leader', '1st-brownies', 'seed'),
+       ('treasurer@staplehurstguiding.org.uk', 'treasurer', NULL, 'seed')
+ON CONFLICT DO NOTHING;
+
+UPDATE units
+SET capacity     = 24,
+    leader_email = 'brownies@staplehurstguiding.org.uk'
+WHERE id = '1st-brownies'
+  AND leader_email IS NULL;
+
+INSERT INTO events (id, title, description, location, unit, starts_at, ends_at, status, created_by, activity,
+                    adult_helpers, signups_enabled, signup_capacity, signup_deadline, signup_sections)
+VALUES ('9b1c5b6e-0a43-4d5c-9a3e-000000000001', 'Brownies weekly meeting', 'Badge work and games.',
+        'Staplehurst Village Centre', '1st-brownies', date_trunc('week', now()) + interval '7 days 18 hours',
+        date_trunc('week', now()) + interval '7 days 19 hours 30 minutes', 'approved', 'seed', 'meeting', 2, false,
+        NULL, NULL, '{}'),
+       ('9b1c5b6e-0a43-4d5c-9a3e-000000000002', 'District campfire', 'A campfire for every section, with sausages.',
+        'Kathie Lamb Guide Centre', NULL, date_trunc('week', now()) + interval '19 days 17 hours',
+        date_trunc('week', now()) + interval '19 days 20 hours', 'approved', 'seed', 'outing', 4, true, 30,
+        date_trunc('week', now()) + interval '14 days', '{rainbows,brownies,guides}'),
+       ('9b1c5b6e-0a43-4d5c-9a3e-000000000003', 'Guides residential', 'A weekend away at the guide centre.',
+        'Kathie Lamb Guide Centre', '1st-guides', date_trunc('week', now()) + interval '40 days 18 hours',
+        date_trunc('week', now()) + interval '42 days 15 hours', 'provisional', 'seed', 'residential', 3, false, NULL,
+        NULL, '{}')
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO members (id, name, date_of_birth, unit, parent_name, parent_email, parent_phone)
+VALUES ('4f0d7c2a-5d8e-4b7f-8c1a-000000000001', 'Ada Example', date_trunc('year', now()) - interval '8 years',
+        '1st-brownies', 'Grace Example', 'parent@example.com', '07700 900001'),
+       ('4f0d7c2a-5d8e-4b7f-8c1a-000000000002', 'Mary Example', date_trunc('year', now()) - interval '5 years',
+        '2nd-rainbows', 'Grace Example', 'parent@example.com', '07700 900001'),
+       ('4f0d7c2a-5d8e-4b7f-8c1a-000000000003', 'Edith Sample', date_trunc('year', now()) - interval '12 years',
+        '1st-guides', 'Alan Sample', 'another.parent@example.com', NULL)
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO event_signups (id, event_id, participant_name, date_of_birth, section, unit, contact_name, contact_email,
+                           status, cancel_token_hash)
+VALUES ('c7e2a1d4-3b6f-4e8a-9d2c-000000000001', '9b1c5b6e-0a43-4d5c-9a3e-000000000002', 'Ada Example',
+        date_trunc('year', now()) - interval '8 years', 'brownies', '1st-brownies', 'Grace Example',
+        'parent@example.com', 'confirmed', sha256('seed-ada'::bytea)),
+       ('c7e2a1d4-3b6f-4e8a-9d2c-000000000002', '9b1c5b6e-0a43-4d5c-9a3e-000000000002', 'Edith Sample',
+        date_trunc('year', now()) - interval '12 years', 'guides', '1st-guides', 'Alan Sample',
+        'another.parent@example.com', 'confirmed', sha256('seed-edith'::bytea))
+ON CONFLICT (id) DO NOTHING;
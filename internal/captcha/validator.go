@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/MicahParks/recaptcha"
 	"github.com/girlguidingstaplehurst/district/internal/rest"
@@ -15,30 +16,54 @@ import (
 var _ rest.CaptchaVerifier = (*Verifier)(nil)
 
 type Verifier struct {
-	cli   recaptcha.VerifierV3
-	armed bool
+	// verifyURL is where tokens are verified, which is Google's unless it's set by tests.
+	verifyURL string
+
+	mu       sync.RWMutex
+	cli      recaptcha.VerifierV3
+	armed    bool
+	minScore float64
 }
 
-func NewVerifier(secret string, armed bool) *Verifier {
-	return &Verifier{
-		cli: recaptcha.NewVerifierV3(secret, recaptcha.VerifierV3Options{
-			HTTPClient: &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)},
-		}),
-		armed: armed,
-	}
+func NewVerifier(secret string, armed bool, minScore float64) *Verifier {
+	v := new(Verifier)
+	v.Update(secret, armed, minScore)
+	return v
+}
+
+// Update changes the secret, whether failures are rejected and the lowest score accepted, for requests verified from
+// then on.
+func (v *Verifier) Update(secret string, armed bool, minScore float64) {
+	cli := recaptcha.NewVerifierV3(secret, recaptcha.VerifierV3Options{
+		HTTPClient: &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)},
+		VerifyURL:  v.verifyURL,
+	})
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.cli = cli
+	v.armed = armed
+	v.minScore = minScore
 }
 
 func (v *Verifier) Verify(ctx context.Context, token string, ip string) error {
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(attribute.String("recaptcha.token", token), attribute.String("recaptcha.ip", ip))
 
-	resp, err := v.cli.Verify(ctx, token, ip)
+	v.mu.RLock()
+	cli, armed, minScore := v.cli, v.armed, v.minScore
+	v.mu.RUnlock()
+
+	resp, err := cli.Verify(ctx, token, ip)
 	if err != nil {
 		return err
 	}
 
-	if !resp.Success && v.armed {
-		return fmt.Errorf("captcha verification failed: %q", resp.ErrorCodes)
+	span.SetAttributes(attribute.Float64("recaptcha.score", resp.Score))
+
+	if err := resp.Check(recaptcha.V3ResponseCheckOptions{Score: minScore}); err != nil && armed {
+		return fmt.Errorf("captcha verification failed: %w", err)
 	}
 
 	return nil
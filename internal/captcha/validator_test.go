@@ -0,0 +1,44 @@
+package captcha
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+
+	siteverify := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"success": true, "score": 0.3}`))
+	}))
+	t.Cleanup(siteverify.Close)
+
+	newVerifier := func(armed bool, minScore float64) *Verifier {
+		v := &Verifier{verifyURL: siteverify.URL}
+		v.Update("secret", armed, minScore)
+		return v
+	}
+
+	t.Run("low scores are rejected", func(t *testing.T) {
+		assert.ErrorContains(t, newVerifier(true, 0.5).Verify(ctx, "token", ""), "score")
+	})
+
+	t.Run("scores from the minimum are accepted", func(t *testing.T) {
+		assert.NoError(t, newVerifier(true, 0.3).Verify(ctx, "token", ""))
+	})
+
+	t.Run("a lowered minimum applies straight away", func(t *testing.T) {
+		v := newVerifier(true, 0.5)
+		v.Update("secret", true, 0.2)
+
+		assert.NoError(t, v.Verify(ctx, "token", ""))
+	})
+
+	t.Run("low scores are let through when it isn't armed", func(t *testing.T) {
+		assert.NoError(t, newVerifier(false, 0.5).Verify(ctx, "token", ""))
+	})
+}
@@ -2,7 +2,177 @@ package config
 
 import (
 	_ "embed"
+	"time"
 )
 
 type Config struct {
+	HTTP        HTTPConfig       `koanf:"http"`
+	Auth        AuthConfig       `koanf:"auth"`
+	Captcha     CaptchaConfig    `koanf:"captcha"`
+	Content     ContentConfig    `koanf:"content"`
+	Database    DatabaseConfig   `koanf:"database"`
+	SMTP        SMTPConfig       `koanf:"smtp"`
+	Telemetry   TelemetryConfig  `koanf:"telemetry"`
+	Encryption  EncryptionConfig `koanf:"encryption"`
+	PlaceOffers PlaceOfferConfig `koanf:"placeoffers"`
+	RateLimits  RateLimitConfig  `koanf:"ratelimits"`
+	Reload      ReloadConfig     `koanf:"reload"`
+}
+
+type HTTPConfig struct {
+	// Address is the address the server listens on, such as ":8080".
+	Address string `koanf:"address"`
+	// ProxyHeader is the header the load balancer gives the client's IP address in.
+	ProxyHeader string `koanf:"proxyheader"`
+	// ShutdownTimeout is how long requests in flight are given to finish when the service is asked to stop, such as
+	// during a rollout. It must be shorter than the pod's terminationGracePeriodSeconds.
+	ShutdownTimeout time.Duration `koanf:"shutdowntimeout"`
+	// StopTimeout is how long the background work is then given to stop, and telemetry to be flushed. Together with
+	// ShutdownTimeout, it must be shorter than the pod's terminationGracePeriodSeconds.
+	StopTimeout time.Duration `koanf:"stoptimeout"`
+}
+
+// AuthConfig controls who can sign in as an admin. Anyone signing in still needs a role before they can do anything.
+type AuthConfig struct {
+	// Domains are the Google Workspace domains whose accounts can sign in.
+	Domains []string `koanf:"domains"`
+	// AllowList are individual accounts from outside the domains, such as personal Gmail accounts, that can sign in.
+	AllowList []string `koanf:"allowlist"`
+	// Invitations controls the invitations admins can send to people from outside the domains.
+	Invitations InvitationConfig `koanf:"invitations"`
+	// Sessions controls how long the admin site's sign in lasts.
+	Sessions SessionConfig `koanf:"sessions"`
+	// Revocations controls how revoked access reaches every replica.
+	Revocations RevocationConfig `koanf:"revocations"`
+	// Providers are OpenID Connect issuers, besides Google, whose ID tokens are accepted.
+	Providers []ProviderConfig `koanf:"providers"`
+	// Parents controls how parents sign in with links sent to their email.
+	Parents ParentConfig `koanf:"parents"`
+	// GoogleClientID is the OAuth client the admin site signs in to Google with, which Google ID tokens must be issued
+	// for.
+	GoogleClientID string `koanf:"googleclientid"`
+}
+
+type InvitationConfig struct {
+	// Expiry is how long an invitation can be accepted for.
+	Expiry time.Duration `koanf:"expiry"`
+}
+
+type SessionConfig struct {
+	// Idle is how long a session lasts without being used.
+	Idle time.Duration `koanf:"idle"`
+	// Lifetime is the longest a session lasts, however much it's used.
+	Lifetime time.Duration `koanf:"lifetime"`
+}
+
+type ParentConfig struct {
+	// LinkExpiry is how long a sign in link can be used for.
+	LinkExpiry time.Duration `koanf:"linkexpiry"`
+	// Sessions controls how long a parent's sign in lasts.
+	Sessions SessionConfig `koanf:"sessions"`
+}
+
+type RevocationConfig struct {
+	// Refresh is how often each replica reloads the revoked admins and sessions.
+	Refresh time.Duration `koanf:"refresh"`
+}
+
+type ProviderConfig struct {
+	// Issuer is the issuer's URL, from which its signing keys are discovered.
+	Issuer string `koanf:"issuer"`
+	// Audiences are the client IDs its tokens may be issued for.
+	Audiences []string `koanf:"audiences"`
+	// Claims names the claims that hold the identity, if they aren't the standard ones.
+	Claims ClaimConfig `koanf:"claims"`
+	// TrustEmail accepts emails without an email_verified claim, for issuers that only issue verified emails. It must
+	// not be set for a claim the issuer's users can change themselves.
+	TrustEmail bool `koanf:"trustemail"`
+}
+
+type ClaimConfig struct {
+	Email         string `koanf:"email"`
+	EmailVerified string `koanf:"emailverified"`
+	Name          string `koanf:"name"`
+	HostedDomain  string `koanf:"hosteddomain"`
+}
+
+type CaptchaConfig struct {
+	// Secret is the reCAPTCHA secret key.
+	Secret string `koanf:"secret"`
+	// Armed rejects requests that fail the captcha. It can be turned off where there are no real users, such as when
+	// running locally.
+	Armed bool `koanf:"armed"`
+	// MinScore is the lowest reCAPTCHA score accepted, from 0.0 for very likely a bot to 1.0 for very likely a person.
+	MinScore float64 `koanf:"minscore"`
+}
+
+type ContentConfig struct {
+	// URL is the Contentful GraphQL endpoint the email templates are fetched from.
+	URL   string `koanf:"url"`
+	Token string `koanf:"token"`
+}
+
+type DatabaseConfig struct {
+	// URL is the Postgres connection string.
+	URL string `koanf:"url"`
+	// Migrate applies any migrations that haven't been when the service starts. Replicas starting together take turns,
+	// so only the first migrates.
+	Migrate bool `koanf:"migrate"`
+	// MaxConns is the most connections each replica opens, and MinConns the fewest it keeps open while idle.
+	MaxConns        int32         `koanf:"maxconns"`
+	MinConns        int32         `koanf:"minconns"`
+	MaxConnLifetime time.Duration `koanf:"maxconnlifetime"`
+	MaxConnIdleTime time.Duration `koanf:"maxconnidletime"`
+	ConnectTimeout  time.Duration `koanf:"connecttimeout"`
+	// StatementTimeout cancels statements that run for longer. Migrations aren't limited by it.
+	StatementTimeout time.Duration `koanf:"statementtimeout"`
+}
+
+type SMTPConfig struct {
+	Server string `koanf:"server"`
+	Port   int    `koanf:"port"`
+	// Username signs in to the server, and is the address emails are sent from.
+	Username string `koanf:"username"`
+	Password string `koanf:"password"`
+}
+
+type TelemetryConfig struct {
+	// Enabled exports traces, metrics and logs over OTLP, to where the standard OTEL_EXPORTER_OTLP_* variables say.
+	Enabled     bool   `koanf:"enabled"`
+	ServiceName string `koanf:"servicename"`
+}
+
+type EncryptionConfig struct {
+	// KeyID is the key that encrypts members' sensitive details. The others are only kept to decrypt what they
+	// encrypted before it was rotated.
+	KeyID string `koanf:"keyid"`
+	// Keys are written as comma separated id:base64key pairs.
+	Keys string `koanf:"keys"`
+}
+
+type PlaceOfferConfig struct {
+	// Expiry is how long a parent has to respond to a place offer.
+	Expiry time.Duration `koanf:"expiry"`
+	// Interval is how often unanswered offers are checked for expiry.
+	Interval time.Duration `koanf:"interval"`
+}
+
+// RateLimitConfig limits the public requests that could be used to send email, or flood the waiting list.
+type RateLimitConfig struct {
+	// JoinRequests limits the join requests accepted from one IP address.
+	JoinRequests RateLimit `koanf:"joinrequests"`
+	// ParentLogins limits the sign in links parents can ask for from one IP address.
+	ParentLogins RateLimit `koanf:"parentlogins"`
+}
+
+type RateLimit struct {
+	// Limit is the most requests accepted within Window.
+	Limit  int           `koanf:"limit"`
+	Window time.Duration `koanf:"window"`
+}
+
+type ReloadConfig struct {
+	// Interval is how often the config file and secret files are checked for changes, which are applied without a
+	// restart where they can be.
+	Interval time.Duration `koanf:"interval"`
 }
@@ -0,0 +1,305 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		cfg := new(config.Config)
+		require.NoError(t, config.Load(cfg, ""))
+
+		assert.Equal(t, []string{"kathielambcentre.org", "staplehurstguiding.org.uk"}, cfg.Auth.Domains)
+		assert.Empty(t, cfg.Auth.AllowList)
+		assert.Equal(t, 14*24*time.Hour, cfg.Auth.Invitations.Expiry)
+		assert.Equal(t, 2*time.Hour, cfg.Auth.Sessions.Idle)
+		assert.Equal(t, 24*time.Hour, cfg.Auth.Sessions.Lifetime)
+		assert.Equal(t, 5*time.Second, cfg.Auth.Revocations.Refresh)
+		assert.Equal(t, 15*time.Minute, cfg.Auth.Parents.LinkExpiry)
+		assert.Equal(t, time.Hour, cfg.Auth.Parents.Sessions.Idle)
+		assert.Equal(t, 12*time.Hour, cfg.Auth.Parents.Sessions.Lifetime)
+	})
+
+	t.Run("environment overrides", func(t *testing.T) {
+		t.Setenv("BOOKING_AUTH_ALLOWLIST", "volunteer@gmail.com,helper@outlook.com")
+		t.Setenv("BOOKING_AUTH_INVITATIONS_EXPIRY", "72h")
+
+		cfg := new(config.Config)
+		require.NoError(t, config.Load(cfg, ""))
+
+		assert.Equal(t, []string{"volunteer@gmail.com", "helper@outlook.com"}, cfg.Auth.AllowList)
+		assert.Equal(t, 72*time.Hour, cfg.Auth.Invitations.Expiry)
+	})
+
+	t.Run("typed overrides", func(t *testing.T) {
+		t.Setenv("BOOKING_CAPTCHA_ARMED", "false")
+		t.Setenv("BOOKING_SMTP_PORT", "2525")
+		t.Setenv("BOOKING_DATABASE_URL", "postgres://localhost/district")
+
+		cfg := new(config.Config)
+		require.NoError(t, config.Load(cfg, ""))
+
+		assert.False(t, cfg.Captcha.Armed)
+		assert.Equal(t, 2525, cfg.SMTP.Port)
+		assert.Equal(t, "postgres://localhost/district", cfg.Database.URL)
+	})
+
+	t.Run("config file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("smtp:\n  server: smtp.example.com\n  port: 465\n"), 0o600))
+		t.Setenv("BOOKING_SMTP_PORT", "2525")
+
+		cfg := new(config.Config)
+		require.NoError(t, config.Load(cfg, path))
+
+		assert.Equal(t, "smtp.example.com", cfg.SMTP.Server)
+		assert.Equal(t, 2525, cfg.SMTP.Port, "the environment overrides the file")
+		assert.Equal(t, 2*time.Hour, cfg.Auth.Sessions.Idle, "the defaults are kept")
+	})
+
+	t.Run("config file from the environment", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("http:\n  address: \":9090\"\n"), 0o600))
+		t.Setenv("BOOKING_CONFIG", path)
+
+		cfg := new(config.Config)
+		require.NoError(t, config.Load(cfg, ""))
+
+		assert.Equal(t, ":9090", cfg.HTTP.Address)
+	})
+
+	t.Run("missing config file", func(t *testing.T) {
+		cfg := new(config.Config)
+		assert.Error(t, config.Load(cfg, filepath.Join(t.TempDir(), "missing.yaml")))
+	})
+
+	t.Run("secrets from files", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "smtp-password")
+		require.NoError(t, os.WriteFile(path, []byte("s3cret\n"), 0o600))
+		t.Setenv("BOOKING_SMTP_PASSWORD_FILE", path)
+
+		cfg := new(config.Config)
+		require.NoError(t, config.Load(cfg, ""))
+
+		assert.Equal(t, "s3cret", cfg.SMTP.Password)
+	})
+
+	t.Run("secrets can't be set both ways", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "smtp-password")
+		require.NoError(t, os.WriteFile(path, []byte("s3cret"), 0o600))
+		t.Setenv("BOOKING_SMTP_PASSWORD_FILE", path)
+		t.Setenv("BOOKING_SMTP_PASSWORD", "other")
+
+		cfg := new(config.Config)
+		assert.ErrorContains(t, config.Load(cfg, ""), "only one of BOOKING_SMTP_PASSWORD and BOOKING_SMTP_PASSWORD_FILE can be set")
+	})
+
+	t.Run("missing secret files", func(t *testing.T) {
+		t.Setenv("BOOKING_SMTP_PASSWORD_FILE", filepath.Join(t.TempDir(), "missing"))
+
+		cfg := new(config.Config)
+		assert.ErrorContains(t, config.Load(cfg, ""), "reading BOOKING_SMTP_PASSWORD_FILE")
+	})
+}
+
+func TestEffective(t *testing.T) {
+	t.Setenv("BOOKING_SMTP_SERVER", "smtp.example.com")
+	t.Setenv("BOOKING_SMTP_PASSWORD", "s3cret")
+
+	effective, err := config.Effective("")
+	require.NoError(t, err)
+
+	assert.Contains(t, string(effective), "server: smtp.example.com")
+	assert.Contains(t, string(effective), "password: REDACTED")
+	assert.NotContains(t, string(effective), "s3cret")
+	assert.Contains(t, string(effective), `token: ""`, "unset secrets are shown as unset")
+}
+
+// validConfig is the defaults with every required value set.
+func validConfig(t *testing.T) *config.Config {
+	cfg := new(config.Config)
+	require.NoError(t, config.Load(cfg, ""))
+
+	cfg.Auth.GoogleClientID = "client-id"
+	cfg.Captcha.Secret = "captcha-secret"
+	cfg.Content.URL = "https://graphql.contentful.com/content/v1/spaces/space"
+	cfg.Content.Token = "content-token"
+	cfg.Database.URL = "postgres://localhost/district"
+	cfg.SMTP.Server = "smtp.example.com"
+	cfg.SMTP.Username = "bookings@example.com"
+	cfg.SMTP.Password = "smtp-password"
+	cfg.Encryption.KeyID = "2026-01"
+	cfg.Encryption.Keys = "2026-01:c2VjcmV0"
+
+	return cfg
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, validConfig(t).Validate())
+	})
+
+	t.Run("the defaults are missing the secrets", func(t *testing.T) {
+		cfg := new(config.Config)
+		require.NoError(t, config.Load(cfg, ""))
+
+		err := cfg.Validate()
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "database.url is required, set it with BOOKING_DATABASE_URL")
+		assert.ErrorContains(t, err, "auth.googleclientid is required, set it with BOOKING_AUTH_GOOGLECLIENTID")
+		assert.ErrorContains(t, err, "captcha.secret is required")
+		assert.ErrorContains(t, err, "smtp.password is required")
+		assert.ErrorContains(t, err, "encryption.keys is required")
+	})
+
+	t.Run("no captcha secret is needed when it isn't armed", func(t *testing.T) {
+		cfg := validConfig(t)
+		cfg.Captcha.Armed = false
+		cfg.Captcha.Secret = ""
+
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("durations", func(t *testing.T) {
+		cfg := validConfig(t)
+		cfg.Auth.Sessions.Idle = 48 * time.Hour
+		cfg.PlaceOffers.Expiry = 0
+		cfg.HTTP.ShutdownTimeout = 0
+		cfg.HTTP.StopTimeout = 0
+
+		err := cfg.Validate()
+		assert.ErrorContains(t, err, "auth.sessions.idle must not be longer than auth.sessions.lifetime")
+		assert.ErrorContains(t, err, "placeoffers.expiry must be a positive duration")
+		assert.ErrorContains(t, err, "http.shutdowntimeout must be a positive duration")
+		assert.ErrorContains(t, err, "http.stoptimeout must be a positive duration")
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		cfg := validConfig(t)
+		cfg.Captcha.MinScore = 1.5
+		cfg.RateLimits.JoinRequests.Limit = 0
+		cfg.RateLimits.ParentLogins.Window = 0
+
+		err := cfg.Validate()
+		assert.ErrorContains(t, err, "captcha.minscore must be between 0 and 1")
+		assert.ErrorContains(t, err, "ratelimits.joinrequests.limit must be at least 1, set it with BOOKING_RATELIMITS_JOINREQUESTS_LIMIT")
+		assert.ErrorContains(t, err, "ratelimits.parentlogins.window must be a positive duration")
+	})
+
+	t.Run("providers", func(t *testing.T) {
+		cfg := validConfig(t)
+		cfg.Auth.Providers = []config.ProviderConfig{{Issuer: "https://login.example.com"}}
+
+		assert.ErrorContains(t, cfg.Validate(), "auth.providers[0].audiences must list at least one client ID")
+	})
+}
+
+// validYAML is a config file with every required value set.
+const validYAML = `
+auth:
+  googleclientid: client-id
+captcha:
+  secret: captcha-secret
+content:
+  url: https://graphql.contentful.com/content/v1/spaces/space
+  token: content-token
+database:
+  url: postgres://localhost/district
+encryption:
+  keyid: "2026-01"
+  keys: 2026-01:c2VjcmV0
+smtp:
+  server: smtp.example.com
+  username: bookings@example.com
+  password: smtp-password
+`
+
+func newWatcher(t *testing.T, path string) (*config.Watcher, *[]string) {
+	cfg := new(config.Config)
+	require.NoError(t, config.Load(cfg, path))
+
+	w, err := config.NewWatcher(path, cfg)
+	require.NoError(t, err)
+
+	var changed []string
+	w.OnChange(func(_ *config.Config, c []string) {
+		changed = c
+	})
+
+	return w, &changed
+}
+
+func TestWatcher_Check(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("applies changes", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(validYAML), 0o600))
+		w, changed := newWatcher(t, path)
+
+		require.NoError(t, os.WriteFile(path, []byte(validYAML+"  port: 465\n"), 0o600))
+		require.NoError(t, w.Check(ctx))
+
+		assert.Equal(t, 465, w.Current().SMTP.Port)
+		assert.Equal(t, []string{"smtp.port"}, *changed)
+	})
+
+	t.Run("unchanged files aren't reloaded", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(validYAML), 0o600))
+		w, changed := newWatcher(t, path)
+		before := w.Current()
+
+		require.NoError(t, w.Check(ctx))
+
+		assert.Same(t, before, w.Current())
+		assert.Nil(t, *changed)
+	})
+
+	t.Run("keeps the last valid config", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(validYAML), 0o600))
+		w, changed := newWatcher(t, path)
+
+		require.NoError(t, os.WriteFile(path, []byte(validYAML+"  port: 0\n"), 0o600))
+		assert.ErrorContains(t, w.Check(ctx), "smtp.port must be between 1 and 65535")
+
+		assert.Equal(t, 587, w.Current().SMTP.Port)
+		assert.Nil(t, *changed)
+	})
+
+	t.Run("applies rotated secrets", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(validYAML), 0o600))
+		secret := filepath.Join(dir, "captcha-secret")
+		require.NoError(t, os.WriteFile(secret, []byte("old\n"), 0o600))
+		t.Setenv("BOOKING_CAPTCHA_SECRET_FILE", secret)
+		w, changed := newWatcher(t, path)
+
+		require.NoError(t, os.WriteFile(secret, []byte("new\n"), 0o600))
+		require.NoError(t, w.Check(ctx))
+
+		assert.Equal(t, "new", w.Current().Captcha.Secret)
+		assert.Equal(t, []string{"captcha.secret"}, *changed)
+	})
+}
+
+func TestDiff(t *testing.T) {
+	old := validConfig(t)
+	updated := validConfig(t)
+	updated.Auth.AllowList = []string{"volunteer@gmail.com"}
+	updated.Auth.Parents.Sessions.Idle = 2 * time.Hour
+	updated.Captcha.Armed = false
+
+	assert.Equal(t, []string{"auth.allowlist", "auth.parents.sessions.idle", "captcha.armed"}, config.Diff(old, updated))
+	assert.Empty(t, config.Diff(old, validConfig(t)))
+}
@@ -2,7 +2,9 @@ package config
 
 import (
 	_ "embed"
-	"log/slog"
+	"errors"
+	"fmt"
+	"os"
 	"strings"
 
 	"github.com/knadh/koanf"
@@ -13,30 +15,126 @@ import (
 
 const (
 	EnvPrefix = "BOOKING_"
+	// EnvConfigPath names a YAML file to load over the defaults, when no path is given to Load.
+	EnvConfigPath = EnvPrefix + "CONFIG"
+	// FileSuffix on an environment variable reads its value from the file it names, such as a secret mounted by
+	// Kubernetes. BOOKING_SMTP_PASSWORD_FILE=/secrets/smtp-password sets smtp.password.
+	FileSuffix = "_FILE"
 )
 
 //go:embed defaults.yaml
 var DefaultCfg []byte
 
-func Load(cfg any) error {
+// Secrets are the keys whose values are never printed. database.url is among them as it usually holds a password.
+var Secrets = []string{"captcha.secret", "content.token", "database.url", "smtp.password", "encryption.keys"}
+
+// Load unmarshals the config into cfg, which must be a pointer to a struct. The embedded defaults are overridden by
+// the YAML file at path, or the one named by BOOKING_CONFIG if path is empty, and then by environment variables.
+func Load(cfg any, path string) error {
+	k, err := load(path)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Unmarshal("", cfg); err != nil {
+		return fmt.Errorf("unmarshalling config: %w", err)
+	}
+
+	return nil
+}
+
+// Effective is the config Load reads from path, as YAML, with the secrets that are set redacted.
+func Effective(path string) ([]byte, error) {
+	k, err := load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range Secrets {
+		if k.String(key) != "" {
+			if err := k.Set(key, "REDACTED"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return k.Marshal(yaml.Parser())
+}
+
+func load(path string) (*koanf.Koanf, error) {
 	k := koanf.New(".")
 
-	// Load from embedded YAML string (defaults)
 	if err := k.Load(rawbytes.Provider(DefaultCfg), yaml.Parser()); err != nil {
-		return err
+		return nil, fmt.Errorf("loading defaults: %w", err)
+	}
+
+	if path = configFile(path); path != "" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading config file: %w", err)
+		}
+
+		if err := k.Load(rawbytes.Provider(raw), yaml.Parser()); err != nil {
+			return nil, fmt.Errorf("loading config file %s: %w", path, err)
+		}
 	}
 
-	// Load from environment (specific overrides, last resort)
-	if err := k.Load(env.Provider(EnvPrefix, ".", func(s string) string {
-		return strings.ToLower(strings.Replace(strings.TrimPrefix(s, EnvPrefix), "_", ".", -1))
-	}), nil); err != nil {
-		slog.Error("error loading configuration from environment variable - continuing", "err", err)
+	var envErrs []error
+	err := k.Load(env.ProviderWithValue(EnvPrefix, ".", func(name, value string) (string, any) {
+		if name == EnvConfigPath {
+			return "", nil
+		}
+
+		if strings.HasSuffix(name, FileSuffix) {
+			name = strings.TrimSuffix(name, FileSuffix)
+
+			// Otherwise which of them won would depend on the order of the environment.
+			if _, ok := os.LookupEnv(name); ok {
+				envErrs = append(envErrs, fmt.Errorf("only one of %s and %s%s can be set", name, name, FileSuffix))
+				return "", nil
+			}
+
+			contents, err := os.ReadFile(value)
+			if err != nil {
+				envErrs = append(envErrs, fmt.Errorf("reading %s%s: %w", name, FileSuffix, err))
+				return "", nil
+			}
+
+			// Secrets are often written with a trailing newline, which is never part of the value.
+			value = strings.TrimRight(string(contents), "\r\n")
+		}
+
+		return strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(name, EnvPrefix), "_", ".")), value
+	}), nil)
+	if err != nil {
+		return nil, fmt.Errorf("loading environment: %w", err)
 	}
 
-	// Unmarshal into supplied config struct
-	if err := k.Unmarshal("", &cfg); err != nil {
-		return err
+	if err := errors.Join(envErrs...); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return k, nil
+}
+
+// configFile is the YAML file Load reads, if any.
+func configFile(path string) string {
+	if path == "" {
+		return os.Getenv(EnvConfigPath)
+	}
+
+	return path
+}
+
+// secretFiles are the files named by _FILE environment variables, which Load reads values from.
+func secretFiles() []string {
+	var files []string
+	for _, kv := range os.Environ() {
+		name, value, _ := strings.Cut(kv, "=")
+		if strings.HasPrefix(name, EnvPrefix) && strings.HasSuffix(name, FileSuffix) {
+			files = append(files, value)
+		}
+	}
+
+	return files
 }
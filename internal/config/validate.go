@@ -0,0 +1,112 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Validate checks the config has everything the service needs to start, saying which values are wrong and which
+// environment variables set them.
+func (c *Config) Validate() error {
+	var errs []error
+
+	required := func(key, value string) {
+		if strings.TrimSpace(value) == "" {
+			errs = append(errs, fmt.Errorf("%s is required, set it with %s", key, EnvVar(key)))
+		}
+	}
+
+	positive := func(key string, d time.Duration) {
+		if d <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be a positive duration, such as 15m, set it with %s", key, EnvVar(key)))
+		}
+	}
+
+	rateLimit := func(key string, r RateLimit) {
+		if r.Limit < 1 {
+			errs = append(errs, fmt.Errorf("%s.limit must be at least 1, set it with %s", key, EnvVar(key+".limit")))
+		}
+		positive(key+".window", r.Window)
+	}
+
+	sessions := func(key string, s SessionConfig) {
+		positive(key+".idle", s.Idle)
+		positive(key+".lifetime", s.Lifetime)
+		if s.Idle > s.Lifetime {
+			errs = append(errs, fmt.Errorf("%s.idle must not be longer than %s.lifetime", key, key))
+		}
+	}
+
+	required("http.address", c.HTTP.Address)
+	positive("http.shutdowntimeout", c.HTTP.ShutdownTimeout)
+	positive("http.stoptimeout", c.HTTP.StopTimeout)
+
+	required("auth.googleclientid", c.Auth.GoogleClientID)
+	positive("auth.invitations.expiry", c.Auth.Invitations.Expiry)
+	sessions("auth.sessions", c.Auth.Sessions)
+	positive("auth.revocations.refresh", c.Auth.Revocations.Refresh)
+	positive("auth.parents.linkexpiry", c.Auth.Parents.LinkExpiry)
+	sessions("auth.parents.sessions", c.Auth.Parents.Sessions)
+
+	for i, p := range c.Auth.Providers {
+		if p.Issuer == "" {
+			errs = append(errs, fmt.Errorf("auth.providers[%d].issuer is required", i))
+		}
+		if len(p.Audiences) == 0 {
+			errs = append(errs, fmt.Errorf("auth.providers[%d].audiences must list at least one client ID", i))
+		}
+	}
+
+	if c.Captcha.Armed {
+		required("captcha.secret", c.Captcha.Secret)
+	}
+	if c.Captcha.MinScore < 0 || c.Captcha.MinScore > 1 {
+		errs = append(errs, fmt.Errorf("captcha.minscore must be between 0 and 1, set it with %s", EnvVar("captcha.minscore")))
+	}
+
+	required("content.url", c.Content.URL)
+	required("content.token", c.Content.Token)
+
+	required("database.url", c.Database.URL)
+	if c.Database.MaxConns < 1 {
+		errs = append(errs, fmt.Errorf("database.maxconns must be at least 1, set it with %s", EnvVar("database.maxconns")))
+	}
+	if c.Database.MinConns < 0 || c.Database.MinConns > c.Database.MaxConns {
+		errs = append(errs, errors.New("database.minconns must be between 0 and database.maxconns"))
+	}
+	positive("database.maxconnlifetime", c.Database.MaxConnLifetime)
+	positive("database.maxconnidletime", c.Database.MaxConnIdleTime)
+	positive("database.connecttimeout", c.Database.ConnectTimeout)
+	positive("database.statementtimeout", c.Database.StatementTimeout)
+
+	required("smtp.server", c.SMTP.Server)
+	required("smtp.username", c.SMTP.Username)
+	required("smtp.password", c.SMTP.Password)
+	if c.SMTP.Port < 1 || c.SMTP.Port > 65535 {
+		errs = append(errs, fmt.Errorf("smtp.port must be between 1 and 65535, set it with %s", EnvVar("smtp.port")))
+	}
+
+	if c.Telemetry.Enabled {
+		required("telemetry.servicename", c.Telemetry.ServiceName)
+	}
+
+	required("encryption.keyid", c.Encryption.KeyID)
+	required("encryption.keys", c.Encryption.Keys)
+
+	positive("placeoffers.expiry", c.PlaceOffers.Expiry)
+	positive("placeoffers.interval", c.PlaceOffers.Interval)
+
+	rateLimit("ratelimits.joinrequests", c.RateLimits.JoinRequests)
+	rateLimit("ratelimits.parentlogins", c.RateLimits.ParentLogins)
+
+	positive("reload.interval", c.Reload.Interval)
+
+	return errors.Join(errs...)
+}
+
+// EnvVar is the environment variable that overrides the key.
+func EnvVar(key string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
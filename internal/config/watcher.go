@@ -0,0 +1,186 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"os"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentation = "github.com/girlguidingstaplehurst/district/internal/config"
+
+// Reload outcomes, recorded on the config.reloads metric.
+const (
+	ReloadApplied  = "applied"
+	ReloadRejected = "rejected"
+)
+
+// ChangeFunc is called with the new config, and the keys of the settings that changed, such as "captcha.armed".
+type ChangeFunc func(cfg *Config, changed []string)
+
+// Watcher reloads the config when its file, or a secret named by a _FILE environment variable, changes. Kubernetes
+// updates mounted secrets by swapping a symlink, which notifications on the file itself miss, so the files are polled.
+//
+// A reloaded config is only used if it's valid. Otherwise the last valid config is kept.
+type Watcher struct {
+	path    string
+	current atomic.Pointer[Config]
+	reloads metric.Int64Counter
+
+	mu          sync.Mutex
+	fingerprint map[string][sha256.Size]byte
+	onChange    []ChangeFunc
+}
+
+// NewWatcher creates a Watcher for the config Load read from path, which must already be valid.
+func NewWatcher(path string, cfg *Config) (*Watcher, error) {
+	reloads, err := otel.Meter(instrumentation).Int64Counter("config.reloads",
+		metric.WithDescription("Config reloads after its file or secrets changed, by outcome."),
+		metric.WithUnit("{reload}"))
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		path:        path,
+		reloads:     reloads,
+		fingerprint: fingerprint(path),
+	}
+	w.current.Store(cfg)
+
+	return w, nil
+}
+
+// Current is the last valid config loaded.
+func (w *Watcher) Current() *Config {
+	return w.current.Load()
+}
+
+// OnChange calls fn whenever a reload changes the config.
+func (w *Watcher) OnChange(fn ChangeFunc) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.onChange = append(w.onChange, fn)
+}
+
+// Run checks the files for changes every interval until the context is done.
+func (w *Watcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Check(ctx); err != nil {
+				slog.Error("failed to reload config, keeping the previous config", "err", err)
+			}
+		}
+	}
+}
+
+// Check reloads the config if any of its files have changed since they were last read.
+func (w *Watcher) Check(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	latest := fingerprint(w.path)
+	if reflect.DeepEqual(latest, w.fingerprint) {
+		return nil
+	}
+
+	// A rejected config isn't tried again until its files change once more.
+	w.fingerprint = latest
+
+	return w.reload(ctx)
+}
+
+func (w *Watcher) reload(ctx context.Context) error {
+	ctx, span := otel.Tracer(instrumentation).Start(ctx, "config.Reload")
+	defer span.End()
+
+	cfg := new(Config)
+	err := Load(cfg, w.path)
+	if err == nil {
+		err = cfg.Validate()
+	}
+
+	if err != nil {
+		w.reloads.Add(ctx, 1, metric.WithAttributes(attribute.String("outcome", ReloadRejected)))
+		span.AddEvent("config.rejected", trace.WithAttributes(attribute.String("error", err.Error())))
+		return err
+	}
+
+	changed := Diff(w.current.Load(), cfg)
+	if len(changed) == 0 {
+		return nil
+	}
+
+	w.current.Store(cfg)
+	w.reloads.Add(ctx, 1, metric.WithAttributes(attribute.String("outcome", ReloadApplied)))
+	span.AddEvent("config.reloaded", trace.WithAttributes(attribute.StringSlice("config.changed", changed)))
+	slog.Info("config reloaded", "changed", changed)
+
+	for _, fn := range w.onChange {
+		fn(cfg, changed)
+	}
+
+	return nil
+}
+
+// fingerprint hashes the config file and secret files, so changes to them can be spotted. Files that can't be read
+// are given the zero hash, so they're reloaded, and the error reported, once they change.
+func fingerprint(path string) map[string][sha256.Size]byte {
+	files := secretFiles()
+	if path = configFile(path); path != "" {
+		files = append(files, path)
+	}
+
+	hashes := make(map[string][sha256.Size]byte, len(files))
+	for _, file := range files {
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			hashes[file] = [sha256.Size]byte{}
+			continue
+		}
+
+		hashes[file] = sha256.Sum256(contents)
+	}
+
+	return hashes
+}
+
+// Diff lists the keys of the settings that differ between the configs, in the order they're declared. Lists, such as
+// auth.providers, are compared as a whole.
+func Diff(old, updated *Config) []string {
+	var changed []string
+	diff(reflect.ValueOf(*old), reflect.ValueOf(*updated), "", &changed)
+
+	return changed
+}
+
+func diff(old, updated reflect.Value, prefix string, changed *[]string) {
+	for i := range old.NumField() {
+		key := prefix + old.Type().Field(i).Tag.Get("koanf")
+
+		if old.Field(i).Kind() == reflect.Struct {
+			diff(old.Field(i), updated.Field(i), key+".", changed)
+			continue
+		}
+
+		if !reflect.DeepEqual(old.Field(i).Interface(), updated.Field(i).Interface()) {
+			*changed = append(*changed, key)
+		}
+	}
+}
@@ -2,9 +2,25 @@ package consts
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
 	//ErrBookingExists occurs when an existing booking overlaps the proposed dates
 	ErrBookingExists = errors.New("a booking exists for these dates")
+
+	//ErrNotFound occurs when the requested record does not exist
+	ErrNotFound = errors.New("not found")
+
+	//ErrConflict occurs when a record is not in the right state for the requested change
+	ErrConflict = errors.New("conflict")
+
+	//ErrInvalidToken occurs when an authentication token can't be trusted
+	ErrInvalidToken = errors.New("invalid token")
+
+	//ErrTokenExpired occurs when an authentication token has expired, and the client needs a new one
+	ErrTokenExpired = fmt.Errorf("%w: expired", ErrInvalidToken)
+
+	//ErrEmailNotVerified occurs when an authentication token's issuer hasn't verified its email
+	ErrEmailNotVerified = fmt.Errorf("%w: email not verified", ErrInvalidToken)
 )
@@ -10,6 +10,54 @@ const (
 	InvoiceStatusPaid      = "paid"
 	InvoiceStatusCancelled = "cancelled"
 
+	SignupStatusConfirmed  = "confirmed"
+	SignupStatusWaitlisted = "waitlisted"
+	SignupStatusCancelled  = "cancelled"
+
+	SectionRainbows = "rainbows"
+	SectionBrownies = "brownies"
+	SectionGuides   = "guides"
+	SectionRangers  = "rangers"
+
+	ActivityMeeting     = "meeting"
+	ActivityOuting      = "outing"
+	ActivityResidential = "residential"
+
+	RatioEnforcementFlag  = "flag"
+	RatioEnforcementBlock = "block"
+
+	JoinRequestStatusWaiting  = "waiting"
+	JoinRequestStatusOffered  = "offered"
+	JoinRequestStatusAccepted = "accepted"
+	JoinRequestStatusDeclined = "declined"
+	JoinRequestStatusExpired  = "expired"
+
+	PlaceOfferStatusOffered  = "offered"
+	PlaceOfferStatusAccepted = "accepted"
+	PlaceOfferStatusDeclined = "declined"
+	PlaceOfferStatusExpired  = "expired"
+
+	RoleDistrictCommissioner = "district_commissioner"
+	RoleUnitLeader           = "unit_leader"
+	RoleTreasurer            = "treasurer"
+	RoleHelper               = "helper"
+
+	// Reasons given to clients when an admin or parent request can't be authenticated.
+	AuthReasonMissingToken     = "missing_token"
+	AuthReasonInvalidRequest   = "invalid_request"
+	AuthReasonInvalidToken     = "invalid_token"
+	AuthReasonExpiredToken     = "expired_token"
+	AuthReasonEmailNotVerified = "email_not_verified"
+	AuthReasonNotAllowed       = "account_not_allowed"
+	AuthReasonNoRoles          = "no_roles"
+	AuthReasonUnavailable      = "auth_unavailable"
+	AuthReasonSessionExpired   = "session_expired"
+	AuthReasonInvalidCSRFToken = "invalid_csrf_token"
+	AuthReasonRevoked          = "access_revoked"
+
+	// ActorSystem is recorded as the actor for changes the service makes by itself, such as expiring offers.
+	ActorSystem = "system"
+
 	RateDefault = "default"
 
 	ReferenceLetters = "ABCDEFGHJLMPQRSTUVWYZ23456789"
@@ -0,0 +1,54 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFillEmail(t *testing.T) {
+	t.Run("names can't add markup to the body", func(t *testing.T) {
+		email := rest.EmailContent{
+			Subject: "{{.ParticipantName}} is signed up",
+			Body:    "<p>Hi {{.ContactName}}, {{.ParticipantName}} is signed up.</p>",
+		}
+
+		filled, err := fillEmail(email, map[string]any{
+			"ParticipantName": `<a href="https://evil.example">Ada</a>`,
+			"ContactName":     "Grace",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, `<a href="https://evil.example">Ada</a> is signed up`, filled.Subject, "subjects are plain text")
+		assert.NotContains(t, filled.Body, "<a href")
+		assert.Contains(t, filled.Body, "&lt;a href=&#34;https://evil.example&#34;&gt;Ada&lt;/a&gt; is signed up")
+	})
+	t.Run("join request names are escaped", func(t *testing.T) {
+		email := rest.EmailContent{
+			Subject: "We've got your request",
+			Body:    "<p>Dear {{.ParentName}}, {{.ChildName}} is on the waiting list.</p>",
+		}
+
+		filled, err := fillEmail(email, map[string]any{
+			"ChildName":  "Ada",
+			"ParentName": `<a href="https://evil.example">Sign in</a>`,
+		})
+		require.NoError(t, err)
+
+		assert.NotContains(t, filled.Body, "<a href")
+		assert.Contains(t, filled.Body, "Dear &lt;a href=")
+	})
+	t.Run("sign in links keep working", func(t *testing.T) {
+		email := rest.EmailContent{
+			Subject: "Sign in to District",
+			Body:    `<p><a href="https://district.example/family/sign-in?token={{.LoginToken}}" target="_blank">Sign in</a></p>`,
+		}
+
+		filled, err := fillEmail(email, map[string]any{"LoginToken": "Abc123"})
+		require.NoError(t, err)
+
+		assert.Contains(t, filled.Body, `<a href="https://district.example/family/sign-in?token=Abc123" target="_blank">`)
+	})
+}
@@ -2,8 +2,11 @@ package content
 
 import (
 	"context"
+	"fmt"
+	"html/template"
+	"io"
 	"strings"
-	"text/template"
+	texttemplate "text/template"
 	"time"
 
 	"github.com/girlguidingstaplehurst/district/internal/pdf"
@@ -44,6 +47,10 @@ func (m *Manager) Email(ctx context.Context, key string) (rest.EmailContent, err
 		return rest.EmailContent{}, err
 	}
 
+	if len(q.EmailCollection.Items) == 0 {
+		return rest.EmailContent{}, fmt.Errorf("no email named %q", key)
+	}
+
 	i := q.EmailCollection.Items[0]
 
 	extensions := parser.CommonExtensions | parser.AutoHeadingIDs | parser.NoEmptyLineBeforeBlock
@@ -69,31 +76,56 @@ func (m *Manager) EmailTemplate(ctx context.Context, key string, vars map[string
 		return rest.EmailContent{}, err
 	}
 
-	subject, err := m.applyTemplate(emailTemplate.Subject, vars)
+	return fillEmail(emailTemplate, vars)
+}
+
+// fillEmail fills in the email's subject and body. Values such as names come from the public, so they're escaped in
+// the HTML body, where they could otherwise add links or markup to email sent in the district's name.
+func fillEmail(email rest.EmailContent, vars map[string]any) (rest.EmailContent, error) {
+	subject, err := texttemplate.New("subject").Parse(email.Subject)
 	if err != nil {
 		return rest.EmailContent{}, err
 	}
 
-	body, err := m.applyTemplate(emailTemplate.Body, vars)
+	body, err := template.New("body").Parse(email.Body)
 	if err != nil {
 		return rest.EmailContent{}, err
 	}
 
-	return rest.EmailContent{
-		Subject: subject,
-		Body:    body,
-	}, nil
+	var filled rest.EmailContent
+	if filled.Subject, err = execute(subject, vars); err != nil {
+		return rest.EmailContent{}, err
+	}
+
+	if filled.Body, err = execute(body, vars); err != nil {
+		return rest.EmailContent{}, err
+	}
+
+	return filled, nil
 }
 
-func (m *Manager) applyTemplate(body string, vars map[string]any) (string, error) {
-	tpl, err := template.New("tpl").Parse(body)
+// CheckEmail makes sure the email exists and its subject and body are valid templates, without sending it.
+func (m *Manager) CheckEmail(ctx context.Context, key string) error {
+	emailTemplate, err := m.Email(ctx, key)
 	if err != nil {
-		return "", err
+		return err
+	}
+
+	if _, err := texttemplate.New("subject").Parse(emailTemplate.Subject); err != nil {
+		return fmt.Errorf("subject: %w", err)
 	}
 
+	if _, err := template.New("body").Parse(emailTemplate.Body); err != nil {
+		return fmt.Errorf("body: %w", err)
+	}
+
+	return nil
+}
+
+// execute runs a text or HTML template.
+func execute(tpl interface{ Execute(io.Writer, any) error }, vars map[string]any) (string, error) {
 	w := strings.Builder{}
-	err = tpl.Execute(&w, vars)
-	if err != nil {
+	if err := tpl.Execute(&w, vars); err != nil {
 		return "", err
 	}
 
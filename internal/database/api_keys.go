@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const apiKeyColumns = `id, name, owner, scopes, created_at, expires_at, last_used_at, last_used_ip`
+
+func (d *Database) ListAPIKeys(ctx context.Context, owner *string) ([]rest.APIKey, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+apiKeyColumns+` FROM api_keys
+		WHERE $1::text IS NULL OR owner = lower($1)
+		ORDER BY owner, name`,
+		owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanAPIKey)
+}
+
+func (d *Database) GetAPIKey(ctx context.Context, id uuid.UUID) (rest.APIKey, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE id = $1`, id)
+	if err != nil {
+		return rest.APIKey{}, err
+	}
+
+	key, err := pgx.CollectExactlyOneRow(rows, scanAPIKey)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.APIKey{}, consts.ErrNotFound
+	}
+
+	return key, err
+}
+
+func (d *Database) FindAPIKey(ctx context.Context, keyHash []byte, now time.Time) (rest.APIKey, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE key_hash = $1 AND expires_at > $2`,
+		keyHash, now)
+	if err != nil {
+		return rest.APIKey{}, err
+	}
+
+	key, err := pgx.CollectExactlyOneRow(rows, scanAPIKey)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.APIKey{}, consts.ErrNotFound
+	}
+
+	return key, err
+}
+
+func (d *Database) CreateAPIKey(ctx context.Context, key rest.APIKeyInput, owner string, keyHash []byte) (rest.APIKey, error) {
+	rows, err := d.pool.Query(ctx, `INSERT INTO api_keys (name, owner, key_hash, scopes, expires_at)
+		VALUES ($1, lower($2), $3, $4, $5)
+		RETURNING `+apiKeyColumns,
+		key.Name, owner, keyHash, key.Scopes, key.ExpiresAt)
+	if err != nil {
+		return rest.APIKey{}, err
+	}
+
+	created, err := pgx.CollectExactlyOneRow(rows, scanAPIKey)
+	if pgErr := new(pgconn.PgError); errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return rest.APIKey{}, consts.ErrConflict
+	}
+
+	return created, err
+}
+
+func (d *Database) DeleteAPIKey(ctx context.Context, id uuid.UUID) error {
+	tag, err := d.pool.Exec(ctx, `DELETE FROM api_keys WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return consts.ErrNotFound
+	}
+
+	return nil
+}
+
+func (d *Database) TouchAPIKey(ctx context.Context, id uuid.UUID, now time.Time, ip string) error {
+	_, err := d.pool.Exec(ctx, `UPDATE api_keys SET last_used_at = $2, last_used_ip = $3 WHERE id = $1`, id, now, ip)
+
+	return err
+}
+
+func scanAPIKey(row pgx.CollectableRow) (rest.APIKey, error) {
+	var k rest.APIKey
+	err := row.Scan(&k.Id, &k.Name, &k.Owner, &k.Scopes, &k.CreatedAt, &k.ExpiresAt, &k.LastUsedAt, &k.LastUsedIP)
+
+	return k, err
+}
@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/jackc/pgx/v5"
+)
+
+const auditColumns = `id, occurred_at, actor, credential, ip, action, target_type, target_id, changes, method, path,
+	status, trace_id`
+
+func (d *Database) AddAuditEntry(ctx context.Context, entry rest.AuditEntry) error {
+	_, err := d.pool.Exec(ctx, `INSERT INTO audit_log
+		(occurred_at, actor, credential, ip, action, target_type, target_id, changes, method, path, status, trace_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		entry.OccurredAt, entry.Actor, entry.Credential, entry.Ip, entry.Action, entry.TargetType, entry.TargetId,
+		entry.Changes, entry.Method, entry.Path, entry.Status, entry.TraceId)
+
+	return err
+}
+
+func (d *Database) ListAuditEntries(ctx context.Context, filter rest.AuditFilter) ([]rest.AuditEntry, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+auditColumns+` FROM audit_log
+		WHERE ($1::text IS NULL OR actor = lower($1))
+		  AND ($2::text IS NULL OR action = $2)
+		  AND ($3::text IS NULL OR target_type = $3)
+		  AND ($4::text IS NULL OR target_id = $4)
+		  AND ($5::timestamptz IS NULL OR occurred_at >= $5)
+		  AND ($6::timestamptz IS NULL OR occurred_at < $6)
+		  AND ($7::bigint IS NULL OR id < $7)
+		ORDER BY id DESC
+		LIMIT $8`,
+		filter.Actor, filter.Action, filter.TargetType, filter.TargetID, filter.Since, filter.Until, filter.Before,
+		filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanAuditEntry)
+}
+
+func scanAuditEntry(row pgx.CollectableRow) (rest.AuditEntry, error) {
+	var e rest.AuditEntry
+	err := row.Scan(&e.Id, &e.OccurredAt, &e.Actor, &e.Credential, &e.Ip, &e.Action, &e.TargetType, &e.TargetId,
+		&e.Changes, &e.Method, &e.Path, &e.Status, &e.TraceId)
+
+	return e, err
+}
@@ -0,0 +1,18 @@
+package database
+
+import (
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ rest.Database = (*Database)(nil)
+
+type Database struct {
+	pool *pgxpool.Pool
+}
+
+func NewDatabase(pool *pgxpool.Pool) *Database {
+	return &Database{
+		pool: pool,
+	}
+}
@@ -0,0 +1,194 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const eventColumns = `id, title, description, location, unit, starts_at, ends_at, status, created_by, created_at,
+	updated_at, activity, adult_helpers, ratio_enforcement, signups_enabled, signup_capacity, signup_deadline,
+	signup_sections, signup_min_age, signup_max_age,
+	(SELECT count(*) FROM event_signups s WHERE s.event_id = events.id AND s.status = 'waitlisted'),
+	(SELECT coalesce(jsonb_object_agg(c.section, c.n), '{}') FROM (
+		SELECT section, count(*) AS n FROM event_signups s
+		WHERE s.event_id = events.id AND s.status = 'confirmed'
+		GROUP BY section
+	) c)`
+
+func (d *Database) ListEvents(ctx context.Context, filter rest.EventFilter) ([]rest.AdminEvent, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+eventColumns+` FROM events
+		WHERE ends_at >= $1 AND starts_at < $2
+		  AND ($3::text IS NULL OR unit IS NULL OR unit = $3)
+		  AND (coalesce(cardinality($4::text[]), 0) = 0 OR status = ANY($4))
+		ORDER BY starts_at, title`,
+		filter.From, filter.To, filter.Unit, filter.Statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanEvent)
+}
+
+func (d *Database) GetEvent(ctx context.Context, id uuid.UUID) (rest.AdminEvent, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+eventColumns+` FROM events WHERE id = $1`, id)
+	if err != nil {
+		return rest.AdminEvent{}, err
+	}
+
+	return collectOneEvent(rows)
+}
+
+func (d *Database) CreateEvent(ctx context.Context, event rest.EventInput, createdBy string) (rest.AdminEvent, error) {
+	s := newSignupSettings(event.Signups)
+
+	rows, err := d.pool.Query(ctx, `INSERT INTO events (title, description, location, unit, starts_at, ends_at, status,
+			created_by, activity, adult_helpers, ratio_enforcement, signups_enabled, signup_capacity, signup_deadline,
+			signup_sections, signup_min_age, signup_max_age)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		RETURNING `+eventColumns,
+		event.Title, event.Description, event.Location, event.Unit, event.Start, event.End, event.Status, createdBy,
+		event.Activity, event.AdultHelpers, event.RatioEnforcement, s.enabled, s.capacity, s.deadline, s.sections,
+		s.minAge, s.maxAge)
+	if err != nil {
+		return rest.AdminEvent{}, err
+	}
+
+	return collectOneEvent(rows)
+}
+
+func (d *Database) UpdateEvent(ctx context.Context, id uuid.UUID, event rest.EventInput) (rest.AdminEvent, error) {
+	s := newSignupSettings(event.Signups)
+
+	rows, err := d.pool.Query(ctx, `UPDATE events
+		SET title = $2, description = $3, location = $4, unit = $5, starts_at = $6, ends_at = $7, status = $8,
+		    activity = $9, adult_helpers = $10, ratio_enforcement = $11, signups_enabled = $12,
+		    signup_capacity = $13, signup_deadline = $14, signup_sections = $15, signup_min_age = $16,
+		    signup_max_age = $17, updated_at = now()
+		WHERE id = $1
+		RETURNING `+eventColumns,
+		id, event.Title, event.Description, event.Location, event.Unit, event.Start, event.End, event.Status,
+		event.Activity, event.AdultHelpers, event.RatioEnforcement, s.enabled, s.capacity, s.deadline, s.sections,
+		s.minAge, s.maxAge)
+	if err != nil {
+		return rest.AdminEvent{}, err
+	}
+
+	return collectOneEvent(rows)
+}
+
+func (d *Database) DeleteEvent(ctx context.Context, id uuid.UUID) error {
+	tag, err := d.pool.Exec(ctx, `DELETE FROM events WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return consts.ErrNotFound
+	}
+
+	return nil
+}
+
+// signupSettings is the column representation of rest.EventSignupSettings.
+type signupSettings struct {
+	enabled  bool
+	capacity *int
+	deadline *time.Time
+	sections []string
+	minAge   *int
+	maxAge   *int
+}
+
+func newSignupSettings(settings *rest.EventSignupSettings) signupSettings {
+	if settings == nil {
+		return signupSettings{sections: []string{}}
+	}
+
+	s := signupSettings{
+		enabled:  true,
+		capacity: settings.Capacity,
+		deadline: &settings.Deadline,
+		sections: []string{},
+		minAge:   settings.MinAge,
+		maxAge:   settings.MaxAge,
+	}
+
+	if settings.Sections != nil {
+		for _, section := range *settings.Sections {
+			s.sections = append(s.sections, string(section))
+		}
+	}
+
+	return s
+}
+
+func (s signupSettings) toREST() *rest.EventSignupSettings {
+	if !s.enabled {
+		return nil
+	}
+
+	settings := &rest.EventSignupSettings{
+		Capacity: s.capacity,
+		MinAge:   s.minAge,
+		MaxAge:   s.maxAge,
+	}
+
+	if s.deadline != nil {
+		settings.Deadline = *s.deadline
+	}
+
+	if len(s.sections) > 0 {
+		sections := make([]rest.Section, 0, len(s.sections))
+		for _, section := range s.sections {
+			sections = append(sections, rest.Section(section))
+		}
+		settings.Sections = &sections
+	}
+
+	return settings
+}
+
+func collectOneEvent(rows pgx.Rows) (rest.AdminEvent, error) {
+	event, err := pgx.CollectExactlyOneRow(rows, scanEvent)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.AdminEvent{}, consts.ErrNotFound
+	}
+
+	return event, err
+}
+
+func scanEvent(row pgx.CollectableRow) (rest.AdminEvent, error) {
+	var (
+		e          rest.AdminEvent
+		s          signupSettings
+		waitlisted int
+		bySection  map[string]int
+	)
+
+	err := row.Scan(&e.Id, &e.Title, &e.Description, &e.Location, &e.Unit, &e.Start, &e.End, &e.Status,
+		&e.CreatedBy, &e.CreatedAt, &e.UpdatedAt, &e.Activity, &e.AdultHelpers, &e.RatioEnforcement, &s.enabled,
+		&s.capacity, &s.deadline, &s.sections, &s.minAge, &s.maxAge, &waitlisted, &bySection)
+	if err != nil {
+		return rest.AdminEvent{}, err
+	}
+
+	e.Signups = s.toREST()
+	if e.Signups != nil {
+		confirmed := 0
+		for _, n := range bySection {
+			confirmed += n
+		}
+
+		e.ConfirmedSignups = &confirmed
+		e.WaitlistedSignups = &waitlisted
+		e.ConfirmedBySection = &bySection
+	}
+
+	return e, nil
+}
@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const invitationColumns = `id, email, invited_by, created_at, expires_at, accepted_at`
+
+func (d *Database) ListInvitations(ctx context.Context) ([]rest.Invitation, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+invitationColumns+` FROM admin_invitations ORDER BY email`)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanInvitation)
+}
+
+func (d *Database) CreateInvitation(ctx context.Context, invitation rest.InvitationInput, invitedBy string, expiresAt time.Time) (rest.Invitation, error) {
+	// An invitation that expired without being accepted is replaced, rather than needing to be removed first.
+	rows, err := d.pool.Query(ctx, `INSERT INTO admin_invitations (email, invited_by, expires_at)
+		VALUES (lower($1), $2, $3)
+		ON CONFLICT (email) DO UPDATE SET invited_by = excluded.invited_by, created_at = now(), expires_at = excluded.expires_at
+		WHERE admin_invitations.accepted_at IS NULL AND admin_invitations.expires_at < now()
+		RETURNING `+invitationColumns,
+		invitation.Email, invitedBy, expiresAt)
+	if err != nil {
+		return rest.Invitation{}, err
+	}
+
+	created, err := pgx.CollectExactlyOneRow(rows, scanInvitation)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.Invitation{}, consts.ErrConflict
+	}
+
+	return created, err
+}
+
+func (d *Database) DeleteInvitation(ctx context.Context, id uuid.UUID) error {
+	tag, err := d.pool.Exec(ctx, `DELETE FROM admin_invitations WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return consts.ErrNotFound
+	}
+
+	return nil
+}
+
+func (d *Database) AcceptInvitation(ctx context.Context, email string, now time.Time) error {
+	tag, err := d.pool.Exec(ctx, `UPDATE admin_invitations SET accepted_at = coalesce(accepted_at, $2)
+		WHERE email = lower($1) AND (accepted_at IS NOT NULL OR expires_at > $2)`,
+		email, now)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return consts.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanInvitation(row pgx.CollectableRow) (rest.Invitation, error) {
+	var i rest.Invitation
+	err := row.Scan(&i.Id, &i.Email, &i.InvitedBy, &i.CreatedAt, &i.ExpiresAt, &i.AcceptedAt)
+
+	return i, err
+}
@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const joinRequestColumns = `id, child_name, date_of_birth, postcode, preferred_units, eligible_sections, parent_name,
+	parent_email, parent_phone, notes, status, created_at`
+
+func (d *Database) AddJoinRequest(ctx context.Context, joinRequest rest.JoinRequestInput, eligible []rest.Section, ip string) (uuid.UUID, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var id uuid.UUID
+	err = tx.QueryRow(ctx, `INSERT INTO join_requests (child_name, date_of_birth, postcode, preferred_units,
+			eligible_sections, parent_name, parent_email, parent_phone, notes, status, ip)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id`,
+		joinRequest.ChildName, joinRequest.DateOfBirth.Time, joinRequest.Postcode, joinRequest.PreferredUnits,
+		eligible, joinRequest.ParentName, joinRequest.ParentEmail, joinRequest.ParentPhone, joinRequest.Notes,
+		consts.JoinRequestStatusWaiting, ip).Scan(&id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = addJoinRequestEvent(ctx, tx, id, nil, "registered", consts.JoinRequestStatusWaiting,
+		string(joinRequest.ParentEmail))
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return id, tx.Commit(ctx)
+}
+
+func (d *Database) CountJoinRequestsFromIP(ctx context.Context, ip string, since time.Time) (int, error) {
+	var count int
+	err := d.pool.QueryRow(ctx, `SELECT count(*) FROM join_requests WHERE ip = $1 AND created_at >= $2`,
+		ip, since).Scan(&count)
+
+	return count, err
+}
+
+func (d *Database) GetJoinRequest(ctx context.Context, id uuid.UUID) (rest.JoinRequest, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+joinRequestColumns+` FROM join_requests WHERE id = $1`, id)
+	if err != nil {
+		return rest.JoinRequest{}, err
+	}
+
+	joinRequest, err := pgx.CollectExactlyOneRow(rows, scanJoinRequest)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.JoinRequest{}, consts.ErrNotFound
+	}
+
+	return joinRequest, err
+}
+
+func (d *Database) ListWaitingList(ctx context.Context, unitID string) ([]rest.JoinRequest, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+joinRequestColumns+` FROM join_requests
+		WHERE status = 'waiting' AND $1 = ANY(preferred_units)
+		ORDER BY created_at`,
+		unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanJoinRequest)
+}
+
+func (d *Database) ListJoinRequestEvents(ctx context.Context, joinRequestID uuid.UUID) ([]rest.JoinRequestEvent, error) {
+	var exists bool
+	err := d.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM join_requests WHERE id = $1)`, joinRequestID).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, consts.ErrNotFound
+	}
+
+	rows, err := d.pool.Query(ctx, `SELECT action, status, offer_id, actor, created_at FROM join_request_events
+		WHERE join_request_id = $1
+		ORDER BY id`,
+		joinRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (rest.JoinRequestEvent, error) {
+		var e rest.JoinRequestEvent
+		err := row.Scan(&e.Action, &e.Status, &e.OfferId, &e.Actor, &e.CreatedAt)
+		return e, err
+	})
+}
+
+// addJoinRequestEvent records a change to a join request, or one of its place offers, in its history.
+func addJoinRequestEvent(ctx context.Context, tx pgx.Tx, joinRequestID uuid.UUID, offerID *uuid.UUID, action, status, actor string) error {
+	_, err := tx.Exec(ctx, `INSERT INTO join_request_events (join_request_id, offer_id, action, status, actor)
+		VALUES ($1, $2, $3, $4, $5)`,
+		joinRequestID, offerID, action, status, actor)
+
+	return err
+}
+
+func scanJoinRequest(row pgx.CollectableRow) (rest.JoinRequest, error) {
+	var j rest.JoinRequest
+	err := row.Scan(&j.Id, &j.ChildName, &j.DateOfBirth.Time, &j.Postcode, &j.PreferredUnits, &j.EligibleSections,
+		&j.ParentName, &j.ParentEmail, &j.ParentPhone, &j.Notes, &j.Status, &j.CreatedAt)
+
+	return j, err
+}
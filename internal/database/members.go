@@ -0,0 +1,206 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const memberColumns = `id, name, date_of_birth, unit, parent_name, parent_email, parent_phone, joined_at`
+
+func (d *Database) ListMembers(ctx context.Context, unitID *string) ([]rest.Member, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+memberColumns+` FROM members
+		WHERE $1::text IS NULL OR unit = $1
+		ORDER BY date_of_birth, name`,
+		unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanMember)
+}
+
+func (d *Database) GetMember(ctx context.Context, id uuid.UUID) (rest.Member, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+memberColumns+` FROM members WHERE id = $1`, id)
+	if err != nil {
+		return rest.Member{}, err
+	}
+
+	member, err := pgx.CollectExactlyOneRow(rows, scanMember)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.Member{}, consts.ErrNotFound
+	}
+
+	return member, err
+}
+
+func (d *Database) TransferMember(ctx context.Context, memberID uuid.UUID, toUnit, transferredBy string) (rest.Member, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return rest.Member{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the unit so that concurrent transfers can't take it over capacity.
+	var full bool
+	err = tx.QueryRow(ctx, `SELECT capacity IS NOT NULL AND capacity <= (SELECT count(*) FROM members WHERE unit = $1)
+		FROM units
+		WHERE id = $1
+		FOR UPDATE`,
+		toUnit).Scan(&full)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.Member{}, consts.ErrNotFound
+	}
+	if err != nil {
+		return rest.Member{}, err
+	}
+
+	if full {
+		return rest.Member{}, consts.ErrConflict
+	}
+
+	var fromUnit string
+	err = tx.QueryRow(ctx, `SELECT unit FROM members WHERE id = $1 FOR UPDATE`, memberID).Scan(&fromUnit)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.Member{}, consts.ErrNotFound
+	}
+	if err != nil {
+		return rest.Member{}, err
+	}
+
+	rows, err := tx.Query(ctx, `UPDATE members SET unit = $2, updated_at = now()
+		WHERE id = $1
+		RETURNING `+memberColumns,
+		memberID, toUnit)
+	if err != nil {
+		return rest.Member{}, err
+	}
+
+	member, err := pgx.CollectExactlyOneRow(rows, scanMember)
+	if err != nil {
+		return rest.Member{}, err
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO member_transfers (member_id, from_unit, to_unit, transferred_by)
+		VALUES ($1, $2, $3, $4)`,
+		memberID, fromUnit, toUnit, transferredBy)
+	if err != nil {
+		return rest.Member{}, err
+	}
+
+	return member, tx.Commit(ctx)
+}
+
+func (d *Database) CreateMember(ctx context.Context, unitID string, member rest.MemberInput) (rest.Member, error) {
+	rows, err := d.pool.Query(ctx, `INSERT INTO members (name, date_of_birth, unit, parent_name, parent_email, parent_phone)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+memberColumns,
+		member.Name, member.DateOfBirth.Time, unitID, member.ParentName, member.ParentEmail, member.ParentPhone)
+	if err != nil {
+		return rest.Member{}, err
+	}
+
+	return pgx.CollectExactlyOneRow(rows, scanMember)
+}
+
+func (d *Database) UpdateMember(ctx context.Context, id uuid.UUID, member rest.MemberInput) (rest.Member, error) {
+	rows, err := d.pool.Query(ctx, `UPDATE members
+		SET name = $2, date_of_birth = $3, parent_name = $4, parent_email = $5, parent_phone = $6, updated_at = now()
+		WHERE id = $1
+		RETURNING `+memberColumns,
+		id, member.Name, member.DateOfBirth.Time, member.ParentName, member.ParentEmail, member.ParentPhone)
+	if err != nil {
+		return rest.Member{}, err
+	}
+
+	updated, err := pgx.CollectExactlyOneRow(rows, scanMember)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.Member{}, consts.ErrNotFound
+	}
+
+	return updated, err
+}
+
+func (d *Database) GetMemberSensitive(ctx context.Context, id uuid.UUID) (rest.EncryptedData, error) {
+	var (
+		data  rest.EncryptedData
+		keyID *string
+	)
+
+	err := d.pool.QueryRow(ctx, `SELECT sensitive, sensitive_key_id FROM members WHERE id = $1`, id).
+		Scan(&data.Ciphertext, &keyID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.EncryptedData{}, consts.ErrNotFound
+	}
+	if err != nil {
+		return rest.EncryptedData{}, err
+	}
+
+	if keyID != nil {
+		data.KeyID = *keyID
+	}
+
+	return data, nil
+}
+
+func (d *Database) SetMemberSensitive(ctx context.Context, id uuid.UUID, data rest.EncryptedData) error {
+	tag, err := d.pool.Exec(ctx, `UPDATE members SET sensitive = $2, sensitive_key_id = $3, updated_at = now()
+		WHERE id = $1`,
+		id, data.Ciphertext, data.KeyID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return consts.ErrNotFound
+	}
+
+	return nil
+}
+
+func (d *Database) AddMemberAccess(ctx context.Context, memberID uuid.UUID, accessedBy, ip string) error {
+	_, err := d.pool.Exec(ctx, `INSERT INTO member_access_log (member_id, accessed_by, ip) VALUES ($1, $2, nullif($3, ''))`,
+		memberID, accessedBy, ip)
+
+	return err
+}
+
+func (d *Database) ListMemberAccess(ctx context.Context, memberID uuid.UUID) ([]rest.MemberAccess, error) {
+	rows, err := d.pool.Query(ctx, `SELECT accessed_by, ip, accessed_at FROM member_access_log
+		WHERE member_id = $1
+		ORDER BY accessed_at DESC`,
+		memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (rest.MemberAccess, error) {
+		var a rest.MemberAccess
+		err := row.Scan(&a.AccessedBy, &a.Ip, &a.AccessedAt)
+		return a, err
+	})
+}
+
+// addMemberFromOffer makes the child on an accepted offer a member of the offer's unit.
+func addMemberFromOffer(ctx context.Context, tx pgx.Tx, offer rest.PlaceOffer) error {
+	_, err := tx.Exec(ctx, `INSERT INTO members (join_request_id, name, date_of_birth, unit, parent_name, parent_email,
+			parent_phone)
+		SELECT id, child_name, date_of_birth, $2, parent_name, parent_email, parent_phone
+		FROM join_requests
+		WHERE id = $1`,
+		offer.JoinRequestId, offer.Unit)
+
+	return err
+}
+
+func scanMember(row pgx.CollectableRow) (rest.Member, error) {
+	var m rest.Member
+	err := row.Scan(&m.Id, &m.Name, &m.DateOfBirth.Time, &m.Unit, &m.ParentName, &m.ParentEmail, &m.ParentPhone,
+		&m.JoinedAt)
+
+	return m, err
+}
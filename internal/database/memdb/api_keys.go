@@ -0,0 +1,118 @@
+package memdb
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type apiKey struct {
+	rest.APIKey
+	keyHash []byte
+}
+
+func (d *Database) ListAPIKeys(_ context.Context, owner *string) ([]rest.APIKey, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var keys []rest.APIKey
+	for _, k := range d.apiKeys {
+		if owner == nil || sameEmail(k.Owner, *owner) {
+			keys = append(keys, k.APIKey)
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b rest.APIKey) int {
+		return cmp.Or(cmp.Compare(a.Owner, b.Owner), cmp.Compare(a.Name, b.Name))
+	})
+
+	return keys, nil
+}
+
+func (d *Database) GetAPIKey(_ context.Context, id uuid.UUID) (rest.APIKey, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := slices.IndexFunc(d.apiKeys, func(k *apiKey) bool { return k.Id == id })
+	if i < 0 {
+		return rest.APIKey{}, consts.ErrNotFound
+	}
+
+	return d.apiKeys[i].APIKey, nil
+}
+
+func (d *Database) FindAPIKey(_ context.Context, keyHash []byte, now time.Time) (rest.APIKey, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, k := range d.apiKeys {
+		if bytes.Equal(k.keyHash, keyHash) && k.ExpiresAt.After(now) {
+			return k.APIKey, nil
+		}
+	}
+
+	return rest.APIKey{}, consts.ErrNotFound
+}
+
+func (d *Database) CreateAPIKey(_ context.Context, key rest.APIKeyInput, owner string, keyHash []byte) (rest.APIKey, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	named := slices.ContainsFunc(d.apiKeys, func(k *apiKey) bool {
+		return sameEmail(k.Owner, owner) && k.Name == key.Name
+	})
+	if named {
+		return rest.APIKey{}, consts.ErrConflict
+	}
+
+	created := &apiKey{
+		APIKey: rest.APIKey{
+			Id:        uuid.New(),
+			Name:      key.Name,
+			Owner:     openapi_types.Email(strings.ToLower(owner)),
+			Scopes:    slices.Clone(key.Scopes),
+			CreatedAt: now(),
+			ExpiresAt: key.ExpiresAt,
+		},
+		keyHash: slices.Clone(keyHash),
+	}
+	d.apiKeys = append(d.apiKeys, created)
+
+	return created.APIKey, nil
+}
+
+func (d *Database) DeleteAPIKey(_ context.Context, id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := slices.IndexFunc(d.apiKeys, func(k *apiKey) bool { return k.Id == id })
+	if i < 0 {
+		return consts.ErrNotFound
+	}
+
+	d.apiKeys = slices.Delete(d.apiKeys, i, i+1)
+
+	return nil
+}
+
+func (d *Database) TouchAPIKey(_ context.Context, id uuid.UUID, now time.Time, ip string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, k := range d.apiKeys {
+		if k.Id == id {
+			k.LastUsedAt = &now
+			k.LastUsedIP = &ip
+		}
+	}
+
+	return nil
+}
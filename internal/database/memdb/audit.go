@@ -0,0 +1,45 @@
+package memdb
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+)
+
+func (d *Database) AddAuditEntry(_ context.Context, entry rest.AuditEntry) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry.Id = int64(len(d.audit)) + 1
+	d.audit = append(d.audit, entry)
+
+	return nil
+}
+
+func (d *Database) ListAuditEntries(_ context.Context, filter rest.AuditFilter) ([]rest.AuditEntry, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var entries []rest.AuditEntry
+	for _, e := range slices.Backward(d.audit) {
+		if len(entries) >= filter.Limit {
+			break
+		}
+
+		if filter.Actor != nil && e.Actor != strings.ToLower(*filter.Actor) ||
+			filter.Action != nil && e.Action != *filter.Action ||
+			filter.TargetType != nil && deref(e.TargetType) != *filter.TargetType ||
+			filter.TargetID != nil && deref(e.TargetId) != *filter.TargetID ||
+			filter.Since != nil && e.OccurredAt.Before(*filter.Since) ||
+			filter.Until != nil && !e.OccurredAt.Before(*filter.Until) ||
+			filter.Before != nil && e.Id >= *filter.Before {
+			continue
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,159 @@
+package memdb
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+func (d *Database) ListEvents(_ context.Context, filter rest.EventFilter) ([]rest.AdminEvent, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var events []rest.AdminEvent
+	for _, e := range d.events {
+		if e.End.Before(filter.From) || !e.Start.Before(filter.To) {
+			continue
+		}
+
+		if filter.Unit != nil && e.Unit != nil && *e.Unit != *filter.Unit {
+			continue
+		}
+
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, string(e.Status)) {
+			continue
+		}
+
+		events = append(events, d.event(e))
+	}
+
+	slices.SortFunc(events, func(a, b rest.AdminEvent) int {
+		return cmp.Or(a.Start.Compare(b.Start), cmp.Compare(a.Title, b.Title))
+	})
+
+	return events, nil
+}
+
+func (d *Database) GetEvent(_ context.Context, id uuid.UUID) (rest.AdminEvent, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	e, ok := d.events[id]
+	if !ok {
+		return rest.AdminEvent{}, consts.ErrNotFound
+	}
+
+	return d.event(e), nil
+}
+
+func (d *Database) CreateEvent(_ context.Context, event rest.EventInput, createdBy string) (rest.AdminEvent, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	created := now()
+	e := &rest.AdminEvent{Id: uuid.New(), CreatedBy: createdBy, CreatedAt: created}
+	setEvent(e, event, created)
+	d.events[e.Id] = e
+
+	return d.event(e), nil
+}
+
+func (d *Database) UpdateEvent(_ context.Context, id uuid.UUID, event rest.EventInput) (rest.AdminEvent, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	e, ok := d.events[id]
+	if !ok {
+		return rest.AdminEvent{}, consts.ErrNotFound
+	}
+
+	setEvent(e, event, now())
+
+	return d.event(e), nil
+}
+
+func (d *Database) DeleteEvent(_ context.Context, id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.events[id]; !ok {
+		return consts.ErrNotFound
+	}
+
+	delete(d.events, id)
+	d.signups = slices.DeleteFunc(d.signups, func(s *signup) bool { return s.EventId == id })
+
+	return nil
+}
+
+// setEvent copies the input to the event, with the defaults its columns have.
+func setEvent(e *rest.AdminEvent, event rest.EventInput, updatedAt time.Time) {
+	e.Title = event.Title
+	e.Description = event.Description
+	e.Location = event.Location
+	e.Unit = event.Unit
+	e.Start = event.Start
+	e.End = event.End
+	e.Status = event.Status
+	e.UpdatedAt = updatedAt
+	e.Activity = cmp.Or(deref(event.Activity), consts.ActivityMeeting)
+	e.AdultHelpers = deref(event.AdultHelpers)
+	e.RatioEnforcement = cmp.Or(deref(event.RatioEnforcement), consts.RatioEnforcementFlag)
+	e.Signups = nil
+
+	if event.Signups != nil {
+		settings := *event.Signups
+		if settings.Sections != nil {
+			if len(*settings.Sections) == 0 {
+				settings.Sections = nil
+			} else {
+				sections := slices.Clone(*settings.Sections)
+				settings.Sections = &sections
+			}
+		}
+		e.Signups = &settings
+	}
+}
+
+// event is a copy of the event, with its sign-ups counted if it takes them.
+func (d *Database) event(e *rest.AdminEvent) rest.AdminEvent {
+	event := *e
+	if event.Signups == nil {
+		return event
+	}
+
+	confirmed, waitlisted, bySection := 0, 0, map[string]int{}
+	for _, s := range d.signups {
+		if s.EventId != e.Id {
+			continue
+		}
+
+		switch string(s.Status) {
+		case consts.SignupStatusConfirmed:
+			confirmed++
+			bySection[string(s.Section)]++
+		case consts.SignupStatusWaitlisted:
+			waitlisted++
+		}
+	}
+
+	event.ConfirmedSignups = &confirmed
+	event.WaitlistedSignups = &waitlisted
+	event.ConfirmedBySection = &bySection
+
+	return event
+}
+
+func deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+
+	return *p
+}
@@ -0,0 +1,86 @@
+package memdb
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+func (d *Database) ListInvitations(_ context.Context) ([]rest.Invitation, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var invitations []rest.Invitation
+	for _, i := range d.invitations {
+		invitations = append(invitations, *i)
+	}
+
+	slices.SortFunc(invitations, func(a, b rest.Invitation) int { return cmp.Compare(a.Email, b.Email) })
+
+	return invitations, nil
+}
+
+func (d *Database) CreateInvitation(_ context.Context, invitation rest.InvitationInput, invitedBy string, expiresAt time.Time) (rest.Invitation, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	created := now()
+
+	i, ok := d.invitation(string(invitation.Email))
+	if !ok {
+		i = &rest.Invitation{Id: uuid.New(), Email: lower(invitation.Email)}
+		d.invitations = append(d.invitations, i)
+	} else if i.AcceptedAt != nil || !i.ExpiresAt.Before(created) {
+		return rest.Invitation{}, consts.ErrConflict
+	}
+
+	i.InvitedBy = invitedBy
+	i.CreatedAt = created
+	i.ExpiresAt = expiresAt
+
+	return *i, nil
+}
+
+func (d *Database) DeleteInvitation(_ context.Context, id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := slices.IndexFunc(d.invitations, func(i *rest.Invitation) bool { return i.Id == id })
+	if i < 0 {
+		return consts.ErrNotFound
+	}
+
+	d.invitations = slices.Delete(d.invitations, i, i+1)
+
+	return nil
+}
+
+func (d *Database) AcceptInvitation(_ context.Context, email string, now time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i, ok := d.invitation(email)
+	if !ok || (i.AcceptedAt == nil && !i.ExpiresAt.After(now)) {
+		return consts.ErrNotFound
+	}
+
+	if i.AcceptedAt == nil {
+		i.AcceptedAt = &now
+	}
+
+	return nil
+}
+
+func (d *Database) invitation(email string) (*rest.Invitation, bool) {
+	i := slices.IndexFunc(d.invitations, func(i *rest.Invitation) bool { return sameEmail(i.Email, email) })
+	if i < 0 {
+		return nil, false
+	}
+
+	return d.invitations[i], true
+}
@@ -0,0 +1,137 @@
+package memdb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+type joinRequest struct {
+	rest.JoinRequest
+	ip string
+}
+
+type joinRequestEvent struct {
+	joinRequestID uuid.UUID
+	rest.JoinRequestEvent
+}
+
+func (d *Database) AddJoinRequest(_ context.Context, input rest.JoinRequestInput, eligible []rest.Section, ip string) (uuid.UUID, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	j := &joinRequest{
+		JoinRequest: rest.JoinRequest{
+			Id:               uuid.New(),
+			ChildName:        input.ChildName,
+			DateOfBirth:      input.DateOfBirth,
+			Postcode:         input.Postcode,
+			PreferredUnits:   slices.Clone(input.PreferredUnits),
+			EligibleSections: slices.Clone(eligible),
+			ParentName:       input.ParentName,
+			ParentEmail:      input.ParentEmail,
+			ParentPhone:      input.ParentPhone,
+			Notes:            input.Notes,
+			Status:           consts.JoinRequestStatusWaiting,
+			CreatedAt:        now(),
+		},
+		ip: ip,
+	}
+	d.joinRequests = append(d.joinRequests, j)
+	d.addJoinRequestEvent(j.Id, nil, "registered", consts.JoinRequestStatusWaiting, string(input.ParentEmail))
+
+	return j.Id, nil
+}
+
+func (d *Database) CountJoinRequestsFromIP(_ context.Context, ip string, since time.Time) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	count := 0
+	for _, j := range d.joinRequests {
+		if j.ip == ip && !j.CreatedAt.Before(since) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (d *Database) GetJoinRequest(_ context.Context, id uuid.UUID) (rest.JoinRequest, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	j, ok := d.joinRequest(id)
+	if !ok {
+		return rest.JoinRequest{}, consts.ErrNotFound
+	}
+
+	return j.copy(), nil
+}
+
+func (d *Database) ListWaitingList(_ context.Context, unitID string) ([]rest.JoinRequest, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var waiting []rest.JoinRequest
+	for _, j := range d.joinRequests {
+		if j.Status == consts.JoinRequestStatusWaiting && slices.Contains(j.PreferredUnits, unitID) {
+			waiting = append(waiting, j.copy())
+		}
+	}
+
+	return waiting, nil
+}
+
+func (d *Database) ListJoinRequestEvents(_ context.Context, joinRequestID uuid.UUID) ([]rest.JoinRequestEvent, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.joinRequest(joinRequestID); !ok {
+		return nil, consts.ErrNotFound
+	}
+
+	var events []rest.JoinRequestEvent
+	for _, e := range d.joinRequestEvents {
+		if e.joinRequestID == joinRequestID {
+			events = append(events, e.JoinRequestEvent)
+		}
+	}
+
+	return events, nil
+}
+
+func (d *Database) joinRequest(id uuid.UUID) (*joinRequest, bool) {
+	i := slices.IndexFunc(d.joinRequests, func(j *joinRequest) bool { return j.Id == id })
+	if i < 0 {
+		return nil, false
+	}
+
+	return d.joinRequests[i], true
+}
+
+func (d *Database) addJoinRequestEvent(joinRequestID uuid.UUID, offerID *uuid.UUID, action string, status rest.JoinRequestStatus, actor string) {
+	d.joinRequestEvents = append(d.joinRequestEvents, joinRequestEvent{
+		joinRequestID: joinRequestID,
+		JoinRequestEvent: rest.JoinRequestEvent{
+			Action:    action,
+			Status:    status,
+			OfferId:   offerID,
+			Actor:     actor,
+			CreatedAt: now(),
+		},
+	})
+}
+
+// copy is the join request without the lists it shares with the stored one.
+func (j *joinRequest) copy() rest.JoinRequest {
+	c := j.JoinRequest
+	c.PreferredUnits = slices.Clone(j.PreferredUnits)
+	c.EligibleSections = slices.Clone(j.EligibleSections)
+
+	return c
+}
@@ -0,0 +1,189 @@
+package memdb
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+type member struct {
+	rest.Member
+	sensitive rest.EncryptedData
+}
+
+type memberAccess struct {
+	memberID uuid.UUID
+	rest.MemberAccess
+}
+
+func (d *Database) ListMembers(_ context.Context, unitID *string) ([]rest.Member, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var members []rest.Member
+	for _, m := range d.members {
+		if unitID == nil || m.Unit == *unitID {
+			members = append(members, m.Member)
+		}
+	}
+
+	slices.SortStableFunc(members, func(a, b rest.Member) int {
+		return cmp.Or(a.DateOfBirth.Time.Compare(b.DateOfBirth.Time), cmp.Compare(a.Name, b.Name))
+	})
+
+	return members, nil
+}
+
+func (d *Database) GetMember(_ context.Context, id uuid.UUID) (rest.Member, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	m, ok := d.member(id)
+	if !ok {
+		return rest.Member{}, consts.ErrNotFound
+	}
+
+	return m.Member, nil
+}
+
+func (d *Database) TransferMember(_ context.Context, memberID uuid.UUID, toUnit, _ string) (rest.Member, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	u, ok := d.unit(toUnit)
+	if !ok {
+		return rest.Member{}, consts.ErrNotFound
+	}
+
+	if u.Capacity != nil && *u.Capacity <= d.unitMembers(toUnit) {
+		return rest.Member{}, consts.ErrConflict
+	}
+
+	m, ok := d.member(memberID)
+	if !ok {
+		return rest.Member{}, consts.ErrNotFound
+	}
+
+	m.Unit = toUnit
+
+	return m.Member, nil
+}
+
+func (d *Database) CreateMember(_ context.Context, unitID string, input rest.MemberInput) (rest.Member, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.addMember(unitID, input)
+}
+
+func (d *Database) UpdateMember(_ context.Context, id uuid.UUID, input rest.MemberInput) (rest.Member, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	m, ok := d.member(id)
+	if !ok {
+		return rest.Member{}, consts.ErrNotFound
+	}
+
+	m.Name = input.Name
+	m.DateOfBirth = input.DateOfBirth
+	m.ParentName = input.ParentName
+	m.ParentEmail = input.ParentEmail
+	m.ParentPhone = input.ParentPhone
+
+	return m.Member, nil
+}
+
+func (d *Database) GetMemberSensitive(_ context.Context, id uuid.UUID) (rest.EncryptedData, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	m, ok := d.member(id)
+	if !ok {
+		return rest.EncryptedData{}, consts.ErrNotFound
+	}
+
+	return m.sensitive, nil
+}
+
+func (d *Database) SetMemberSensitive(_ context.Context, id uuid.UUID, data rest.EncryptedData) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	m, ok := d.member(id)
+	if !ok {
+		return consts.ErrNotFound
+	}
+
+	m.sensitive = rest.EncryptedData{KeyID: data.KeyID, Ciphertext: slices.Clone(data.Ciphertext)}
+
+	return nil
+}
+
+func (d *Database) AddMemberAccess(_ context.Context, memberID uuid.UUID, accessedBy, ip string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.member(memberID); !ok {
+		return fmt.Errorf("no member %s", memberID)
+	}
+
+	access := rest.MemberAccess{AccessedBy: accessedBy, AccessedAt: now()}
+	if ip != "" {
+		access.Ip = &ip
+	}
+	d.memberAccess = append(d.memberAccess, memberAccess{memberID: memberID, MemberAccess: access})
+
+	return nil
+}
+
+func (d *Database) ListMemberAccess(_ context.Context, memberID uuid.UUID) ([]rest.MemberAccess, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var accesses []rest.MemberAccess
+	for _, a := range slices.Backward(d.memberAccess) {
+		if a.memberID == memberID {
+			accesses = append(accesses, a.MemberAccess)
+		}
+	}
+
+	return accesses, nil
+}
+
+func (d *Database) member(id uuid.UUID) (*member, bool) {
+	i := slices.IndexFunc(d.members, func(m *member) bool { return m.Id == id })
+	if i < 0 {
+		return nil, false
+	}
+
+	return d.members[i], true
+}
+
+// addMember adds a member to the unit, which must exist as members reference it.
+func (d *Database) addMember(unitID string, input rest.MemberInput) (rest.Member, error) {
+	if _, ok := d.unit(unitID); !ok {
+		return rest.Member{}, fmt.Errorf("no unit %q", unitID)
+	}
+
+	m := &member{
+		Member: rest.Member{
+			Id:          uuid.New(),
+			Name:        input.Name,
+			DateOfBirth: input.DateOfBirth,
+			Unit:        unitID,
+			ParentName:  input.ParentName,
+			ParentEmail: input.ParentEmail,
+			ParentPhone: input.ParentPhone,
+			JoinedAt:    now(),
+		},
+	}
+	d.members = append(d.members, m)
+
+	return m.Member, nil
+}
@@ -0,0 +1,92 @@
+// Package memdb is an in-memory implementation of the server's repositories, for tests that need a database that
+// behaves like the real one without running Postgres. It starts out as the migrations leave a new database, with the
+// district's units and the starting ratio rules.
+package memdb
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+var _ rest.Database = (*Database)(nil)
+
+// Database keeps everything in memory, guarded by one lock, so each method is as atomic as its transaction in the real
+// database.
+type Database struct {
+	mu sync.Mutex
+
+	events       map[uuid.UUID]*rest.AdminEvent
+	signups      []*signup
+	ratioRules   []rest.RatioRule
+	units        []*rest.AdminUnit
+	members      []*member
+	memberAccess []memberAccess
+
+	roles              []rest.RoleAssignment
+	invitations        []*rest.Invitation
+	sessions           []*rest.Session
+	sessionRevocations []sessionRevocation
+	apiKeys            []*apiKey
+	revocations        []rest.Revocation
+	audit              []rest.AuditEntry
+
+	joinRequests      []*joinRequest
+	joinRequestEvents []joinRequestEvent
+	offers            []*placeOffer
+
+	parentLogins   []*parentLogin
+	parentSessions []*rest.ParentSession
+}
+
+func New() *Database {
+	d := &Database{events: map[uuid.UUID]*rest.AdminEvent{}}
+
+	for _, u := range []rest.AdminUnit{
+		{Id: "2nd-rainbows", Name: "2nd Staplehurst Rainbows", Section: rest.Rainbows},
+		{Id: "1st-brownies", Name: "1st Staplehurst Brownies", Section: rest.Brownies},
+		{Id: "4th-brownies", Name: "4th Staplehurst Brownies", Section: rest.Brownies},
+		{Id: "1st-guides", Name: "1st Staplehurst Guides", Section: rest.Guides},
+		{Id: "1st-rangers", Name: "1st Staplehurst Rangers", Section: rest.Rangers},
+	} {
+		d.units = append(d.units, &u)
+	}
+
+	perAdult := map[rest.Activity]map[rest.Section]int{
+		rest.Meeting:     {rest.Rainbows: 5, rest.Brownies: 8, rest.Guides: 12, rest.Rangers: 12},
+		rest.Outing:      {rest.Rainbows: 4, rest.Brownies: 6, rest.Guides: 10, rest.Rangers: 12},
+		rest.Residential: {rest.Rainbows: 4, rest.Brownies: 6, rest.Guides: 10, rest.Rangers: 12},
+	}
+	for activity, sections := range perAdult {
+		for section, girls := range sections {
+			d.ratioRules = append(d.ratioRules, rest.RatioRule{Activity: activity, Section: section, GirlsPerAdult: girls, MinAdults: 2})
+		}
+	}
+
+	return d
+}
+
+// now is when changes happen, for the columns the real database defaults to now().
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+// sameEmail compares emails the way the real database does, which lower cases them.
+func sameEmail[T ~string](a T, b string) bool {
+	return strings.EqualFold(string(a), b)
+}
+
+func lower[T ~string](email T) T {
+	return T(strings.ToLower(string(email)))
+}
+
+// sectionOrder sorts units the way the sections are joined, youngest first.
+var sectionOrder = []rest.Section{rest.Rainbows, rest.Brownies, rest.Guides, rest.Rangers}
+
+func sectionIndex(s rest.Section) int {
+	return slices.Index(sectionOrder, s)
+}
@@ -0,0 +1,148 @@
+package memdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/database/memdb"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestDatabase_EventSignups(t *testing.T) {
+	ctx := context.Background()
+	d := memdb.New()
+
+	start := time.Now().Add(24 * time.Hour)
+	event, err := d.CreateEvent(ctx, rest.EventInput{
+		Title:            "Camp",
+		Start:            start,
+		End:              start.Add(time.Hour),
+		Status:           consts.EventStatusApproved,
+		AdultHelpers:     ptr(2),
+		RatioEnforcement: ptr(rest.RatioEnforcement(consts.RatioEnforcementBlock)),
+		Signups:          &rest.EventSignupSettings{Capacity: ptr(12), Deadline: start},
+	}, "leader@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, rest.Activity(consts.ActivityMeeting), event.Activity)
+
+	signUp := func(section rest.Section) rest.EventSignup {
+		s, err := d.AddEventSignup(ctx, event.Id, rest.EventSignupInput{ParticipantName: "Ada", Section: section}, []byte("token"))
+		require.NoError(t, err)
+		return s
+	}
+
+	// Two adults can look after ten rainbows at a meeting.
+	var rainbows []rest.EventSignup
+	for range 11 {
+		rainbows = append(rainbows, signUp(rest.Rainbows))
+	}
+	assert.Equal(t, rest.SignupStatus(consts.SignupStatusWaitlisted), rainbows[10].Status)
+
+	// Even a guide, who needs less of an adult, would need a third.
+	assert.Equal(t, rest.SignupStatus(consts.SignupStatusWaitlisted), signUp(rest.Guides).Status)
+
+	event, err = d.GetEvent(ctx, event.Id)
+	require.NoError(t, err)
+	assert.Equal(t, 10, *event.ConfirmedSignups)
+	assert.Equal(t, 2, *event.WaitlistedSignups)
+	assert.Equal(t, map[string]int{"rainbows": 10}, *event.ConfirmedBySection)
+
+	cancelled, err := d.CancelEventSignup(ctx, event.Id, rainbows[0].Id, []byte("token"))
+	require.NoError(t, err)
+	assert.Equal(t, rest.SignupStatus(consts.SignupStatusConfirmed), cancelled.Status)
+
+	_, err = d.CancelEventSignup(ctx, event.Id, rainbows[0].Id, nil)
+	assert.ErrorIs(t, err, consts.ErrNotFound)
+
+	// The place goes to the rainbow, who fits, and not the guide behind her.
+	promoted, err := d.PromoteEventSignups(ctx, event.Id)
+	require.NoError(t, err)
+	require.Len(t, promoted, 1)
+	assert.Equal(t, rainbows[10].Id, promoted[0].Id)
+}
+
+func TestDatabase_CreateInvitation(t *testing.T) {
+	ctx := context.Background()
+	d := memdb.New()
+
+	invited, err := d.CreateInvitation(ctx, rest.InvitationInput{Email: "Leader@Example.com"}, "dc@example.com", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, openapi_types.Email("leader@example.com"), invited.Email)
+
+	assert.ErrorIs(t, d.AcceptInvitation(ctx, "leader@example.com", time.Now()), consts.ErrNotFound)
+
+	// The expired invitation is replaced, but an open one isn't.
+	reinvited, err := d.CreateInvitation(ctx, rest.InvitationInput{Email: "leader@example.com"}, "dc@example.com", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, invited.Id, reinvited.Id)
+
+	_, err = d.CreateInvitation(ctx, rest.InvitationInput{Email: "leader@example.com"}, "dc@example.com", time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, consts.ErrConflict)
+
+	require.NoError(t, d.AcceptInvitation(ctx, "LEADER@example.com", time.Now()))
+}
+
+func TestDatabase_RespondToPlaceOffer(t *testing.T) {
+	ctx := context.Background()
+	d := memdb.New()
+
+	id, err := d.AddJoinRequest(ctx, rest.JoinRequestInput{
+		ChildName:      "Ada",
+		ParentEmail:    "parent@example.com",
+		PreferredUnits: []string{"1st-brownies"},
+	}, []rest.Section{rest.Brownies}, "192.0.2.1")
+	require.NoError(t, err)
+
+	_, err = d.OfferPlace(ctx, id, "1st-guides", []byte("token"), time.Now().Add(time.Hour), "dc@example.com")
+	assert.ErrorIs(t, err, consts.ErrConflict)
+
+	offer, err := d.OfferPlace(ctx, id, "1st-brownies", []byte("token"), time.Now().Add(time.Hour), "dc@example.com")
+	require.NoError(t, err)
+
+	_, err = d.RespondToPlaceOffer(ctx, offer.Id, []byte("wrong"), true, time.Now())
+	assert.ErrorIs(t, err, consts.ErrNotFound)
+
+	_, err = d.RespondToPlaceOffer(ctx, offer.Id, []byte("token"), true, time.Now())
+	require.NoError(t, err)
+
+	members, err := d.ListFamilyMembers(ctx, "Parent@Example.com")
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, "1st-brownies", members[0].Unit)
+
+	events, err := d.ListJoinRequestEvents(ctx, id)
+	require.NoError(t, err)
+	var actions []string
+	for _, e := range events {
+		actions = append(actions, e.Action)
+	}
+	assert.Equal(t, []string{"registered", "offered", "accepted"}, actions)
+}
+
+func TestDatabase_ListAuditEntries(t *testing.T) {
+	ctx := context.Background()
+	d := memdb.New()
+
+	for _, actor := range []string{"a@example.com", "b@example.com", "a@example.com"} {
+		require.NoError(t, d.AddAuditEntry(ctx, rest.AuditEntry{Actor: actor, Action: "member.update", OccurredAt: time.Now()}))
+	}
+
+	entries, err := d.ListAuditEntries(ctx, rest.AuditFilter{Actor: ptr("A@example.com"), Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, []int64{3, 1}, []int64{entries[0].Id, entries[1].Id})
+
+	entries, err = d.ListAuditEntries(ctx, rest.AuditFilter{Before: ptr(int64(3)), Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(2), entries[0].Id)
+}
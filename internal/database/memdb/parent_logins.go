@@ -0,0 +1,163 @@
+package memdb
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+type parentLogin struct {
+	email     string
+	tokenHash []byte
+	ip        string
+	createdAt time.Time
+	expiresAt time.Time
+	usedAt    *time.Time
+}
+
+func (d *Database) CreateParentLogin(_ context.Context, email string, tokenHash []byte, ip string, expiresAt time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.parentLogins = append(d.parentLogins, &parentLogin{
+		email:     lower(email),
+		tokenHash: slices.Clone(tokenHash),
+		ip:        ip,
+		createdAt: now(),
+		expiresAt: expiresAt,
+	})
+
+	return nil
+}
+
+func (d *Database) CountParentLoginsFromIP(_ context.Context, ip string, since time.Time) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	count := 0
+	for _, l := range d.parentLogins {
+		if l.ip == ip && !l.createdAt.Before(since) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (d *Database) RedeemParentLogin(_ context.Context, tokenHash []byte, now time.Time) (string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, l := range d.parentLogins {
+		if bytes.Equal(l.tokenHash, tokenHash) && l.usedAt == nil && l.expiresAt.After(now) {
+			l.usedAt = &now
+			return l.email, nil
+		}
+	}
+
+	return "", consts.ErrNotFound
+}
+
+func (d *Database) HasFamily(_ context.Context, email string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	member := slices.ContainsFunc(d.members, func(m *member) bool { return sameEmail(m.ParentEmail, email) })
+	signup := slices.ContainsFunc(d.signups, func(s *signup) bool { return sameEmail(s.ContactEmail, email) })
+
+	return member || signup, nil
+}
+
+func (d *Database) ListFamilyMembers(_ context.Context, email string) ([]rest.Member, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var members []rest.Member
+	for _, m := range d.members {
+		if sameEmail(m.ParentEmail, email) {
+			members = append(members, m.Member)
+		}
+	}
+
+	slices.SortStableFunc(members, func(a, b rest.Member) int {
+		return cmp.Or(a.DateOfBirth.Time.Compare(b.DateOfBirth.Time), cmp.Compare(a.Name, b.Name))
+	})
+
+	return members, nil
+}
+
+func (d *Database) ListFamilySignups(_ context.Context, email string) ([]rest.EventSignup, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var signups []rest.EventSignup
+	for _, s := range slices.Backward(d.signups) {
+		if sameEmail(s.ContactEmail, email) {
+			signups = append(signups, s.EventSignup)
+		}
+	}
+
+	return signups, nil
+}
+
+func (d *Database) CreateParentSession(_ context.Context, session rest.ParentSession) (rest.ParentSession, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.parentSessions = slices.DeleteFunc(d.parentSessions, func(s *rest.ParentSession) bool {
+		return s.ExpiresAt.Before(session.CreatedAt)
+	})
+	d.parentLogins = slices.DeleteFunc(d.parentLogins, func(l *parentLogin) bool {
+		return l.expiresAt.Before(session.CreatedAt.AddDate(0, 0, -1))
+	})
+
+	created := session
+	created.ID = uuid.New()
+	created.Email = lower(session.Email)
+	created.TokenHash = slices.Clone(session.TokenHash)
+	d.parentSessions = append(d.parentSessions, &created)
+
+	return created, nil
+}
+
+func (d *Database) GetParentSession(_ context.Context, tokenHash []byte, now time.Time) (rest.ParentSession, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, s := range d.parentSessions {
+		if bytes.Equal(s.TokenHash, tokenHash) && s.ExpiresAt.After(now) {
+			return *s, nil
+		}
+	}
+
+	return rest.ParentSession{}, consts.ErrNotFound
+}
+
+func (d *Database) TouchParentSession(_ context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, s := range d.parentSessions {
+		if s.ID == id {
+			s.LastSeenAt = now
+			s.ExpiresAt = expiresAt
+		}
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteParentSession(_ context.Context, id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.parentSessions = slices.DeleteFunc(d.parentSessions, func(s *rest.ParentSession) bool { return s.ID == id })
+
+	return nil
+}
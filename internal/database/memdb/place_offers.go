@@ -0,0 +1,115 @@
+package memdb
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+type placeOffer struct {
+	rest.PlaceOffer
+	tokenHash []byte
+}
+
+func (d *Database) OfferPlace(_ context.Context, joinRequestID uuid.UUID, unitID string, tokenHash []byte, expiresAt time.Time, offeredBy string) (rest.PlaceOffer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	j, ok := d.joinRequest(joinRequestID)
+	if !ok {
+		return rest.PlaceOffer{}, consts.ErrNotFound
+	}
+
+	if j.Status != consts.JoinRequestStatusWaiting || !slices.Contains(j.PreferredUnits, unitID) {
+		return rest.PlaceOffer{}, consts.ErrConflict
+	}
+
+	o := &placeOffer{
+		PlaceOffer: rest.PlaceOffer{
+			Id:            uuid.New(),
+			JoinRequestId: joinRequestID,
+			Unit:          unitID,
+			Status:        consts.PlaceOfferStatusOffered,
+			ExpiresAt:     expiresAt,
+			CreatedBy:     offeredBy,
+			CreatedAt:     now(),
+		},
+		tokenHash: slices.Clone(tokenHash),
+	}
+	d.offers = append(d.offers, o)
+	d.setJoinRequestStatus(o.PlaceOffer, consts.JoinRequestStatusOffered, "offered", offeredBy)
+
+	return o.PlaceOffer, nil
+}
+
+func (d *Database) RespondToPlaceOffer(_ context.Context, offerID uuid.UUID, tokenHash []byte, accept bool, now time.Time) (rest.PlaceOffer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := slices.IndexFunc(d.offers, func(o *placeOffer) bool { return o.Id == offerID && bytes.Equal(o.tokenHash, tokenHash) })
+	if i < 0 {
+		return rest.PlaceOffer{}, consts.ErrNotFound
+	}
+
+	o := d.offers[i]
+	if o.Status != consts.PlaceOfferStatusOffered || !o.ExpiresAt.After(now) {
+		return rest.PlaceOffer{}, consts.ErrConflict
+	}
+
+	offerStatus, joinRequestStatus := rest.PlaceOfferStatus(consts.PlaceOfferStatusDeclined), rest.JoinRequestStatus(consts.JoinRequestStatusDeclined)
+	if accept {
+		offerStatus, joinRequestStatus = consts.PlaceOfferStatusAccepted, consts.JoinRequestStatusAccepted
+	}
+
+	o.Status = offerStatus
+	o.RespondedAt = &now
+
+	j, _ := d.joinRequest(o.JoinRequestId)
+	d.setJoinRequestStatus(o.PlaceOffer, joinRequestStatus, string(offerStatus), string(j.ParentEmail))
+
+	if accept {
+		_, err := d.addMember(o.Unit, rest.MemberInput{
+			Name:        j.ChildName,
+			DateOfBirth: j.DateOfBirth,
+			ParentName:  j.ParentName,
+			ParentEmail: j.ParentEmail,
+			ParentPhone: j.ParentPhone,
+		})
+		if err != nil {
+			return rest.PlaceOffer{}, err
+		}
+	}
+
+	return o.PlaceOffer, nil
+}
+
+func (d *Database) ExpirePlaceOffers(_ context.Context, now time.Time) ([]rest.PlaceOffer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var expired []rest.PlaceOffer
+	for _, o := range d.offers {
+		if o.Status != consts.PlaceOfferStatusOffered || o.ExpiresAt.After(now) {
+			continue
+		}
+
+		o.Status = consts.PlaceOfferStatusExpired
+		d.setJoinRequestStatus(o.PlaceOffer, consts.JoinRequestStatusExpired, "expired", consts.ActorSystem)
+		expired = append(expired, o.PlaceOffer)
+	}
+
+	return expired, nil
+}
+
+func (d *Database) setJoinRequestStatus(offer rest.PlaceOffer, status rest.JoinRequestStatus, action, actor string) {
+	if j, ok := d.joinRequest(offer.JoinRequestId); ok {
+		j.Status = status
+	}
+
+	d.addJoinRequestEvent(offer.JoinRequestId, &offer.Id, action, status, actor)
+}
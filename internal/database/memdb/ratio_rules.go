@@ -0,0 +1,52 @@
+package memdb
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/girlguidingstaplehurst/district/internal/ratio"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+)
+
+func (d *Database) ListRatioRules(_ context.Context) ([]rest.RatioRule, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	rules := slices.Clone(d.ratioRules)
+	slices.SortFunc(rules, func(a, b rest.RatioRule) int {
+		return cmp.Or(cmp.Compare(a.Activity, b.Activity), cmp.Compare(a.Section, b.Section))
+	})
+
+	return rules, nil
+}
+
+func (d *Database) SaveRatioRules(_ context.Context, rules []rest.RatioRule, _ string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, r := range rules {
+		i := slices.IndexFunc(d.ratioRules, func(existing rest.RatioRule) bool {
+			return existing.Activity == r.Activity && existing.Section == r.Section
+		})
+		if i < 0 {
+			d.ratioRules = append(d.ratioRules, r)
+			continue
+		}
+
+		d.ratioRules[i] = r
+	}
+
+	return nil
+}
+
+func (d *Database) activityRules(activity rest.Activity) ratio.Rules {
+	rules := ratio.Rules{}
+	for _, r := range d.ratioRules {
+		if r.Activity == activity {
+			rules[string(r.Section)] = ratio.Rule{GirlsPerAdult: r.GirlsPerAdult, MinAdults: r.MinAdults}
+		}
+	}
+
+	return rules
+}
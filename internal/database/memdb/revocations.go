@@ -0,0 +1,74 @@
+package memdb
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+func (d *Database) ListRevocations(_ context.Context) ([]rest.Revocation, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	revocations := slices.Clone(d.revocations)
+	slices.SortFunc(revocations, func(a, b rest.Revocation) int { return cmp.Compare(a.Email, b.Email) })
+
+	return revocations, nil
+}
+
+func (d *Database) ListRevokedSessions(_ context.Context, now time.Time) ([]uuid.UUID, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var ids []uuid.UUID
+	for _, r := range d.sessionRevocations {
+		if r.expiresAt.After(now) {
+			ids = append(ids, r.sessionID)
+		}
+	}
+
+	return ids, nil
+}
+
+func (d *Database) RevokeUser(_ context.Context, revocation rest.RevocationInput, revokedBy string) (rest.Revocation, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	revoked := slices.ContainsFunc(d.revocations, func(r rest.Revocation) bool {
+		return sameEmail(r.Email, string(revocation.Email))
+	})
+	if revoked {
+		return rest.Revocation{}, consts.ErrConflict
+	}
+
+	added := rest.Revocation{
+		Id:        uuid.New(),
+		Email:     lower(revocation.Email),
+		Reason:    revocation.Reason,
+		RevokedBy: revokedBy,
+		CreatedAt: now(),
+	}
+	d.revocations = append(d.revocations, added)
+	d.sessions = slices.DeleteFunc(d.sessions, func(s *rest.Session) bool { return s.Email == string(added.Email) })
+
+	return added, nil
+}
+
+func (d *Database) DeleteRevocation(_ context.Context, id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := slices.IndexFunc(d.revocations, func(r rest.Revocation) bool { return r.Id == id })
+	if i < 0 {
+		return consts.ErrNotFound
+	}
+
+	d.revocations = slices.Delete(d.revocations, i, i+1)
+
+	return nil
+}
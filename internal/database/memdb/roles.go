@@ -0,0 +1,93 @@
+package memdb
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+func (d *Database) ListUserRoles(_ context.Context, email string) ([]rest.RoleAssignment, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var roles []rest.RoleAssignment
+	for _, r := range d.sortedRoles() {
+		if sameEmail(r.Email, email) {
+			roles = append(roles, r)
+		}
+	}
+
+	return roles, nil
+}
+
+func (d *Database) ListRoleAssignments(_ context.Context) ([]rest.RoleAssignment, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.sortedRoles(), nil
+}
+
+func (d *Database) AddRoleAssignment(_ context.Context, role rest.RoleAssignmentInput, createdBy string) (rest.RoleAssignment, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	assigned := slices.ContainsFunc(d.roles, func(r rest.RoleAssignment) bool {
+		return sameEmail(r.Email, string(role.Email)) && r.Role == role.Role && deref(r.Unit) == deref(role.Unit)
+	})
+	if assigned {
+		return rest.RoleAssignment{}, consts.ErrConflict
+	}
+
+	added := rest.RoleAssignment{
+		Id:        uuid.New(),
+		Email:     lower(role.Email),
+		Role:      role.Role,
+		Unit:      role.Unit,
+		CreatedBy: createdBy,
+		CreatedAt: now(),
+	}
+	d.roles = append(d.roles, added)
+
+	return added, nil
+}
+
+func (d *Database) DeleteRoleAssignment(_ context.Context, id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := slices.IndexFunc(d.roles, func(r rest.RoleAssignment) bool { return r.Id == id })
+	if i < 0 {
+		return consts.ErrNotFound
+	}
+
+	d.roles = slices.Delete(d.roles, i, i+1)
+
+	return nil
+}
+
+func (d *Database) sortedRoles() []rest.RoleAssignment {
+	roles := slices.Clone(d.roles)
+	slices.SortStableFunc(roles, func(a, b rest.RoleAssignment) int {
+		return cmp.Or(cmp.Compare(a.Email, b.Email), cmp.Compare(a.Role, b.Role), compareNullable(a.Unit, b.Unit))
+	})
+
+	return roles
+}
+
+// compareNullable orders values the way Postgres does, with nulls last.
+func compareNullable[T cmp.Ordered](a, b *T) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+
+	return cmp.Compare(*a, *b)
+}
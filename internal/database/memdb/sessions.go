@@ -0,0 +1,109 @@
+package memdb
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+type sessionRevocation struct {
+	sessionID uuid.UUID
+	expiresAt time.Time
+}
+
+func (d *Database) CreateSession(_ context.Context, session rest.Session) (rest.Session, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.sessions = slices.DeleteFunc(d.sessions, func(s *rest.Session) bool { return s.ExpiresAt.Before(session.CreatedAt) })
+
+	created := session
+	created.ID = uuid.New()
+	created.Email = lower(session.Email)
+	created.TokenHash = slices.Clone(session.TokenHash)
+	d.sessions = append(d.sessions, &created)
+
+	return created, nil
+}
+
+func (d *Database) GetSession(_ context.Context, tokenHash []byte, now time.Time) (rest.Session, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, s := range d.sessions {
+		if bytes.Equal(s.TokenHash, tokenHash) && s.ExpiresAt.After(now) {
+			return *s, nil
+		}
+	}
+
+	return rest.Session{}, consts.ErrNotFound
+}
+
+func (d *Database) ListSessions(_ context.Context, email *string, now time.Time) ([]rest.Session, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var sessions []rest.Session
+	for _, s := range d.sessions {
+		if s.ExpiresAt.After(now) && (email == nil || sameEmail(s.Email, *email)) {
+			sessions = append(sessions, *s)
+		}
+	}
+
+	slices.SortFunc(sessions, func(a, b rest.Session) int {
+		return cmp.Or(cmp.Compare(a.Email, b.Email), b.LastSeenAt.Compare(a.LastSeenAt))
+	})
+
+	return sessions, nil
+}
+
+func (d *Database) TouchSession(_ context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if s, ok := d.session(id); ok {
+		s.LastSeenAt = now
+		s.ExpiresAt = expiresAt
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteSession(_ context.Context, id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.sessions = slices.DeleteFunc(d.sessions, func(s *rest.Session) bool { return s.ID == id })
+
+	return nil
+}
+
+func (d *Database) RevokeSession(_ context.Context, id uuid.UUID, _ string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	s, ok := d.session(id)
+	if !ok {
+		return consts.ErrNotFound
+	}
+
+	d.sessions = slices.DeleteFunc(d.sessions, func(s *rest.Session) bool { return s.ID == id })
+	d.sessionRevocations = append(d.sessionRevocations, sessionRevocation{sessionID: id, expiresAt: s.ExpiresAt})
+
+	return nil
+}
+
+func (d *Database) session(id uuid.UUID) (*rest.Session, bool) {
+	i := slices.IndexFunc(d.sessions, func(s *rest.Session) bool { return s.ID == id })
+	if i < 0 {
+		return nil, false
+	}
+
+	return d.sessions[i], true
+}
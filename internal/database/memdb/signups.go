@@ -0,0 +1,171 @@
+package memdb
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/ratio"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+)
+
+type signup struct {
+	rest.EventSignup
+	cancelTokenHash []byte
+}
+
+func (d *Database) AddEventSignup(_ context.Context, eventID uuid.UUID, input rest.EventSignupInput, tokenHash []byte) (rest.EventSignup, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	p, err := d.places(eventID)
+	if err != nil {
+		return rest.EventSignup{}, err
+	}
+
+	status := rest.SignupStatus(consts.SignupStatusConfirmed)
+	if !p.fits(string(input.Section)) {
+		status = consts.SignupStatusWaitlisted
+	}
+
+	s := &signup{
+		EventSignup: rest.EventSignup{
+			Id:              uuid.New(),
+			EventId:         eventID,
+			ParticipantName: input.ParticipantName,
+			DateOfBirth:     input.DateOfBirth,
+			Section:         input.Section,
+			Unit:            input.Unit,
+			ContactName:     input.ContactName,
+			ContactEmail:    input.ContactEmail,
+			ContactPhone:    input.ContactPhone,
+			Notes:           input.Notes,
+			Status:          status,
+			CreatedAt:       now(),
+		},
+		cancelTokenHash: tokenHash,
+	}
+	d.signups = append(d.signups, s)
+
+	return s.EventSignup, nil
+}
+
+func (d *Database) ListEventSignups(_ context.Context, eventID uuid.UUID, statuses ...string) ([]rest.EventSignup, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var signups []rest.EventSignup
+	for _, s := range d.signups {
+		if s.EventId == eventID && (len(statuses) == 0 || slices.Contains(statuses, string(s.Status))) {
+			signups = append(signups, s.EventSignup)
+		}
+	}
+
+	return signups, nil
+}
+
+func (d *Database) CancelEventSignup(_ context.Context, eventID, signupID uuid.UUID, tokenHash []byte) (rest.EventSignup, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, s := range d.signups {
+		if s.Id != signupID || s.EventId != eventID || s.Status == consts.SignupStatusCancelled {
+			continue
+		}
+
+		if tokenHash != nil && !bytes.Equal(s.cancelTokenHash, tokenHash) {
+			continue
+		}
+
+		previous := s.EventSignup
+		s.Status = consts.SignupStatusCancelled
+
+		return previous, nil
+	}
+
+	return rest.EventSignup{}, consts.ErrNotFound
+}
+
+func (d *Database) PromoteEventSignups(_ context.Context, eventID uuid.UUID) ([]rest.EventSignup, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	p, err := d.places(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var promoted []rest.EventSignup
+	for _, s := range d.signups {
+		if s.EventId != eventID || s.Status != consts.SignupStatusWaitlisted {
+			continue
+		}
+
+		if p.fits(string(s.Section)) {
+			p.confirmed[string(s.Section)]++
+			s.Status = consts.SignupStatusConfirmed
+			promoted = append(promoted, s.EventSignup)
+		}
+	}
+
+	return promoted, nil
+}
+
+// places tracks whether an event can confirm more sign-ups, as the real database does.
+type places struct {
+	capacity     *int
+	confirmed    map[string]int
+	enforceRatio bool
+	adultHelpers int
+	rules        ratio.Rules
+}
+
+func (d *Database) places(eventID uuid.UUID) (*places, error) {
+	e, ok := d.events[eventID]
+	if !ok {
+		return nil, consts.ErrNotFound
+	}
+
+	p := &places{
+		confirmed:    map[string]int{},
+		enforceRatio: e.RatioEnforcement == consts.RatioEnforcementBlock,
+		adultHelpers: e.AdultHelpers,
+		rules:        d.activityRules(e.Activity),
+	}
+
+	if e.Signups != nil {
+		p.capacity = e.Signups.Capacity
+	}
+
+	for _, s := range d.signups {
+		if s.EventId == eventID && s.Status == consts.SignupStatusConfirmed {
+			p.confirmed[string(s.Section)]++
+		}
+	}
+
+	return p, nil
+}
+
+func (p *places) fits(section string) bool {
+	total := 0
+	for _, n := range p.confirmed {
+		total += n
+	}
+
+	if p.capacity != nil && total >= *p.capacity {
+		return false
+	}
+
+	if !p.enforceRatio {
+		return true
+	}
+
+	girls := maps.Clone(p.confirmed)
+	girls[section]++
+
+	required, err := p.rules.RequiredAdults(girls)
+	return err == nil && required <= p.adultHelpers
+}
@@ -0,0 +1,104 @@
+package memdb
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+)
+
+func (d *Database) ListUnits(_ context.Context) ([]rest.Unit, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var units []rest.Unit
+	for _, u := range d.sortedUnits() {
+		units = append(units, rest.Unit{Id: u.Id, Name: u.Name, Section: u.Section})
+	}
+
+	return units, nil
+}
+
+func (d *Database) GetUnit(_ context.Context, id string) (rest.Unit, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	u, ok := d.unit(id)
+	if !ok {
+		return rest.Unit{}, consts.ErrNotFound
+	}
+
+	return rest.Unit{Id: u.Id, Name: u.Name, Section: u.Section}, nil
+}
+
+func (d *Database) ListAdminUnits(_ context.Context) ([]rest.AdminUnit, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var units []rest.AdminUnit
+	for _, u := range d.sortedUnits() {
+		units = append(units, d.adminUnit(u))
+	}
+
+	return units, nil
+}
+
+func (d *Database) UpdateUnit(_ context.Context, id string, settings rest.UnitSettings) (rest.AdminUnit, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	u, ok := d.unit(id)
+	if !ok {
+		return rest.AdminUnit{}, consts.ErrNotFound
+	}
+
+	u.Capacity = settings.Capacity
+	u.LeaderEmail = settings.LeaderEmail
+
+	return d.adminUnit(u), nil
+}
+
+func (d *Database) unit(id string) (*rest.AdminUnit, bool) {
+	i := slices.IndexFunc(d.units, func(u *rest.AdminUnit) bool { return u.Id == id })
+	if i < 0 {
+		return nil, false
+	}
+
+	return d.units[i], true
+}
+
+func (d *Database) sortedUnits() []*rest.AdminUnit {
+	units := slices.Clone(d.units)
+	slices.SortFunc(units, func(a, b *rest.AdminUnit) int {
+		return cmp.Or(cmp.Compare(sectionIndex(a.Section), sectionIndex(b.Section)), cmp.Compare(a.Name, b.Name))
+	})
+
+	return units
+}
+
+// adminUnit is a copy of the unit, with its members counted.
+func (d *Database) adminUnit(u *rest.AdminUnit) rest.AdminUnit {
+	unit := *u
+	unit.Members = d.unitMembers(u.Id)
+	unit.FreePlaces = nil
+
+	if unit.Capacity != nil {
+		free := max(*unit.Capacity-unit.Members, 0)
+		unit.FreePlaces = &free
+	}
+
+	return unit
+}
+
+func (d *Database) unitMembers(id string) int {
+	n := 0
+	for _, m := range d.members {
+		if m.Unit == id {
+			n++
+		}
+	}
+
+	return n
+}
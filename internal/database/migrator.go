@@ -0,0 +1,273 @@
+package database
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// migrationLockID is the Postgres advisory lock held while migrating, so only one replica migrates at once. The others
+// wait for it, and then find there's nothing left to do.
+const migrationLockID = 7_325_019_846
+
+var migrationFile = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+var ErrDirty = errors.New("a migration failed part way through, and the database needs fixing by hand")
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Migration
+	Applied bool
+}
+
+// Migrator applies the schema migrations. It records the version reached in schema_migrations, the same way the
+// migrate CLI does, so databases it has already migrated carry on from where they were.
+type Migrator struct {
+	pool       *pgxpool.Pool
+	migrations []Migration
+}
+
+func NewMigrator(pool *pgxpool.Pool, files fs.FS) (*Migrator, error) {
+	migrations, err := ParseMigrations(files)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{pool: pool, migrations: migrations}, nil
+}
+
+// ParseMigrations reads the migrations in files, in version order. Every migration needs both an up and a down file.
+func ParseMigrations(files fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		match := migrationFile.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", entry.Name(), err)
+		}
+
+		sql, err := fs.ReadFile(files, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d is named both %s and %s", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(sql)
+		} else {
+			m.Down = string(sql)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %06d_%s needs both an up and a down file", m.Version, m.Name)
+		}
+
+		migrations = append(migrations, *m)
+	}
+
+	slices.SortFunc(migrations, func(a, b Migration) int { return cmp.Compare(a.Version, b.Version) })
+
+	return migrations, nil
+}
+
+// Up applies every migration that hasn't been, returning those it applied.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+
+	err := m.locked(ctx, func(conn *pgxpool.Conn, current int64) error {
+		for _, migration := range m.migrations {
+			if migration.Version <= current {
+				continue
+			}
+
+			if err := m.apply(ctx, conn, migration.Up, migration.Version); err != nil {
+				return fmt.Errorf("applying %06d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			slog.Info("applied migration", "version", migration.Version, "name", migration.Name)
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down rolls back the latest migration applied, returning it. It returns false if there was nothing to roll back.
+func (m *Migrator) Down(ctx context.Context) (Migration, bool, error) {
+	var rolledBack Migration
+	var ok bool
+
+	err := m.locked(ctx, func(conn *pgxpool.Conn, current int64) error {
+		i := slices.IndexFunc(m.migrations, func(migration Migration) bool { return migration.Version == current })
+		if i < 0 {
+			if current == 0 {
+				return nil
+			}
+			return fmt.Errorf("the database is at version %d, which this build doesn't have", current)
+		}
+
+		// Rolling back the first migration leaves no version, which is recorded as having none at all.
+		previous := int64(0)
+		if i > 0 {
+			previous = m.migrations[i-1].Version
+		}
+
+		if err := m.apply(ctx, conn, m.migrations[i].Down, previous); err != nil {
+			return fmt.Errorf("rolling back %06d_%s: %w", current, m.migrations[i].Name, err)
+		}
+
+		slog.Info("rolled back migration", "version", current, "name", m.migrations[i].Name)
+		rolledBack, ok = m.migrations[i], true
+		return nil
+	})
+
+	return rolledBack, ok, err
+}
+
+// Status lists every migration, and whether it has been applied. It only reads, so it doesn't wait for a migration in
+// progress, and a database that has never been migrated is left as it is.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var migrated bool
+	if err := m.pool.QueryRow(ctx, `SELECT to_regclass('schema_migrations') IS NOT NULL`).Scan(&migrated); err != nil {
+		return nil, err
+	}
+
+	var current int64
+	if migrated {
+		var err error
+		if current, err = currentVersion(ctx, m.pool); err != nil {
+			return nil, err
+		}
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		statuses = append(statuses, MigrationStatus{Migration: migration, Applied: migration.Version <= current})
+	}
+
+	return statuses, nil
+}
+
+// locked calls fn with the version the database is at, holding the migration lock.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *pgxpool.Conn, current int64) error) error {
+	// Advisory locks belong to a connection, so the same one has to be used throughout.
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	// Migrations, and waiting for another replica's, can take longer than queries are allowed to.
+	if _, err := conn.Exec(ctx, `SET statement_timeout = 0`); err != nil {
+		return err
+	}
+	defer func() {
+		if _, err := conn.Exec(context.WithoutCancel(ctx), `RESET statement_timeout`); err != nil {
+			slog.Error("failed to reset the statement timeout", "err", err)
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("taking the migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.Exec(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, migrationLockID); err != nil {
+			slog.Error("failed to release the migration lock", "err", err)
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)`); err != nil {
+		return err
+	}
+
+	current, err := currentVersion(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	return fn(conn, current)
+}
+
+// currentVersion returns the version recorded in schema_migrations, which is 0 if no migrations have been applied.
+func currentVersion(ctx context.Context, q interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}) (int64, error) {
+	var current int64
+	var dirty bool
+	err := q.QueryRow(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&current, &dirty)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return 0, nil
+	case err != nil:
+		return 0, err
+	case dirty:
+		return 0, fmt.Errorf("version %d: %w", current, ErrDirty)
+	}
+
+	return current, nil
+}
+
+// apply runs the migration's SQL and records the version it leaves the database at, in one transaction, so a failed
+// migration changes nothing.
+func (m *Migrator) apply(ctx context.Context, conn *pgxpool.Conn, sql string, version int64) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Without arguments, the file is sent as it is, so it can hold more than one statement.
+	if _, err := tx.Exec(ctx, sql); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM schema_migrations`); err != nil {
+		return err
+	}
+
+	if version > 0 {
+		if _, err := tx.Exec(ctx, `INSERT INTO schema_migrations (version, dirty) VALUES ($1, false)`, version); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
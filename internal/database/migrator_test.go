@@ -0,0 +1,54 @@
+package database_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/girlguidingstaplehurst/district/db"
+	"github.com/girlguidingstaplehurst/district/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMigrations(t *testing.T) {
+	t.Run("in version order", func(t *testing.T) {
+		migrations, err := database.ParseMigrations(fstest.MapFS{
+			"000010_add_units.up.sql":       {Data: []byte("CREATE TABLE units ();")},
+			"000010_add_units.down.sql":     {Data: []byte("DROP TABLE units;")},
+			"000002_create_events.up.sql":   {Data: []byte("CREATE TABLE events ();")},
+			"000002_create_events.down.sql": {Data: []byte("DROP TABLE events;")},
+			"README.md":                     {Data: []byte("not a migration")},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []database.Migration{
+			{Version: 2, Name: "create_events", Up: "CREATE TABLE events ();", Down: "DROP TABLE events;"},
+			{Version: 10, Name: "add_units", Up: "CREATE TABLE units ();", Down: "DROP TABLE units;"},
+		}, migrations)
+	})
+
+	t.Run("every migration can be rolled back", func(t *testing.T) {
+		_, err := database.ParseMigrations(fstest.MapFS{
+			"000001_create_events.up.sql": {Data: []byte("CREATE TABLE events ();")},
+		})
+		assert.ErrorContains(t, err, "needs both an up and a down file")
+	})
+
+	t.Run("versions have one name", func(t *testing.T) {
+		_, err := database.ParseMigrations(fstest.MapFS{
+			"000001_create_events.up.sql":    {Data: []byte("CREATE TABLE events ();")},
+			"000001_create_signups.down.sql": {Data: []byte("DROP TABLE events;")},
+		})
+		assert.ErrorContains(t, err, "is named both")
+	})
+
+	t.Run("the embedded migrations", func(t *testing.T) {
+		migrations, err := database.ParseMigrations(db.Migrations)
+		require.NoError(t, err)
+
+		require.NotEmpty(t, migrations)
+		for i, m := range migrations {
+			assert.Equal(t, int64(i+1), m.Version, "migrations are numbered in sequence")
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const parentSessionColumns = `id, token_hash, email, csrf_token, created_at, last_seen_at, expires_at`
+
+func (d *Database) CreateParentLogin(ctx context.Context, email string, tokenHash []byte, ip string, expiresAt time.Time) error {
+	_, err := d.pool.Exec(ctx, `INSERT INTO parent_logins (email, token_hash, ip, expires_at) VALUES (lower($1), $2, $3, $4)`,
+		email, tokenHash, ip, expiresAt)
+
+	return err
+}
+
+func (d *Database) CountParentLoginsFromIP(ctx context.Context, ip string, since time.Time) (int, error) {
+	var count int
+	err := d.pool.QueryRow(ctx, `SELECT count(*) FROM parent_logins WHERE ip = $1 AND created_at >= $2`,
+		ip, since).Scan(&count)
+
+	return count, err
+}
+
+func (d *Database) RedeemParentLogin(ctx context.Context, tokenHash []byte, now time.Time) (string, error) {
+	var email string
+	err := d.pool.QueryRow(ctx, `UPDATE parent_logins SET used_at = $2
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > $2
+		RETURNING email`,
+		tokenHash, now).Scan(&email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", consts.ErrNotFound
+	}
+
+	return email, err
+}
+
+func (d *Database) HasFamily(ctx context.Context, email string) (bool, error) {
+	var exists bool
+	err := d.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM members WHERE lower(parent_email) = lower($1))
+		OR EXISTS (SELECT 1 FROM event_signups WHERE lower(contact_email) = lower($1))`,
+		email).Scan(&exists)
+
+	return exists, err
+}
+
+func (d *Database) ListFamilyMembers(ctx context.Context, email string) ([]rest.Member, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+memberColumns+` FROM members
+		WHERE lower(parent_email) = lower($1)
+		ORDER BY date_of_birth, name`,
+		email)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanMember)
+}
+
+func (d *Database) ListFamilySignups(ctx context.Context, email string) ([]rest.EventSignup, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+signupColumns+` FROM event_signups
+		WHERE lower(contact_email) = lower($1)
+		ORDER BY created_at DESC`,
+		email)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanSignup)
+}
+
+func (d *Database) CreateParentSession(ctx context.Context, session rest.ParentSession) (rest.ParentSession, error) {
+	// Expired sessions and sign in links are tidied up as new sessions start, rather than needing a job of their own.
+	// Links are kept for a day after they expire, so they still count towards the limit on asking for them.
+	if _, err := d.pool.Exec(ctx, `DELETE FROM parent_sessions WHERE expires_at < $1`, session.CreatedAt); err != nil {
+		return rest.ParentSession{}, err
+	}
+
+	if _, err := d.pool.Exec(ctx, `DELETE FROM parent_logins WHERE expires_at < $1`, session.CreatedAt.AddDate(0, 0, -1)); err != nil {
+		return rest.ParentSession{}, err
+	}
+
+	rows, err := d.pool.Query(ctx, `INSERT INTO parent_sessions
+		(token_hash, email, csrf_token, created_at, last_seen_at, expires_at)
+		VALUES ($1, lower($2), $3, $4, $5, $6)
+		RETURNING `+parentSessionColumns,
+		session.TokenHash, session.Email, session.CSRFToken, session.CreatedAt, session.LastSeenAt, session.ExpiresAt)
+	if err != nil {
+		return rest.ParentSession{}, err
+	}
+
+	return pgx.CollectExactlyOneRow(rows, scanParentSession)
+}
+
+func (d *Database) GetParentSession(ctx context.Context, tokenHash []byte, now time.Time) (rest.ParentSession, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+parentSessionColumns+` FROM parent_sessions WHERE token_hash = $1 AND expires_at > $2`,
+		tokenHash, now)
+	if err != nil {
+		return rest.ParentSession{}, err
+	}
+
+	session, err := pgx.CollectExactlyOneRow(rows, scanParentSession)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.ParentSession{}, consts.ErrNotFound
+	}
+
+	return session, err
+}
+
+func (d *Database) TouchParentSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	_, err := d.pool.Exec(ctx, `UPDATE parent_sessions SET last_seen_at = $2, expires_at = $3 WHERE id = $1`,
+		id, now, expiresAt)
+
+	return err
+}
+
+func (d *Database) DeleteParentSession(ctx context.Context, id uuid.UUID) error {
+	_, err := d.pool.Exec(ctx, `DELETE FROM parent_sessions WHERE id = $1`, id)
+
+	return err
+}
+
+func scanParentSession(row pgx.CollectableRow) (rest.ParentSession, error) {
+	var s rest.ParentSession
+	err := row.Scan(&s.ID, &s.TokenHash, &s.Email, &s.CSRFToken, &s.CreatedAt, &s.LastSeenAt, &s.ExpiresAt)
+
+	return s, err
+}
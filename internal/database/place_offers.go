@@ -0,0 +1,164 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const placeOfferColumns = `id, join_request_id, unit, status, expires_at, created_by, created_at, responded_at`
+
+func (d *Database) OfferPlace(ctx context.Context, joinRequestID uuid.UUID, unitID string, tokenHash []byte, expiresAt time.Time, offeredBy string) (rest.PlaceOffer, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return rest.PlaceOffer{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var waiting bool
+	err = tx.QueryRow(ctx, `SELECT status = 'waiting' AND $2 = ANY(preferred_units) FROM join_requests
+		WHERE id = $1
+		FOR UPDATE`,
+		joinRequestID, unitID).Scan(&waiting)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.PlaceOffer{}, consts.ErrNotFound
+	}
+	if err != nil {
+		return rest.PlaceOffer{}, err
+	}
+
+	if !waiting {
+		return rest.PlaceOffer{}, consts.ErrConflict
+	}
+
+	rows, err := tx.Query(ctx, `INSERT INTO place_offers (join_request_id, unit, status, token_hash, expires_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+placeOfferColumns,
+		joinRequestID, unitID, consts.PlaceOfferStatusOffered, tokenHash, expiresAt, offeredBy)
+	if err != nil {
+		return rest.PlaceOffer{}, err
+	}
+
+	offer, err := pgx.CollectExactlyOneRow(rows, scanPlaceOffer)
+	if err != nil {
+		return rest.PlaceOffer{}, err
+	}
+
+	if err := setJoinRequestStatus(ctx, tx, offer, consts.JoinRequestStatusOffered, "offered", offeredBy); err != nil {
+		return rest.PlaceOffer{}, err
+	}
+
+	return offer, tx.Commit(ctx)
+}
+
+func (d *Database) RespondToPlaceOffer(ctx context.Context, offerID uuid.UUID, tokenHash []byte, accept bool, now time.Time) (rest.PlaceOffer, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return rest.PlaceOffer{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		open        bool
+		parentEmail string
+	)
+	err = tx.QueryRow(ctx, `SELECT o.status = 'offered' AND o.expires_at > $3, j.parent_email
+		FROM place_offers o JOIN join_requests j ON j.id = o.join_request_id
+		WHERE o.id = $1 AND o.token_hash = $2
+		FOR UPDATE`,
+		offerID, tokenHash, now).Scan(&open, &parentEmail)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.PlaceOffer{}, consts.ErrNotFound
+	}
+	if err != nil {
+		return rest.PlaceOffer{}, err
+	}
+
+	if !open {
+		return rest.PlaceOffer{}, consts.ErrConflict
+	}
+
+	offerStatus, joinRequestStatus := consts.PlaceOfferStatusDeclined, consts.JoinRequestStatusDeclined
+	if accept {
+		offerStatus, joinRequestStatus = consts.PlaceOfferStatusAccepted, consts.JoinRequestStatusAccepted
+	}
+
+	rows, err := tx.Query(ctx, `UPDATE place_offers SET status = $2, responded_at = $3
+		WHERE id = $1
+		RETURNING `+placeOfferColumns,
+		offerID, offerStatus, now)
+	if err != nil {
+		return rest.PlaceOffer{}, err
+	}
+
+	offer, err := pgx.CollectExactlyOneRow(rows, scanPlaceOffer)
+	if err != nil {
+		return rest.PlaceOffer{}, err
+	}
+
+	if err := setJoinRequestStatus(ctx, tx, offer, joinRequestStatus, offerStatus, parentEmail); err != nil {
+		return rest.PlaceOffer{}, err
+	}
+
+	if accept {
+		if err := addMemberFromOffer(ctx, tx, offer); err != nil {
+			return rest.PlaceOffer{}, err
+		}
+	}
+
+	return offer, tx.Commit(ctx)
+}
+
+func (d *Database) ExpirePlaceOffers(ctx context.Context, now time.Time) ([]rest.PlaceOffer, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `UPDATE place_offers SET status = 'expired'
+		WHERE status = 'offered' AND expires_at <= $1
+		RETURNING `+placeOfferColumns,
+		now)
+	if err != nil {
+		return nil, err
+	}
+
+	offers, err := pgx.CollectRows(rows, scanPlaceOffer)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, offer := range offers {
+		err := setJoinRequestStatus(ctx, tx, offer, consts.JoinRequestStatusExpired, "expired", consts.ActorSystem)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return offers, tx.Commit(ctx)
+}
+
+// setJoinRequestStatus moves the join request for the offer to the status, recording the action in its history.
+func setJoinRequestStatus(ctx context.Context, tx pgx.Tx, offer rest.PlaceOffer, status, action, actor string) error {
+	_, err := tx.Exec(ctx, `UPDATE join_requests SET status = $2, updated_at = now() WHERE id = $1`,
+		offer.JoinRequestId, status)
+	if err != nil {
+		return err
+	}
+
+	return addJoinRequestEvent(ctx, tx, offer.JoinRequestId, &offer.Id, action, status, actor)
+}
+
+func scanPlaceOffer(row pgx.CollectableRow) (rest.PlaceOffer, error) {
+	var o rest.PlaceOffer
+	err := row.Scan(&o.Id, &o.JoinRequestId, &o.Unit, &o.Status, &o.ExpiresAt, &o.CreatedBy, &o.CreatedAt,
+		&o.RespondedAt)
+
+	return o, err
+}
@@ -0,0 +1,33 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/exaring/otelpgx"
+	"github.com/girlguidingstaplehurst/district/internal/config"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NewPool opens a pool of connections to the database, tracing every query. Each replica has its own pool, so the
+// database needs to allow database.maxconns connections for each of them.
+func NewPool(ctx context.Context, cfg config.DatabaseConfig) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(cfg.URL)
+	if err != nil {
+		// The error would quote the URL, and with it the password.
+		return nil, errors.New("database.url isn't a valid Postgres connection string")
+	}
+
+	poolCfg.MaxConns = cfg.MaxConns
+	poolCfg.MinConns = cfg.MinConns
+	// Connections are closed once they're old, so they're spread across the database again after a failover.
+	poolCfg.MaxConnLifetime = cfg.MaxConnLifetime
+	poolCfg.MaxConnIdleTime = cfg.MaxConnIdleTime
+	poolCfg.ConnConfig.ConnectTimeout = cfg.ConnectTimeout
+	// A slow query is cancelled, rather than holding on to a connection the other requests need.
+	poolCfg.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	poolCfg.ConnConfig.Tracer = otelpgx.NewTracer()
+
+	return pgxpool.NewWithConfig(ctx, poolCfg)
+}
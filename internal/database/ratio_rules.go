@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+
+	"github.com/girlguidingstaplehurst/district/internal/ratio"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/jackc/pgx/v5"
+)
+
+func (d *Database) ListRatioRules(ctx context.Context) ([]rest.RatioRule, error) {
+	rows, err := d.pool.Query(ctx, `SELECT activity, section, girls_per_adult, min_adults FROM ratio_rules
+		ORDER BY activity, section`)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (rest.RatioRule, error) {
+		var r rest.RatioRule
+		err := row.Scan(&r.Activity, &r.Section, &r.GirlsPerAdult, &r.MinAdults)
+		return r, err
+	})
+}
+
+func (d *Database) SaveRatioRules(ctx context.Context, rules []rest.RatioRule, updatedBy string) error {
+	batch := &pgx.Batch{}
+	for _, r := range rules {
+		batch.Queue(`INSERT INTO ratio_rules (activity, section, girls_per_adult, min_adults, updated_by)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (activity, section) DO UPDATE
+			SET girls_per_adult = excluded.girls_per_adult, min_adults = excluded.min_adults,
+			    updated_by = excluded.updated_by, updated_at = now()`,
+			r.Activity, r.Section, r.GirlsPerAdult, r.MinAdults, updatedBy)
+	}
+
+	return pgx.BeginFunc(ctx, d.pool, func(tx pgx.Tx) error {
+		return tx.SendBatch(ctx, batch).Close()
+	})
+}
+
+func activityRatioRules(ctx context.Context, tx pgx.Tx, activity string) (ratio.Rules, error) {
+	rows, err := tx.Query(ctx, `SELECT section, girls_per_adult, min_adults FROM ratio_rules WHERE activity = $1`,
+		activity)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := ratio.Rules{}
+	for rows.Next() {
+		var (
+			section string
+			rule    ratio.Rule
+		)
+		if err := rows.Scan(&section, &rule.GirlsPerAdult, &rule.MinAdults); err != nil {
+			return nil, err
+		}
+		rules[section] = rule
+	}
+
+	return rules, rows.Err()
+}
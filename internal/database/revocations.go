@@ -0,0 +1,83 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const revocationColumns = `id, email, reason, revoked_by, created_at`
+
+func (d *Database) ListRevocations(ctx context.Context) ([]rest.Revocation, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+revocationColumns+` FROM admin_revocations ORDER BY email`)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanRevocation)
+}
+
+func (d *Database) ListRevokedSessions(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	rows, err := d.pool.Query(ctx, `SELECT session_id FROM admin_session_revocations WHERE expires_at > $1`, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+}
+
+func (d *Database) RevokeUser(ctx context.Context, revocation rest.RevocationInput, revokedBy string) (rest.Revocation, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return rest.Revocation{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `INSERT INTO admin_revocations (email, reason, revoked_by)
+		VALUES (lower($1), $2, $3)
+		RETURNING `+revocationColumns,
+		revocation.Email, revocation.Reason, revokedBy)
+	if err != nil {
+		return rest.Revocation{}, err
+	}
+
+	revoked, err := pgx.CollectExactlyOneRow(rows, scanRevocation)
+	if pgErr := new(pgconn.PgError); errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return rest.Revocation{}, consts.ErrConflict
+	}
+	if err != nil {
+		return rest.Revocation{}, err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM admin_sessions WHERE email = $1`, revoked.Email); err != nil {
+		return rest.Revocation{}, err
+	}
+
+	return revoked, tx.Commit(ctx)
+}
+
+func (d *Database) DeleteRevocation(ctx context.Context, id uuid.UUID) error {
+	tag, err := d.pool.Exec(ctx, `DELETE FROM admin_revocations WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return consts.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanRevocation(row pgx.CollectableRow) (rest.Revocation, error) {
+	var r rest.Revocation
+	err := row.Scan(&r.Id, &r.Email, &r.Reason, &r.RevokedBy, &r.CreatedAt)
+
+	return r, err
+}
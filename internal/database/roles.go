@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolation is the Postgres error code for a unique constraint violation.
+const uniqueViolation = "23505"
+
+const roleColumns = `id, email, role, unit, created_by, created_at`
+
+func (d *Database) ListUserRoles(ctx context.Context, email string) ([]rest.RoleAssignment, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+roleColumns+` FROM user_roles WHERE email = lower($1)
+		ORDER BY role, unit`,
+		email)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanRoleAssignment)
+}
+
+func (d *Database) ListRoleAssignments(ctx context.Context) ([]rest.RoleAssignment, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+roleColumns+` FROM user_roles ORDER BY email, role, unit`)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanRoleAssignment)
+}
+
+func (d *Database) AddRoleAssignment(ctx context.Context, role rest.RoleAssignmentInput, createdBy string) (rest.RoleAssignment, error) {
+	rows, err := d.pool.Query(ctx, `INSERT INTO user_roles (email, role, unit, created_by)
+		VALUES (lower($1), $2, $3, $4)
+		RETURNING `+roleColumns,
+		role.Email, role.Role, role.Unit, createdBy)
+	if err != nil {
+		return rest.RoleAssignment{}, err
+	}
+
+	added, err := pgx.CollectExactlyOneRow(rows, scanRoleAssignment)
+	if pgErr := new(pgconn.PgError); errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return rest.RoleAssignment{}, consts.ErrConflict
+	}
+
+	return added, err
+}
+
+func (d *Database) DeleteRoleAssignment(ctx context.Context, id uuid.UUID) error {
+	tag, err := d.pool.Exec(ctx, `DELETE FROM user_roles WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return consts.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanRoleAssignment(row pgx.CollectableRow) (rest.RoleAssignment, error) {
+	var r rest.RoleAssignment
+	err := row.Scan(&r.Id, &r.Email, &r.Role, &r.Unit, &r.CreatedBy, &r.CreatedAt)
+
+	return r, err
+}
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const sessionColumns = `id, token_hash, email, name, hosted_domain, csrf_token, created_at, last_seen_at, expires_at`
+
+func (d *Database) CreateSession(ctx context.Context, session rest.Session) (rest.Session, error) {
+	// Expired sessions are tidied up as new ones start, rather than needing a job of their own.
+	if _, err := d.pool.Exec(ctx, `DELETE FROM admin_sessions WHERE expires_at < $1`, session.CreatedAt); err != nil {
+		return rest.Session{}, err
+	}
+
+	rows, err := d.pool.Query(ctx, `INSERT INTO admin_sessions
+		(token_hash, email, name, hosted_domain, csrf_token, created_at, last_seen_at, expires_at)
+		VALUES ($1, lower($2), $3, $4, $5, $6, $7, $8)
+		RETURNING `+sessionColumns,
+		session.TokenHash, session.Email, session.Name, session.HostedDomain, session.CSRFToken, session.CreatedAt,
+		session.LastSeenAt, session.ExpiresAt)
+	if err != nil {
+		return rest.Session{}, err
+	}
+
+	return pgx.CollectExactlyOneRow(rows, scanSession)
+}
+
+func (d *Database) GetSession(ctx context.Context, tokenHash []byte, now time.Time) (rest.Session, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+sessionColumns+` FROM admin_sessions WHERE token_hash = $1 AND expires_at > $2`,
+		tokenHash, now)
+	if err != nil {
+		return rest.Session{}, err
+	}
+
+	session, err := pgx.CollectExactlyOneRow(rows, scanSession)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.Session{}, consts.ErrNotFound
+	}
+
+	return session, err
+}
+
+func (d *Database) ListSessions(ctx context.Context, email *string, now time.Time) ([]rest.Session, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+sessionColumns+` FROM admin_sessions
+		WHERE expires_at > $1 AND ($2::text IS NULL OR email = lower($2))
+		ORDER BY email, last_seen_at DESC`,
+		now, email)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanSession)
+}
+
+func (d *Database) TouchSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	_, err := d.pool.Exec(ctx, `UPDATE admin_sessions SET last_seen_at = $2, expires_at = $3 WHERE id = $1`,
+		id, now, expiresAt)
+
+	return err
+}
+
+func (d *Database) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	_, err := d.pool.Exec(ctx, `DELETE FROM admin_sessions WHERE id = $1`, id)
+
+	return err
+}
+
+func (d *Database) RevokeSession(ctx context.Context, id uuid.UUID, revokedBy string) error {
+	tag, err := d.pool.Exec(ctx, `WITH revoked AS (DELETE FROM admin_sessions WHERE id = $1 RETURNING id, email, expires_at)
+		INSERT INTO admin_session_revocations (session_id, email, revoked_by, expires_at)
+		SELECT id, email, $2, expires_at FROM revoked`,
+		id, revokedBy)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return consts.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanSession(row pgx.CollectableRow) (rest.Session, error) {
+	var s rest.Session
+	err := row.Scan(&s.ID, &s.TokenHash, &s.Email, &s.Name, &s.HostedDomain, &s.CSRFToken, &s.CreatedAt, &s.LastSeenAt,
+		&s.ExpiresAt)
+
+	return s, err
+}
@@ -0,0 +1,243 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/ratio"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const signupColumns = `id, event_id, participant_name, date_of_birth, section, unit, contact_name, contact_email,
+	contact_phone, notes, status, created_at`
+
+func (d *Database) AddEventSignup(ctx context.Context, eventID uuid.UUID, signup rest.EventSignupInput, tokenHash []byte) (rest.EventSignup, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return rest.EventSignup{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	p, err := lockPlaces(ctx, tx, eventID)
+	if err != nil {
+		return rest.EventSignup{}, err
+	}
+
+	status := consts.SignupStatusConfirmed
+	if !p.fits(string(signup.Section)) {
+		status = consts.SignupStatusWaitlisted
+	}
+
+	rows, err := tx.Query(ctx, `INSERT INTO event_signups (event_id, participant_name, date_of_birth, section, unit,
+			contact_name, contact_email, contact_phone, notes, status, cancel_token_hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING `+signupColumns,
+		eventID, signup.ParticipantName, signup.DateOfBirth.Time, signup.Section, signup.Unit, signup.ContactName,
+		signup.ContactEmail, signup.ContactPhone, signup.Notes, status, tokenHash)
+	if err != nil {
+		return rest.EventSignup{}, err
+	}
+
+	added, err := pgx.CollectExactlyOneRow(rows, scanSignup)
+	if err != nil {
+		return rest.EventSignup{}, err
+	}
+
+	return added, tx.Commit(ctx)
+}
+
+func (d *Database) ListEventSignups(ctx context.Context, eventID uuid.UUID, statuses ...string) ([]rest.EventSignup, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+signupColumns+` FROM event_signups
+		WHERE event_id = $1 AND (coalesce(cardinality($2::text[]), 0) = 0 OR status = ANY($2))
+		ORDER BY created_at`,
+		eventID, statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanSignup)
+}
+
+func (d *Database) CancelEventSignup(ctx context.Context, eventID, signupID uuid.UUID, tokenHash []byte) (rest.EventSignup, error) {
+	rows, err := d.pool.Query(ctx, `WITH previous AS (
+			SELECT id, status FROM event_signups
+			WHERE id = $1 AND event_id = $2 AND status <> 'cancelled'
+			  AND ($3::bytea IS NULL OR cancel_token_hash = $3)
+			FOR UPDATE
+		)
+		UPDATE event_signups s SET status = 'cancelled', updated_at = now()
+		FROM previous
+		WHERE s.id = previous.id
+		RETURNING s.id, s.event_id, s.participant_name, s.date_of_birth, s.section, s.unit, s.contact_name,
+			s.contact_email, s.contact_phone, s.notes, previous.status, s.created_at`,
+		signupID, eventID, tokenHash)
+	if err != nil {
+		return rest.EventSignup{}, err
+	}
+
+	signup, err := pgx.CollectExactlyOneRow(rows, scanSignup)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.EventSignup{}, consts.ErrNotFound
+	}
+
+	return signup, err
+}
+
+func (d *Database) PromoteEventSignups(ctx context.Context, eventID uuid.UUID) ([]rest.EventSignup, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	p, err := lockPlaces(ctx, tx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `SELECT id, section FROM event_signups
+		WHERE event_id = $1 AND status = 'waitlisted'
+		ORDER BY created_at
+		FOR UPDATE`,
+		eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	type waiting struct {
+		ID      uuid.UUID
+		Section string
+	}
+	waitlist, err := pgx.CollectRows(rows, pgx.RowToStructByPos[waiting])
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign-ups that would break the ratio are passed over, so a place can still go to someone further down the
+	// list from a section with a more generous ratio.
+	var ids []uuid.UUID
+	for _, w := range waitlist {
+		if p.fits(w.Section) {
+			p.confirm(w.Section)
+			ids = append(ids, w.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rows, err = tx.Query(ctx, `UPDATE event_signups SET status = 'confirmed', updated_at = now()
+		WHERE id = ANY($1)
+		RETURNING `+signupColumns,
+		ids)
+	if err != nil {
+		return nil, err
+	}
+
+	promoted, err := pgx.CollectRows(rows, scanSignup)
+	if err != nil {
+		return nil, err
+	}
+
+	return promoted, tx.Commit(ctx)
+}
+
+// places tracks whether an event can confirm more sign-ups, within its capacity and, if it blocks sign-ups that
+// break it, the adult to child ratio.
+type places struct {
+	capacity     *int
+	confirmed    map[string]int
+	enforceRatio bool
+	adultHelpers int
+	rules        ratio.Rules
+}
+
+// lockPlaces locks the event against concurrent changes to its sign-ups for the rest of the transaction.
+func lockPlaces(ctx context.Context, tx pgx.Tx, eventID uuid.UUID) (*places, error) {
+	var (
+		p                     places
+		activity, enforcement string
+	)
+
+	err := tx.QueryRow(ctx, `SELECT signup_capacity, activity, adult_helpers, ratio_enforcement FROM events
+		WHERE id = $1
+		FOR UPDATE`,
+		eventID).Scan(&p.capacity, &activity, &p.adultHelpers, &enforcement)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, consts.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `SELECT section, count(*) FROM event_signups
+		WHERE event_id = $1 AND status = 'confirmed'
+		GROUP BY section`,
+		eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	p.confirmed = map[string]int{}
+	var (
+		section string
+		count   int
+	)
+	_, err = pgx.ForEachRow(rows, []any{&section, &count}, func() error {
+		p.confirmed[section] = count
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if enforcement == consts.RatioEnforcementBlock {
+		p.enforceRatio = true
+		p.rules, err = activityRatioRules(ctx, tx, activity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &p, nil
+}
+
+// fits reports whether one more sign-up from the section can be confirmed. If the ratio can't be checked, because
+// there is no rule for the section, it is treated as broken.
+func (p *places) fits(section string) bool {
+	total := 0
+	for _, n := range p.confirmed {
+		total += n
+	}
+
+	if p.capacity != nil && total >= *p.capacity {
+		return false
+	}
+
+	if !p.enforceRatio {
+		return true
+	}
+
+	girls := maps.Clone(p.confirmed)
+	girls[section]++
+
+	required, err := p.rules.RequiredAdults(girls)
+	return err == nil && required <= p.adultHelpers
+}
+
+func (p *places) confirm(section string) {
+	p.confirmed[section]++
+}
+
+func scanSignup(row pgx.CollectableRow) (rest.EventSignup, error) {
+	var s rest.EventSignup
+	err := row.Scan(&s.Id, &s.EventId, &s.ParticipantName, &s.DateOfBirth.Time, &s.Section, &s.Unit,
+		&s.ContactName, &s.ContactEmail, &s.ContactPhone, &s.Notes, &s.Status, &s.CreatedAt)
+
+	return s, err
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/jackc/pgx/v5"
+)
+
+const adminUnitColumns = `id, name, section, capacity, leader_email,
+	(SELECT count(*) FROM members m WHERE m.unit = units.id)`
+
+func (d *Database) ListUnits(ctx context.Context) ([]rest.Unit, error) {
+	rows, err := d.pool.Query(ctx, `SELECT id, name, section FROM units
+		ORDER BY array_position(ARRAY['rainbows', 'brownies', 'guides', 'rangers'], section), name`)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (rest.Unit, error) {
+		var u rest.Unit
+		err := row.Scan(&u.Id, &u.Name, &u.Section)
+		return u, err
+	})
+}
+
+func (d *Database) GetUnit(ctx context.Context, id string) (rest.Unit, error) {
+	var u rest.Unit
+	err := d.pool.QueryRow(ctx, `SELECT id, name, section FROM units WHERE id = $1`, id).Scan(&u.Id, &u.Name, &u.Section)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.Unit{}, consts.ErrNotFound
+	}
+
+	return u, err
+}
+
+func (d *Database) ListAdminUnits(ctx context.Context) ([]rest.AdminUnit, error) {
+	rows, err := d.pool.Query(ctx, `SELECT `+adminUnitColumns+` FROM units
+		ORDER BY array_position(ARRAY['rainbows', 'brownies', 'guides', 'rangers'], section), name`)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, scanAdminUnit)
+}
+
+func (d *Database) UpdateUnit(ctx context.Context, id string, settings rest.UnitSettings) (rest.AdminUnit, error) {
+	rows, err := d.pool.Query(ctx, `UPDATE units SET capacity = $2, leader_email = $3
+		WHERE id = $1
+		RETURNING `+adminUnitColumns,
+		id, settings.Capacity, settings.LeaderEmail)
+	if err != nil {
+		return rest.AdminUnit{}, err
+	}
+
+	unit, err := pgx.CollectExactlyOneRow(rows, scanAdminUnit)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rest.AdminUnit{}, consts.ErrNotFound
+	}
+
+	return unit, err
+}
+
+func scanAdminUnit(row pgx.CollectableRow) (rest.AdminUnit, error) {
+	var u rest.AdminUnit
+	err := row.Scan(&u.Id, &u.Name, &u.Section, &u.Capacity, &u.LeaderEmail, &u.Members)
+	if err != nil {
+		return rest.AdminUnit{}, err
+	}
+
+	if u.Capacity != nil {
+		free := max(*u.Capacity-u.Members, 0)
+		u.FreePlaces = &free
+	}
+
+	return u, nil
+}
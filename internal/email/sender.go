@@ -0,0 +1,51 @@
+package email
+
+import (
+	"context"
+	"sync"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/gomail.v2"
+)
+
+var _ rest.EmailSender = (*Sender)(nil)
+
+type Sender struct {
+	mu     sync.RWMutex
+	dialer *gomail.Dialer
+	from   string
+}
+
+func NewSender(server string, port int, username, password string) *Sender {
+	s := new(Sender)
+	s.Update(server, port, username, password)
+	return s
+}
+
+// Update changes the server and account emails are sent with, such as when the password is rotated.
+func (s *Sender) Update(server string, port int, username, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dialer = gomail.NewDialer(server, port, username, password)
+	s.from = username
+}
+
+func (s *Sender) Send(ctx context.Context, to, subject, body string) error {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("email.subject", subject))
+
+	s.mu.RLock()
+	dialer, from := s.dialer, s.from
+	s.mu.RUnlock()
+
+	m := gomail.NewMessage()
+	m.SetHeader("From", from)
+	m.SetHeader("To", to)
+	m.SetHeader("Subject", subject)
+	m.SetBody("text/html", body)
+
+	return dialer.DialAndSend(m)
+}
@@ -0,0 +1,101 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+)
+
+var _ rest.Encrypter = (*Keyring)(nil)
+
+// KeySize is the size of the AES-256 keys used to encrypt data.
+const KeySize = 32
+
+var ErrUnknownKey = errors.New("unknown encryption key")
+
+// Keyring encrypts data with AES-256-GCM using its current key, and decrypts data encrypted with any of its keys. To
+// rotate keys, add a new key and make it current, keeping the old keys until nothing is encrypted with them.
+type Keyring struct {
+	current string
+	aeads   map[string]cipher.AEAD
+}
+
+// NewKeyring creates a Keyring from keys keyed by key ID, encrypting with the key with the current ID.
+func NewKeyring(current string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[current]; !ok {
+		return nil, fmt.Errorf("current key %q is not one of the keys", current)
+	}
+
+	k := &Keyring{current: current, aeads: map[string]cipher.AEAD{}}
+	for id, key := range keys {
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("key %q must be %d bytes", id, KeySize)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+
+		k.aeads[id], err = cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return k, nil
+}
+
+// ParseKeys parses a comma separated list of keys, each written as the key ID and base64 encoded key separated by a
+// colon, such as "2026-01:c2VjcmV0...".
+func ParseKeys(s string) (map[string][]byte, error) {
+	keys := map[string][]byte{}
+	for _, entry := range strings.Split(s, ",") {
+		id, encoded, found := strings.Cut(strings.TrimSpace(entry), ":")
+		if !found || id == "" {
+			return nil, errors.New("keys must be written as id:base64key")
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not valid base64: %w", id, err)
+		}
+
+		keys[id] = key
+	}
+
+	return keys, nil
+}
+
+// Encrypt encrypts the plaintext with the current key, returning its ID with the ciphertext. The additional data is
+// not encrypted, but must be the same to decrypt, so it can tie the ciphertext to the record it belongs to.
+func (k *Keyring) Encrypt(plaintext, additionalData []byte) (string, []byte, error) {
+	aead := k.aeads[k.current]
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+
+	return k.current, aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func (k *Keyring) Decrypt(keyID string, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, ok := k.aeads[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, keyID)
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, additionalData)
+}
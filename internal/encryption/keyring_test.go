@@ -0,0 +1,59 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyring(t *testing.T) {
+	oldKey, newKey := bytes.Repeat([]byte{1}, KeySize), bytes.Repeat([]byte{2}, KeySize)
+
+	old, err := NewKeyring("old", map[string][]byte{"old": oldKey})
+	require.NoError(t, err)
+
+	keyID, ciphertext, err := old.Encrypt([]byte("nut allergy"), []byte("member-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "old", keyID)
+	assert.NotContains(t, string(ciphertext), "nut allergy")
+
+	t.Run("rotated keyrings still decrypt with old keys", func(t *testing.T) {
+		rotated, err := NewKeyring("new", map[string][]byte{"old": oldKey, "new": newKey})
+		require.NoError(t, err)
+
+		plaintext, err := rotated.Decrypt(keyID, ciphertext, []byte("member-1"))
+		require.NoError(t, err)
+		assert.Equal(t, "nut allergy", string(plaintext))
+
+		keyID, _, err := rotated.Encrypt([]byte("nut allergy"), []byte("member-1"))
+		require.NoError(t, err)
+		assert.Equal(t, "new", keyID)
+	})
+
+	t.Run("ciphertext can't be moved to another record", func(t *testing.T) {
+		_, err := old.Decrypt(keyID, ciphertext, []byte("member-2"))
+		assert.Error(t, err)
+	})
+
+	t.Run("removed keys can't decrypt", func(t *testing.T) {
+		rotated, err := NewKeyring("new", map[string][]byte{"new": newKey})
+		require.NoError(t, err)
+
+		_, err = rotated.Decrypt(keyID, ciphertext, []byte("member-1"))
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	})
+}
+
+func TestParseKeys(t *testing.T) {
+	key := bytes.Repeat([]byte{3}, KeySize)
+
+	keys, err := ParseKeys("2025:" + base64.StdEncoding.EncodeToString(key) + ", 2026:" + base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"2025": key, "2026": key}, keys)
+
+	_, err = ParseKeys("no-separator")
+	assert.Error(t, err)
+}
@@ -0,0 +1,66 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"google.golang.org/api/idtoken"
+)
+
+// Google verifies Google ID tokens using Google's own library, which caches Google's signing keys.
+type Google struct {
+	clientID  string
+	validator *idtoken.Validator
+}
+
+// NewGoogle creates a Google verifier, which fetches Google's signing keys with client.
+func NewGoogle(clientID string, client *http.Client) (*Google, error) {
+	validator, err := idtoken.NewValidator(context.Background(), idtoken.WithHTTPClient(client))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Google{clientID: clientID, validator: validator}, nil
+}
+
+func (g *Google) Issuers() []string {
+	return []string{"accounts.google.com", "https://accounts.google.com"}
+}
+
+func (g *Google) Verify(ctx context.Context, raw string) (rest.Identity, error) {
+	payload, err := g.validator.Validate(ctx, raw, g.clientID)
+	switch {
+	// The library has no error values to check for, but clients need to know when to get a new token, and whether
+	// it was the token that was wrong or Google's keys that couldn't be fetched.
+	case err != nil && strings.Contains(err.Error(), "token expired"):
+		return rest.Identity{}, fmt.Errorf("%w: %w", ErrExpired, err)
+	case err != nil && googleTokenFault(err):
+		return rest.Identity{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	case err != nil:
+		return rest.Identity{}, fmt.Errorf("fetching Google's signing keys: %w", err)
+	}
+
+	return identity(payload.Issuer, payload.Claims, DefaultClaims, false)
+}
+
+// googleTokenFault reports whether the error from validating a Google ID token is down to the token itself, such as
+// its signature or claims, rather than to fetching Google's signing keys.
+func googleTokenFault(err error) bool {
+	if errors.Is(err, rsa.ErrVerification) {
+		return true
+	}
+
+	msg := err.Error()
+	if strings.Contains(msg, "unable to retrieve cert") || strings.Contains(msg, "cert response is nil") {
+		return false
+	}
+
+	// The library's own errors are about the token, apart from those above. Anything else, such as a network error or
+	// a certs response that isn't JSON, comes from fetching the keys.
+	return strings.HasPrefix(msg, "idtoken:")
+}
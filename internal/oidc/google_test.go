@@ -0,0 +1,84 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// googleCerts stands in for Google's signing keys endpoint, whatever URL is asked for.
+type googleCerts func(w http.ResponseWriter) error
+
+func (f googleCerts) RoundTrip(req *http.Request) (*http.Response, error) {
+	w := httptest.NewRecorder()
+	if err := f(w); err != nil {
+		return nil, err
+	}
+
+	return w.Result(), nil
+}
+
+func TestGoogle_Verify(t *testing.T) {
+	ctx := context.Background()
+
+	iss := newTestIssuer(t)
+	iss.addRSAKey(t, "google-key")
+	claims := iss.claims(map[string]any{"iss": "https://accounts.google.com", "hd": "example.com"})
+
+	serveKeys := googleCerts(func(w http.ResponseWriter) error {
+		return json.NewEncoder(w).Encode(map[string]any{
+			"keys": []any{publicJWK("google-key", iss.keys["google-key"].Public())},
+		})
+	})
+
+	google := func(t *testing.T, certs googleCerts) *Google {
+		g, err := NewGoogle(testAudience, &http.Client{Transport: certs})
+		require.NoError(t, err)
+		return g
+	}
+
+	t.Run("valid tokens", func(t *testing.T) {
+		identity, err := google(t, serveKeys).Verify(ctx, iss.sign(t, "google-key", claims))
+		require.NoError(t, err)
+		assert.Equal(t, "volunteer@example.com", identity.Email)
+		assert.Equal(t, "example.com", identity.HostedDomain)
+	})
+
+	t.Run("tokens that don't match the keys are invalid", func(t *testing.T) {
+		other := newTestIssuer(t)
+		other.addRSAKey(t, "google-key")
+
+		_, err := google(t, serveKeys).Verify(ctx, other.sign(t, "google-key", claims))
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("expired tokens", func(t *testing.T) {
+		expired := iss.claims(map[string]any{"iss": "https://accounts.google.com", "exp": time.Now().Add(-time.Hour).Unix()})
+
+		_, err := google(t, serveKeys).Verify(ctx, iss.sign(t, "google-key", expired))
+		assert.ErrorIs(t, err, ErrExpired)
+	})
+
+	t.Run("keys that can't be fetched don't make the token invalid", func(t *testing.T) {
+		for name, certs := range map[string]googleCerts{
+			"network error": func(http.ResponseWriter) error { return errors.New("connection refused") },
+			"server error": func(w http.ResponseWriter) error {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return nil
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := google(t, certs).Verify(ctx, iss.sign(t, "google-key", claims))
+				require.Error(t, err)
+				assert.NotErrorIs(t, err, ErrInvalidToken)
+			})
+		}
+	})
+}
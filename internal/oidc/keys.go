@@ -0,0 +1,216 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// minKeyRefresh stops tokens with unknown key IDs from making us fetch the keys over and over.
+	minKeyRefresh = time.Minute
+	// defaultKeyExpiry is how long keys are cached for when the issuer doesn't say.
+	defaultKeyExpiry = time.Hour
+	maxKeyExpiry     = 24 * time.Hour
+)
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type publicKey struct {
+	id  string
+	alg string
+	key crypto.PublicKey
+}
+
+var curves = map[string]elliptic.Curve{
+	"P-256": elliptic.P256(),
+	"P-384": elliptic.P384(),
+	"P-521": elliptic.P521(),
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("RSA exponent is too large")
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		curve, ok := curves[k.Crv]
+		if !ok {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) > size || len(y) > size {
+			return nil, fmt.Errorf("EC point is too large for %s", k.Crv)
+		}
+
+		// The point is given uncompressed, with each coordinate padded to the curve's size.
+		point := make([]byte, 1+2*size)
+		point[0] = 4
+		copy(point[1+size-len(x):], x)
+		copy(point[1+2*size-len(y):], y)
+
+		return ecdsa.ParseUncompressedPublicKey(curve, point)
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// keySet caches an issuer's signing keys, fetching them again when they expire, or when a token is signed by a key
+// it doesn't have, as the issuer may have rotated its keys.
+type keySet struct {
+	client *http.Client
+	uri    string
+	now    func() time.Time
+
+	mu      sync.Mutex
+	keys    []publicKey
+	fetched time.Time
+	expires time.Time
+}
+
+// lookup returns the keys the token may have been signed with: the key with the ID, or all the keys if it has none.
+func (s *keySet) lookup(ctx context.Context, kid string) ([]publicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.After(s.expires) || (len(s.matching(kid)) == 0 && now.Sub(s.fetched) >= minKeyRefresh) {
+		if err := s.refresh(ctx, now); err != nil {
+			if len(s.keys) == 0 {
+				return nil, err
+			}
+
+			// Keep using the keys we have until the issuer can be reached again.
+			slog.Warn("failed to refresh signing keys", "err", err, "uri", s.uri)
+		}
+	}
+
+	return s.matching(kid), nil
+}
+
+func (s *keySet) matching(kid string) []publicKey {
+	if kid == "" {
+		return s.keys
+	}
+
+	for _, k := range s.keys {
+		if k.id == kid {
+			return []publicKey{k}
+		}
+	}
+
+	return nil
+}
+
+func (s *keySet) refresh(ctx context.Context, now time.Time) error {
+	// Whether or not it works, don't try again straight away.
+	s.fetched = now
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.uri, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching signing keys: %s", resp.Status)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("decoding signing keys: %w", err)
+	}
+
+	keys := make([]publicKey, 0, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			slog.Warn("skipping signing key", "err", err, "kid", k.Kid, "uri", s.uri)
+			continue
+		}
+
+		keys = append(keys, publicKey{id: k.Kid, alg: k.Alg, key: key})
+	}
+
+	s.keys = keys
+	s.expires = now.Add(maxAge(resp.Header.Get("Cache-Control")))
+
+	return nil
+}
+
+// maxAge returns how long the response may be cached for, according to its Cache-Control header.
+func maxAge(cacheControl string) time.Duration {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		if !strings.EqualFold(name, "max-age") {
+			continue
+		}
+
+		seconds, err := strconv.Atoi(value)
+		if err != nil {
+			break
+		}
+
+		return min(max(time.Duration(seconds)*time.Second, minKeyRefresh), maxKeyExpiry)
+	}
+
+	return defaultKeyExpiry
+}
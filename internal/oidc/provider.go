@@ -0,0 +1,228 @@
+package oidc
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+)
+
+// ClaimMapping names the claims that hold each part of the identity, as they vary between issuers.
+type ClaimMapping struct {
+	Email string
+	// EmailVerified is the claim saying the issuer has verified the email.
+	EmailVerified string
+	Name          string
+	// HostedDomain is the claim holding the Google Workspace domain, or an equivalent.
+	HostedDomain string
+}
+
+// DefaultClaims are the standard OpenID Connect claims, with Google's hosted domain claim.
+var DefaultClaims = ClaimMapping{
+	Email:         "email",
+	EmailVerified: "email_verified",
+	Name:          "name",
+	HostedDomain:  "hd",
+}
+
+type ProviderConfig struct {
+	// Issuer is the issuer's URL, which its tokens' iss claim must match exactly.
+	Issuer string
+	// Audiences are the client IDs tokens may be issued for.
+	Audiences []string
+	// Claims are the claims to read the identity from. Any left empty are the DefaultClaims.
+	Claims ClaimMapping
+	// TrustEmail accepts emails without checking they have been verified, for issuers that only issue tokens with
+	// verified emails but don't include the claim.
+	TrustEmail bool
+}
+
+// Provider verifies the ID tokens from an OpenID Connect issuer, finding its signing keys through discovery.
+type Provider struct {
+	cfg    ProviderConfig
+	client *http.Client
+	now    func() time.Time
+
+	mu   sync.Mutex
+	keys *keySet
+	// failed is when discovery last failed, and failure why.
+	failed  time.Time
+	failure error
+}
+
+// NewProvider creates a Provider. The issuer isn't contacted until the first token is verified.
+func NewProvider(cfg ProviderConfig, client *http.Client) *Provider {
+	cfg.Claims.Email = cmp.Or(cfg.Claims.Email, DefaultClaims.Email)
+	cfg.Claims.EmailVerified = cmp.Or(cfg.Claims.EmailVerified, DefaultClaims.EmailVerified)
+	cfg.Claims.Name = cmp.Or(cfg.Claims.Name, DefaultClaims.Name)
+	cfg.Claims.HostedDomain = cmp.Or(cfg.Claims.HostedDomain, DefaultClaims.HostedDomain)
+
+	return &Provider{
+		cfg:    cfg,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+func (p *Provider) Issuers() []string {
+	return []string{p.cfg.Issuer}
+}
+
+func (p *Provider) Verify(ctx context.Context, raw string) (rest.Identity, error) {
+	t, err := parseToken(raw)
+	if err != nil {
+		return rest.Identity{}, err
+	}
+
+	keys, err := p.keySet(ctx)
+	if err != nil {
+		return rest.Identity{}, err
+	}
+
+	candidates, err := keys.lookup(ctx, t.header.Kid)
+	if err != nil {
+		return rest.Identity{}, err
+	}
+
+	err = ErrInvalidSignature
+	for _, key := range candidates {
+		if key.alg != "" && key.alg != t.header.Alg {
+			continue
+		}
+
+		if err = t.verifySignature(key.key); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return rest.Identity{}, err
+	}
+
+	if err := t.verifyClaims(p.cfg.Issuer, p.cfg.Audiences, p.now()); err != nil {
+		return rest.Identity{}, err
+	}
+
+	return identity(p.cfg.Issuer, t.claims, p.cfg.Claims, p.cfg.TrustEmail)
+}
+
+// keySet discovers the issuer's signing keys the first time it is called. If discovery fails, it isn't tried again
+// for minKeyRefresh, so while the issuer is down its tokens are turned away straight away, rather than each waiting on
+// it in turn.
+func (p *Provider) keySet(ctx context.Context) (*keySet, error) {
+	p.mu.Lock()
+	keys, failed, failure := p.keys, p.failed, p.failure
+	p.mu.Unlock()
+
+	if keys != nil {
+		return keys, nil
+	}
+
+	now := p.now()
+	if failure != nil && now.Sub(failed) < minKeyRefresh {
+		return nil, failure
+	}
+
+	jwksURI, err := p.discover(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err != nil {
+		// A request that gave up says nothing about the issuer.
+		if ctx.Err() == nil {
+			p.failed, p.failure = now, err
+		}
+		return nil, err
+	}
+
+	// Another request may have discovered the keys in the meantime.
+	if p.keys == nil {
+		p.keys = &keySet{client: p.client, uri: jwksURI, now: p.now}
+	}
+
+	return p.keys, nil
+}
+
+// discover returns the URI of the issuer's signing keys, from its discovery document.
+func (p *Provider) discover(ctx context.Context) (string, error) {
+	uri := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("discovering %s: %w", p.cfg.Issuer, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("discovering %s: %s", p.cfg.Issuer, resp.Status)
+	}
+
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+		return "", fmt.Errorf("discovering %s: %w", p.cfg.Issuer, err)
+	}
+
+	if discovery.Issuer != p.cfg.Issuer {
+		return "", fmt.Errorf("discovering %s: issuer is %q", p.cfg.Issuer, discovery.Issuer)
+	}
+
+	if discovery.JWKSURI == "" {
+		return "", fmt.Errorf("discovering %s: no jwks_uri", p.cfg.Issuer)
+	}
+
+	return discovery.JWKSURI, nil
+}
+
+// identity reads the identity from the claims, which must include a verified email unless the issuer's emails are
+// trusted.
+func identity(issuer string, claims map[string]any, mapping ClaimMapping, trustEmail bool) (rest.Identity, error) {
+	id := rest.Identity{Issuer: issuer}
+	id.Subject, _ = claims["sub"].(string)
+	id.Email, _ = claims[mapping.Email].(string)
+	id.Name, _ = claims[mapping.Name].(string)
+	id.HostedDomain, _ = claims[mapping.HostedDomain].(string)
+
+	switch exp := claims["exp"].(type) {
+	case json.Number:
+		if seconds, err := exp.Float64(); err == nil {
+			id.Expiry = time.Unix(int64(seconds), 0)
+		}
+	case float64:
+		id.Expiry = time.Unix(int64(exp), 0)
+	}
+
+	if id.Email == "" {
+		return rest.Identity{}, ErrEmailNotVerified
+	}
+
+	if !trustEmail {
+		// Some issuers give the claim as a string.
+		switch verified := claims[mapping.EmailVerified].(type) {
+		case bool:
+			if !verified {
+				return rest.Identity{}, ErrEmailNotVerified
+			}
+		case string:
+			if verified != "true" {
+				return rest.Identity{}, ErrEmailNotVerified
+			}
+		default:
+			return rest.Identity{}, ErrEmailNotVerified
+		}
+	}
+
+	return id, nil
+}
@@ -0,0 +1,366 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAudience = "district-admin"
+
+// testIssuer is a stand-in OpenID Connect issuer, serving discovery and its signing keys.
+type testIssuer struct {
+	*httptest.Server
+	keys    map[string]crypto.Signer
+	fetches atomic.Int32
+	// down makes discovery fail, and discoveries counts the attempts.
+	down        atomic.Bool
+	discoveries atomic.Int32
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	iss := &testIssuer{keys: map[string]crypto.Signer{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		iss.discoveries.Add(1)
+		if iss.down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": iss.URL, "jwks_uri": iss.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		iss.fetches.Add(1)
+
+		var set struct {
+			Keys []map[string]string `json:"keys"`
+		}
+		for kid, key := range iss.keys {
+			set.Keys = append(set.Keys, publicJWK(kid, key.Public()))
+		}
+
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		_ = json.NewEncoder(w).Encode(set)
+	})
+
+	iss.Server = httptest.NewServer(mux)
+	t.Cleanup(iss.Close)
+
+	return iss
+}
+
+func (iss *testIssuer) addRSAKey(t *testing.T, kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	iss.keys[kid] = key
+}
+
+func (iss *testIssuer) addECKey(t *testing.T, kid string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	iss.keys[kid] = key
+}
+
+// claims returns valid claims for a verified account, with any overrides.
+func (iss *testIssuer) claims(overrides map[string]any) map[string]any {
+	claims := map[string]any{
+		"iss":            iss.URL,
+		"aud":            testAudience,
+		"sub":            "1234",
+		"email":          "volunteer@example.com",
+		"email_verified": true,
+		"name":           "Vicky Volunteer",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range overrides {
+		claims[k] = v
+	}
+
+	return claims
+}
+
+func (iss *testIssuer) sign(t *testing.T, kid string, claims map[string]any) string {
+	key := iss.keys[kid]
+
+	alg := "RS256"
+	if _, ok := key.(*ecdsa.PrivateKey); ok {
+		alg = "ES256"
+	}
+
+	return signToken(t, key, map[string]any{"alg": alg, "kid": kid, "typ": "JWT"}, claims)
+}
+
+func signToken(t *testing.T, key crypto.Signer, header, claims map[string]any) string {
+	h, err := json.Marshal(header)
+	require.NoError(t, err)
+	c, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		require.NoError(t, err)
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func publicJWK(kid string, key crypto.PublicKey) map[string]string {
+	encode := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return map[string]string{"kty": "RSA", "kid": kid, "use": "sig", "n": encode(k.N), "e": encode(big.NewInt(int64(k.E)))}
+	case *ecdsa.PublicKey:
+		point, _ := k.Bytes()
+		size := (len(point) - 1) / 2
+		return map[string]string{
+			"kty": "EC", "kid": kid, "use": "sig", "crv": "P-256",
+			"x": base64.RawURLEncoding.EncodeToString(point[1 : 1+size]),
+			"y": base64.RawURLEncoding.EncodeToString(point[1+size:]),
+		}
+	}
+
+	return nil
+}
+
+func (iss *testIssuer) provider() *Provider {
+	return NewProvider(ProviderConfig{Issuer: iss.URL, Audiences: []string{testAudience}}, iss.Client())
+}
+
+func TestProvider_Verify(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	iss.addRSAKey(t, "rsa")
+	iss.addECKey(t, "ec")
+	p := iss.provider()
+
+	t.Run("RSA signed tokens", func(t *testing.T) {
+		id, err := p.Verify(ctx, iss.sign(t, "rsa", iss.claims(nil)))
+		require.NoError(t, err)
+		assert.Equal(t, iss.URL, id.Issuer)
+		assert.Equal(t, "1234", id.Subject)
+		assert.Equal(t, "volunteer@example.com", id.Email)
+		assert.Equal(t, "Vicky Volunteer", id.Name)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), id.Expiry, time.Minute)
+	})
+
+	t.Run("ECDSA signed tokens", func(t *testing.T) {
+		_, err := p.Verify(ctx, iss.sign(t, "ec", iss.claims(nil)))
+		require.NoError(t, err)
+	})
+
+	t.Run("keys are cached", func(t *testing.T) {
+		assert.Equal(t, int32(1), iss.fetches.Load())
+	})
+
+	tests := []struct {
+		name  string
+		token func() string
+		want  error
+	}{
+		{"wrong audience", func() string {
+			return iss.sign(t, "rsa", iss.claims(map[string]any{"aud": []string{"someone-else"}}))
+		}, ErrWrongAudience},
+		{"expired", func() string {
+			return iss.sign(t, "rsa", iss.claims(map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}))
+		}, ErrExpired},
+		{"no expiry", func() string {
+			return iss.sign(t, "rsa", iss.claims(map[string]any{"exp": nil}))
+		}, ErrExpired},
+		{"not valid yet", func() string {
+			return iss.sign(t, "rsa", iss.claims(map[string]any{"nbf": time.Now().Add(time.Hour).Unix()}))
+		}, ErrNotValidYet},
+		{"email not verified", func() string {
+			return iss.sign(t, "rsa", iss.claims(map[string]any{"email_verified": false}))
+		}, ErrEmailNotVerified},
+		{"tampered", func() string {
+			token := iss.sign(t, "rsa", iss.claims(nil))
+			parts := strings.Split(token, ".")
+			claims, _ := json.Marshal(iss.claims(map[string]any{"email": "admin@example.com"}))
+			return parts[0] + "." + base64.RawURLEncoding.EncodeToString(claims) + "." + parts[2]
+		}, ErrInvalidSignature},
+		{"unsigned", func() string {
+			token := iss.sign(t, "rsa", iss.claims(nil))
+			header, _ := json.Marshal(map[string]string{"alg": "none", "kid": "rsa"})
+			return base64.RawURLEncoding.EncodeToString(header) + "." + strings.Split(token, ".")[1] + "."
+		}, ErrInvalidSignature},
+		{"signed with the wrong algorithm", func() string {
+			return signToken(t, iss.keys["ec"], map[string]any{"alg": "RS256", "kid": "ec"}, iss.claims(nil))
+		}, ErrInvalidSignature},
+		{"not a token", func() string { return "not-a-token" }, ErrMalformedToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.Verify(ctx, tt.token())
+			assert.ErrorIs(t, err, tt.want)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestProvider_KeyRotation(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	iss.addRSAKey(t, "2026-01")
+
+	now := time.Now()
+	p := iss.provider()
+	p.now = func() time.Time { return now }
+
+	_, err := p.Verify(ctx, iss.sign(t, "2026-01", iss.claims(nil)))
+	require.NoError(t, err)
+
+	delete(iss.keys, "2026-01")
+	iss.addRSAKey(t, "2026-02")
+	rotated := iss.sign(t, "2026-02", iss.claims(nil))
+
+	t.Run("keys aren't fetched again straight away", func(t *testing.T) {
+		_, err := p.Verify(ctx, rotated)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+		assert.Equal(t, int32(1), iss.fetches.Load())
+	})
+
+	t.Run("new keys are fetched when a token uses one", func(t *testing.T) {
+		now = now.Add(2 * minKeyRefresh)
+
+		_, err := p.Verify(ctx, rotated)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), iss.fetches.Load())
+	})
+
+	t.Run("keys are fetched again when they expire", func(t *testing.T) {
+		now = now.Add(2 * time.Hour)
+
+		_, err := p.Verify(ctx, iss.sign(t, "2026-02", iss.claims(map[string]any{"exp": now.Add(time.Hour).Unix()})))
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), iss.fetches.Load())
+	})
+}
+
+func TestProvider_DiscoveryFails(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	iss.addRSAKey(t, "rsa")
+	iss.down.Store(true)
+
+	now := time.Now()
+	p := iss.provider()
+	p.now = func() time.Time { return now }
+
+	token := iss.sign(t, "rsa", iss.claims(nil))
+
+	_, err := p.Verify(ctx, token)
+	assert.ErrorContains(t, err, "503 Service Unavailable")
+
+	t.Run("isn't tried again straight away", func(t *testing.T) {
+		_, err := p.Verify(ctx, token)
+		assert.ErrorContains(t, err, "503 Service Unavailable")
+		assert.Equal(t, int32(1), iss.discoveries.Load())
+	})
+
+	t.Run("is tried again after a while", func(t *testing.T) {
+		iss.down.Store(false)
+		now = now.Add(2 * minKeyRefresh)
+
+		_, err := p.Verify(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), iss.discoveries.Load())
+	})
+
+	t.Run("requests that gave up don't count", func(t *testing.T) {
+		p := iss.provider()
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := p.Verify(cancelled, token)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		_, err = p.Verify(ctx, token)
+		assert.NoError(t, err)
+	})
+}
+
+func TestProvider_ClaimMapping(t *testing.T) {
+	iss := newTestIssuer(t)
+	iss.addRSAKey(t, "rsa")
+
+	p := NewProvider(ProviderConfig{
+		Issuer:     iss.URL,
+		Audiences:  []string{testAudience},
+		Claims:     ClaimMapping{Email: "preferred_username"},
+		TrustEmail: true,
+	}, iss.Client())
+
+	id, err := p.Verify(context.Background(), iss.sign(t, "rsa", iss.claims(map[string]any{
+		"email":              nil,
+		"email_verified":     nil,
+		"preferred_username": "volunteer@outlook.com",
+	})))
+	require.NoError(t, err)
+	assert.Equal(t, "volunteer@outlook.com", id.Email)
+	assert.Equal(t, "Vicky Volunteer", id.Name)
+}
+
+func TestVerifiers(t *testing.T) {
+	ctx := context.Background()
+	first, second := newTestIssuer(t), newTestIssuer(t)
+	first.addRSAKey(t, "first")
+	second.addECKey(t, "second")
+
+	v, err := NewVerifiers(first.provider(), second.provider())
+	require.NoError(t, err)
+
+	t.Run("tokens are verified by their issuer", func(t *testing.T) {
+		id, err := v.Verify(ctx, first.sign(t, "first", first.claims(nil)))
+		require.NoError(t, err)
+		assert.Equal(t, first.URL, id.Issuer)
+
+		id, err = v.Verify(ctx, second.sign(t, "second", second.claims(nil)))
+		require.NoError(t, err)
+		assert.Equal(t, second.URL, id.Issuer)
+	})
+
+	t.Run("a token can't claim to be from another issuer", func(t *testing.T) {
+		_, err := v.Verify(ctx, first.sign(t, "first", first.claims(map[string]any{"iss": second.URL})))
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("unknown issuers", func(t *testing.T) {
+		_, err := v.Verify(ctx, first.sign(t, "first", first.claims(map[string]any{"iss": "https://evil.example.com"})))
+		assert.ErrorIs(t, err, ErrUnknownIssuer)
+	})
+
+	t.Run("issuers can only be configured once", func(t *testing.T) {
+		_, err := NewVerifiers(first.provider(), first.provider())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,190 @@
+package oidc
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+)
+
+var (
+	// ErrInvalidToken is wrapped by all the errors for tokens that can't be trusted. Other errors mean the token
+	// couldn't be checked.
+	ErrInvalidToken     = consts.ErrInvalidToken
+	ErrMalformedToken   = fmt.Errorf("%w: malformed", ErrInvalidToken)
+	ErrUnknownIssuer    = fmt.Errorf("%w: unknown issuer", ErrInvalidToken)
+	ErrInvalidSignature = fmt.Errorf("%w: signature does not match", ErrInvalidToken)
+	ErrWrongAudience    = fmt.Errorf("%w: not issued for this service", ErrInvalidToken)
+	ErrExpired          = consts.ErrTokenExpired
+	ErrNotValidYet      = fmt.Errorf("%w: not valid yet", ErrInvalidToken)
+	ErrEmailNotVerified = consts.ErrEmailNotVerified
+)
+
+// leeway allows for clocks that are slightly out with the issuer's.
+const leeway = time.Minute
+
+// algorithms are the signing algorithms tokens may use, with the hash each signs.
+var algorithms = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+	"ES256": crypto.SHA256,
+	"ES384": crypto.SHA384,
+	"ES512": crypto.SHA512,
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// token is a JWT split into its parts, before it has been verified.
+type token struct {
+	header    header
+	claims    map[string]any
+	signed    []byte
+	signature []byte
+}
+
+func parseToken(raw string) (token, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return token{}, ErrMalformedToken
+	}
+
+	var t token
+	if err := decodeSegment(parts[0], &t.header); err != nil {
+		return token{}, err
+	}
+
+	if err := decodeSegment(parts[1], &t.claims); err != nil {
+		return token{}, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return token{}, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	t.signed = []byte(parts[0] + "." + parts[1])
+	t.signature = signature
+
+	return t, nil
+}
+
+func decodeSegment(segment string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(v); err != nil {
+		return fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	return nil
+}
+
+// verifySignature checks the token was signed by the key.
+func (t token) verifySignature(key crypto.PublicKey) error {
+	hash, ok := algorithms[t.header.Alg]
+	if !ok {
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidSignature, t.header.Alg)
+	}
+
+	h := hash.New()
+	h.Write(t.signed)
+	digest := h.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(t.header.Alg, "RS") || rsa.VerifyPKCS1v15(k, hash, digest, t.signature) != nil {
+			return ErrInvalidSignature
+		}
+	case *ecdsa.PublicKey:
+		// ECDSA signatures are the two integers r and s, each padded to the curve's size.
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if !strings.HasPrefix(t.header.Alg, "ES") || len(t.signature) != 2*size {
+			return ErrInvalidSignature
+		}
+
+		r := new(big.Int).SetBytes(t.signature[:size])
+		s := new(big.Int).SetBytes(t.signature[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return ErrInvalidSignature
+		}
+	default:
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// verifyClaims checks the token was issued by the issuer for one of the audiences, and is valid now.
+func (t token) verifyClaims(issuer string, audiences []string, now time.Time) error {
+	if iss, _ := t.claims["iss"].(string); iss != issuer {
+		return ErrUnknownIssuer
+	}
+
+	if !t.hasAudience(audiences) {
+		return ErrWrongAudience
+	}
+
+	exp, ok := t.time("exp")
+	if !ok || now.After(exp.Add(leeway)) {
+		return ErrExpired
+	}
+
+	if nbf, ok := t.time("nbf"); ok && now.Before(nbf.Add(-leeway)) {
+		return ErrNotValidYet
+	}
+
+	return nil
+}
+
+// hasAudience reports whether the token's audience, which may be a string or a list, includes any of the audiences.
+func (t token) hasAudience(audiences []string) bool {
+	var aud []string
+	switch v := t.claims["aud"].(type) {
+	case string:
+		aud = []string{v}
+	case []any:
+		for _, a := range v {
+			if s, ok := a.(string); ok {
+				aud = append(aud, s)
+			}
+		}
+	}
+
+	return slices.ContainsFunc(aud, func(a string) bool {
+		return slices.Contains(audiences, a)
+	})
+}
+
+// time returns the claim as a time, for claims given in seconds since the epoch.
+func (t token) time(claim string) (time.Time, bool) {
+	n, ok := t.claims[claim].(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	seconds, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(seconds), 0), true
+}
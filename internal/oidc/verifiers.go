@@ -0,0 +1,58 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+)
+
+var (
+	_ rest.TokenVerifier = (*Verifiers)(nil)
+	_ IssuerVerifier     = (*Provider)(nil)
+	_ IssuerVerifier     = (*Google)(nil)
+)
+
+// IssuerVerifier verifies the tokens from one or more issuers.
+type IssuerVerifier interface {
+	Issuers() []string
+	Verify(ctx context.Context, raw string) (rest.Identity, error)
+}
+
+// Verifiers verifies tokens from several issuers, passing each token to the verifier for the issuer it claims to be
+// from.
+type Verifiers struct {
+	byIssuer map[string]IssuerVerifier
+}
+
+// NewVerifiers creates Verifiers, returning an error if more than one verifier is given for an issuer.
+func NewVerifiers(verifiers ...IssuerVerifier) (*Verifiers, error) {
+	v := &Verifiers{byIssuer: map[string]IssuerVerifier{}}
+	for _, verifier := range verifiers {
+		for _, issuer := range verifier.Issuers() {
+			if _, ok := v.byIssuer[issuer]; ok {
+				return nil, fmt.Errorf("issuer %q is configured more than once", issuer)
+			}
+
+			v.byIssuer[issuer] = verifier
+		}
+	}
+
+	return v, nil
+}
+
+func (v *Verifiers) Verify(ctx context.Context, raw string) (rest.Identity, error) {
+	// The issuer can't be trusted until the verifier has checked the signature.
+	t, err := parseToken(raw)
+	if err != nil {
+		return rest.Identity{}, err
+	}
+
+	issuer, _ := t.claims["iss"].(string)
+	verifier, ok := v.byIssuer[issuer]
+	if !ok {
+		return rest.Identity{}, ErrUnknownIssuer
+	}
+
+	return verifier.Verify(ctx, raw)
+}
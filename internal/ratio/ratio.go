@@ -0,0 +1,64 @@
+package ratio
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Rule is the adult to child ratio for one section doing one kind of activity.
+type Rule struct {
+	GirlsPerAdult int
+	MinAdults     int
+}
+
+// Rules holds the rules for one kind of activity, keyed by section.
+type Rules map[string]Rule
+
+// RequiredAdults calculates the number of adults needed for the girls attending, keyed by section. Each girl needs a
+// share of an adult according to her section's ratio, and the shares are added up across sections before rounding,
+// so mixed groups aren't over-counted. It is never fewer than the largest minimum of the sections attending.
+func (r Rules) RequiredAdults(girls map[string]int) (int, error) {
+	sections := make([]string, 0, len(girls))
+	for section, count := range girls {
+		if count > 0 {
+			sections = append(sections, section)
+		}
+	}
+	slices.Sort(sections)
+
+	if len(sections) == 0 {
+		return 0, nil
+	}
+
+	// Add the fractions girls/GirlsPerAdult over a common denominator to keep the arithmetic exact.
+	denominator := 1
+	for _, section := range sections {
+		rule, ok := r[section]
+		if !ok || rule.GirlsPerAdult < 1 {
+			return 0, fmt.Errorf("no ratio rule for %s", section)
+		}
+		denominator = lcm(denominator, rule.GirlsPerAdult)
+	}
+
+	numerator, minimum := 0, 0
+	for _, section := range sections {
+		rule := r[section]
+		numerator += girls[section] * (denominator / rule.GirlsPerAdult)
+		minimum = max(minimum, rule.MinAdults)
+	}
+
+	required := (numerator + denominator - 1) / denominator
+
+	return max(required, minimum), nil
+}
+
+func lcm(a, b int) int {
+	return a / gcd(a, b) * b
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
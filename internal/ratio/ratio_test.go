@@ -0,0 +1,54 @@
+package ratio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRules_RequiredAdults(t *testing.T) {
+	rules := Rules{
+		"rainbows": {GirlsPerAdult: 5, MinAdults: 2},
+		"brownies": {GirlsPerAdult: 8, MinAdults: 2},
+		"guides":   {GirlsPerAdult: 12, MinAdults: 2},
+		"rangers":  {GirlsPerAdult: 12, MinAdults: 1},
+	}
+
+	tests := []struct {
+		name  string
+		girls map[string]int
+		want  int
+	}{
+		{name: "nobody attending needs no adults", girls: map[string]int{"rainbows": 0}, want: 0},
+		{name: "small groups need the minimum", girls: map[string]int{"rainbows": 3}, want: 2},
+		{name: "a full ratio needs no extra adult", girls: map[string]int{"brownies": 24}, want: 3},
+		{name: "one over the ratio needs another adult", girls: map[string]int{"brownies": 25}, want: 4},
+		{name: "the minimum is the largest of the sections attending", girls: map[string]int{"rangers": 5}, want: 1},
+		{
+			// 12/8 + 18/12 = 3, where rounding each section separately would need 4.
+			name:  "mixed sections share adults",
+			girls: map[string]int{"brownies": 12, "guides": 18, "rangers": 0},
+			want:  3,
+		},
+		{
+			// 11/5 + 17/8 + 13/12 = 5.4
+			name:  "mixed sections round up once",
+			girls: map[string]int{"rainbows": 11, "brownies": 17, "guides": 13},
+			want:  6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rules.RequiredAdults(tt.girls)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("sections without a rule can't be checked", func(t *testing.T) {
+		_, err := Rules{}.RequiredAdults(map[string]int{"guides": 4})
+		assert.EqualError(t, err, "no ratio rule for guides")
+	})
+}
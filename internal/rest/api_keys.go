@@ -0,0 +1,153 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/google/uuid"
+	"github.com/thanhpk/randstr"
+)
+
+// APIKeyPrefix starts every API key, so the authenticator can tell them from ID tokens.
+const APIKeyPrefix = "dk_"
+
+const (
+	apiKeyLength = 40
+	// maxAPIKeyLifetime is the longest an API key can be created for, so forgotten keys don't work forever.
+	maxAPIKeyLifetime = 365 * 24 * time.Hour
+)
+
+var scopePermissions = map[APIKeyScope]Permission{
+	Read:      PermissionRead,
+	Write:     PermissionWrite,
+	Sensitive: PermissionSensitive,
+}
+
+func (s *Server) AdminListAPIKeys(ctx context.Context, request AdminListAPIKeysRequestObject) (AdminListAPIKeysResponseObject, error) {
+	if !allowedAnywhere(ctx, PermissionRead) {
+		return AdminListAPIKeys403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	var owner *string
+	if !allowed(ctx, PermissionWrite, nil) {
+		email, _ := UserEmailFromContext(ctx)
+		owner = &email
+	}
+
+	keys, err := s.db.ListAPIKeys(ctx, owner)
+	if err != nil {
+		slog.Error("failed to list API keys", "err", err)
+		return AdminListAPIKeys500JSONResponse{ErrorMessage: "failed to list API keys"}, nil
+	}
+
+	if keys == nil {
+		keys = []APIKey{}
+	}
+
+	return AdminListAPIKeys200JSONResponse{ApiKeys: keys}, nil
+}
+
+func (s *Server) AdminCreateAPIKey(ctx context.Context, request AdminCreateAPIKeyRequestObject) (AdminCreateAPIKeyResponseObject, error) {
+	if !allowedAnywhere(ctx, PermissionRead) {
+		return AdminCreateAPIKey403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	p, _ := PrincipalFromContext(ctx)
+	if p.APIKeyID != uuid.Nil {
+		return AdminCreateAPIKey400JSONResponse{ErrorMessage: "API keys can't create other API keys"}, nil
+	}
+
+	if err := validateAPIKey(*request.Body, time.Now()); err != nil {
+		return AdminCreateAPIKey400JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	key := APIKeyPrefix + randstr.Base62(apiKeyLength)
+
+	created, err := s.db.CreateAPIKey(ctx, *request.Body, p.Email, hashToken(key))
+	switch {
+	case errors.Is(err, consts.ErrConflict):
+		return AdminCreateAPIKey409JSONResponse{ErrorMessage: "you already have an API key with this name"}, nil
+	case err != nil:
+		slog.Error("failed to create API key", "err", err)
+		return AdminCreateAPIKey500JSONResponse{ErrorMessage: "failed to create API key"}, nil
+	}
+
+	slog.Info("API key created", "id", created.Id, "name", created.Name, "by", p.Email)
+	// created doesn't hold the key, only its hash, which the database keeps to itself.
+	audit(ctx, "api_key.create", "api_key", created.Id.String(), nil, created)
+
+	return AdminCreateAPIKey201JSONResponse{
+		Id:         created.Id,
+		Name:       created.Name,
+		Owner:      created.Owner,
+		Scopes:     created.Scopes,
+		CreatedAt:  created.CreatedAt,
+		ExpiresAt:  created.ExpiresAt,
+		LastUsedAt: created.LastUsedAt,
+		LastUsedIP: created.LastUsedIP,
+		Key:        key,
+	}, nil
+}
+
+func (s *Server) AdminDeleteAPIKey(ctx context.Context, request AdminDeleteAPIKeyRequestObject) (AdminDeleteAPIKeyResponseObject, error) {
+	key, err := s.db.GetAPIKey(ctx, request.ApiKeyID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminDeleteAPIKey404JSONResponse{ErrorMessage: "API key not found"}, nil
+	case err != nil:
+		slog.Error("failed to get API key", "err", err)
+		return AdminDeleteAPIKey500JSONResponse{ErrorMessage: "failed to delete API key"}, nil
+	}
+
+	// Admins can delete their own keys, and those who manage the district anyone's.
+	email, _ := UserEmailFromContext(ctx)
+	if !strings.EqualFold(string(key.Owner), email) && !allowed(ctx, PermissionWrite, nil) {
+		return AdminDeleteAPIKey403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	err = s.db.DeleteAPIKey(ctx, key.Id)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminDeleteAPIKey404JSONResponse{ErrorMessage: "API key not found"}, nil
+	case err != nil:
+		slog.Error("failed to delete API key", "err", err)
+		return AdminDeleteAPIKey500JSONResponse{ErrorMessage: "failed to delete API key"}, nil
+	}
+
+	slog.Info("API key deleted", "id", key.Id, "owner", key.Owner, "by", email)
+	audit(ctx, "api_key.delete", "api_key", key.Id.String(), key, nil)
+
+	return AdminDeleteAPIKey204Response{}, nil
+}
+
+func validateAPIKey(key APIKeyInput, now time.Time) error {
+	if strings.TrimSpace(key.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	if !key.ExpiresAt.After(now) {
+		return errors.New("expiresAt must be in the future")
+	}
+
+	if key.ExpiresAt.After(now.Add(maxAPIKeyLifetime)) {
+		return errors.New("expiresAt must be within a year")
+	}
+
+	return nil
+}
+
+// scopedPermissions are the permissions an API key's scopes allow.
+func scopedPermissions(scopes []APIKeyScope) []Permission {
+	permissions := []Permission{}
+	for _, scope := range scopes {
+		if p, ok := scopePermissions[scope]; ok {
+			permissions = append(permissions, p)
+		}
+	}
+
+	return permissions
+}
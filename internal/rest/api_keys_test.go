@@ -0,0 +1,134 @@
+package rest_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_AdminCreateAPIKey(t *testing.T) {
+	treasurer := "treasurer@staplehurstguiding.org.uk"
+	input := rest.APIKeyInput{Name: "Reports", Scopes: []rest.APIKeyScope{rest.Read}, ExpiresAt: time.Now().Add(90 * 24 * time.Hour)}
+
+	t.Run("creates a key for the admin", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := adminContext(treasurer, role(rest.Treasurer, ""))
+
+		var hash []byte
+		m.db.EXPECT().CreateAPIKey(ctx, input, treasurer, gomock.Any()).
+			DoAndReturn(func(_ context.Context, key rest.APIKeyInput, owner string, keyHash []byte) (rest.APIKey, error) {
+				hash = keyHash
+				return rest.APIKey{Id: uuid.New(), Name: key.Name, Owner: "treasurer@staplehurstguiding.org.uk", Scopes: key.Scopes}, nil
+			})
+
+		resp, err := s.AdminCreateAPIKey(ctx, rest.AdminCreateAPIKeyRequestObject{Body: &input})
+		require.NoError(t, err)
+		require.IsType(t, rest.AdminCreateAPIKey201JSONResponse{}, resp)
+
+		key := resp.(rest.AdminCreateAPIKey201JSONResponse).Key
+		assert.True(t, strings.HasPrefix(key, rest.APIKeyPrefix))
+
+		// Only the hash of the key is kept.
+		want := sha256.Sum256([]byte(key))
+		assert.Equal(t, want[:], hash)
+	})
+
+	t.Run("keys must expire within a year", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		ctx := adminContext(treasurer, role(rest.Treasurer, ""))
+
+		forever := input
+		forever.ExpiresAt = time.Now().Add(2 * 365 * 24 * time.Hour)
+
+		resp, err := s.AdminCreateAPIKey(ctx, rest.AdminCreateAPIKeyRequestObject{Body: &forever})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminCreateAPIKey400JSONResponse{}, resp)
+	})
+
+	t.Run("API keys can't create keys", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		ctx := context.WithValue(context.Background(), rest.PrincipalKey{}, rest.Principal{
+			Email:    treasurer,
+			Roles:    rest.Roles{role(rest.Treasurer, "")},
+			APIKeyID: uuid.New(),
+			Scopes:   []rest.Permission{rest.PermissionRead},
+		})
+
+		resp, err := s.AdminCreateAPIKey(ctx, rest.AdminCreateAPIKeyRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminCreateAPIKey400JSONResponse{}, resp)
+	})
+}
+
+func TestServer_AdminListAPIKeys(t *testing.T) {
+	t.Run("admins see their own keys", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := adminContext("treasurer@staplehurstguiding.org.uk", role(rest.Treasurer, ""))
+
+		owner := "treasurer@staplehurstguiding.org.uk"
+		m.db.EXPECT().ListAPIKeys(ctx, &owner).Return(nil, nil)
+
+		resp, err := s.AdminListAPIKeys(ctx, rest.AdminListAPIKeysRequestObject{})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminListAPIKeys200JSONResponse{ApiKeys: []rest.APIKey{}}, resp)
+	})
+
+	t.Run("the commissioner sees everyone's", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := commissionerContext()
+
+		m.db.EXPECT().ListAPIKeys(ctx, nil).Return(nil, nil)
+
+		_, err := s.AdminListAPIKeys(ctx, rest.AdminListAPIKeysRequestObject{})
+		require.NoError(t, err)
+	})
+}
+
+func TestServer_AdminDeleteAPIKey(t *testing.T) {
+	key := rest.APIKey{Id: uuid.New(), Owner: "treasurer@staplehurstguiding.org.uk"}
+
+	t.Run("admins can delete their own keys", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := adminContext("treasurer@staplehurstguiding.org.uk", role(rest.Treasurer, ""))
+
+		m.db.EXPECT().GetAPIKey(ctx, key.Id).Return(key, nil)
+		m.db.EXPECT().DeleteAPIKey(ctx, key.Id).Return(nil)
+
+		resp, err := s.AdminDeleteAPIKey(ctx, rest.AdminDeleteAPIKeyRequestObject{ApiKeyID: key.Id})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminDeleteAPIKey204Response{}, resp)
+	})
+
+	t.Run("but not other admins' keys", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+
+		m.db.EXPECT().GetAPIKey(ctx, key.Id).Return(key, nil)
+
+		resp, err := s.AdminDeleteAPIKey(ctx, rest.AdminDeleteAPIKeyRequestObject{ApiKeyID: key.Id})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminDeleteAPIKey403JSONResponse{}, resp)
+	})
+}
+
+func TestPrincipal_Allows(t *testing.T) {
+	leader := rest.Principal{Roles: rest.Roles{role(rest.UnitLeader, "1st-brownies")}}
+	unit := "1st-brownies"
+
+	assert.True(t, leader.Allows(rest.PermissionWrite, &unit))
+
+	leader.Scopes = []rest.Permission{rest.PermissionRead}
+	assert.True(t, leader.Allows(rest.PermissionRead, &unit))
+	assert.False(t, leader.Allows(rest.PermissionWrite, &unit), "scopes limit what the roles allow")
+
+	treasurer := rest.Principal{Roles: rest.Roles{role(rest.Treasurer, "")}, Scopes: []rest.Permission{rest.PermissionWrite}}
+	assert.False(t, treasurer.Allows(rest.PermissionWrite, nil), "scopes don't add to what the roles allow")
+}
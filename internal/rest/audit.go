@@ -0,0 +1,316 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultAuditLimit = 100
+	// auditExportLimit caps the entries in an export, which should be narrowed with the filters if it is reached.
+	auditExportLimit = 10000
+)
+
+var errAuditForbidden = errors.New("only admins who can see sensitive details across the district can see the audit log")
+
+// Auditor records the admin actions in the audit log.
+type Auditor struct {
+	store AuditStore
+}
+
+func NewAuditor(store AuditStore) *Auditor {
+	return &Auditor{store: store}
+}
+
+type auditKey struct{}
+
+// auditRecord is what the handler says its action was, for the Auditor to record once it has responded.
+type auditRecord struct {
+	action     string
+	targetType string
+	targetID   string
+	changes    map[string]AuditChange
+}
+
+// Record records every admin request that could change anything, after it has been handled, along with reads that
+// handlers ask to be recorded, such as exports. It must come after the JWTAuthenticator, so the actor is known.
+func (a *Auditor) Record(ctx *fiber.Ctx) error {
+	record := &auditRecord{}
+	ctx.SetUserContext(context.WithValue(ctx.UserContext(), auditKey{}, record))
+
+	handlerErr := ctx.Next()
+
+	if safeMethod(ctx.Method()) && record.action == "" {
+		return handlerErr
+	}
+
+	userCtx := ctx.UserContext()
+
+	status := ctx.Response().StatusCode()
+	if fe := new(fiber.Error); errors.As(handlerErr, &fe) {
+		status = fe.Code
+	} else if handlerErr != nil {
+		status = fiber.StatusInternalServerError
+	}
+
+	entry := AuditEntry{
+		OccurredAt: time.Now(),
+		Action:     record.action,
+		Method:     ctx.Method(),
+		Path:       ctx.Path(),
+		Status:     status,
+		TargetType: optional(record.targetType),
+		TargetId:   optional(record.targetID),
+	}
+
+	if entry.Action == "" {
+		entry.Action = ctx.Method() + " " + ctx.Route().Path
+	}
+
+	if len(record.changes) > 0 {
+		entry.Changes = &record.changes
+	}
+
+	if p, ok := PrincipalFromContext(userCtx); ok {
+		entry.Actor = p.Email
+
+		credential := CredentialToken
+		switch {
+		case p.SessionID != uuid.Nil:
+			credential = CredentialSession
+		case p.APIKeyID != uuid.Nil:
+			credential = CredentialAPIKey
+		}
+		entry.Credential = &credential
+	}
+
+	if ip, ok := UserIPFromContext(userCtx); ok {
+		entry.Ip = optional(ip)
+	}
+
+	if sc := trace.SpanContextFromContext(userCtx); sc.HasTraceID() {
+		entry.TraceId = optional(sc.TraceID().String())
+	}
+
+	// The response has already been decided, so a failure is logged rather than returned.
+	if err := a.store.AddAuditEntry(userCtx, entry); err != nil {
+		slog.Error("failed to record audit entry", "err", err, "action", entry.Action, "actor", entry.Actor)
+	}
+
+	return handlerErr
+}
+
+// audit names the action the handler took on the target, for the Auditor to record. Before and after are the target
+// as it was and became, and either can be nil when it was created or deleted, or both when nothing is worth keeping.
+func audit(ctx context.Context, action, targetType, targetID string, before, after any) {
+	record, ok := ctx.Value(auditKey{}).(*auditRecord)
+	if !ok {
+		return
+	}
+
+	record.action = action
+	record.targetType = targetType
+	record.targetID = targetID
+
+	changes, err := auditChanges(before, after)
+	if err != nil {
+		slog.Error("failed to work out audit changes", "err", err, "action", action)
+	}
+	record.changes = changes
+}
+
+// auditChanges compares the fields of before and after as they would be given to clients, returning those that
+// differ.
+func auditChanges(before, after any) (map[string]AuditChange, error) {
+	b, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]AuditChange{}
+	for name, value := range b {
+		if !reflect.DeepEqual(value, a[name]) {
+			changes[name] = AuditChange{Before: value, After: a[name]}
+		}
+	}
+	for name, value := range a {
+		if _, ok := b[name]; !ok {
+			changes[name] = AuditChange{After: value}
+		}
+	}
+
+	return changes, nil
+}
+
+func auditFields(v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("%T isn't an object: %w", v, err)
+	}
+
+	return fields, nil
+}
+
+func (s *Server) AdminListAuditLog(ctx context.Context, request AdminListAuditLogRequestObject) (AdminListAuditLogResponseObject, error) {
+	if !allowed(ctx, PermissionSensitive, nil) {
+		return AdminListAuditLog403JSONResponse{ErrorMessage: errAuditForbidden.Error()}, nil
+	}
+
+	params := request.Params
+	filter := AuditFilter{
+		Actor:      params.Actor,
+		Action:     params.Action,
+		TargetType: params.TargetType,
+		TargetID:   params.TargetId,
+		Since:      params.Since,
+		Until:      params.Until,
+		Before:     params.Before,
+		Limit:      defaultAuditLimit,
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	// One more than asked for is fetched, to find out whether there's another page.
+	filter.Limit++
+	entries, err := s.db.ListAuditEntries(ctx, filter)
+	if err != nil {
+		slog.Error("failed to list audit entries", "err", err)
+		return AdminListAuditLog500JSONResponse{ErrorMessage: "failed to search the audit log"}, nil
+	}
+
+	resp := AdminListAuditLog200JSONResponse{Entries: entries}
+	if len(entries) == filter.Limit {
+		resp.Entries = entries[:len(entries)-1]
+		resp.NextBefore = &resp.Entries[len(resp.Entries)-1].Id
+	}
+
+	if resp.Entries == nil {
+		resp.Entries = []AuditEntry{}
+	}
+
+	return resp, nil
+}
+
+func (s *Server) AdminExportAuditLog(ctx context.Context, request AdminExportAuditLogRequestObject) (AdminExportAuditLogResponseObject, error) {
+	if !allowed(ctx, PermissionSensitive, nil) {
+		return AdminExportAuditLog403JSONResponse{ErrorMessage: errAuditForbidden.Error()}, nil
+	}
+
+	params := request.Params
+	entries, err := s.db.ListAuditEntries(ctx, AuditFilter{
+		Actor:      params.Actor,
+		Action:     params.Action,
+		TargetType: params.TargetType,
+		TargetID:   params.TargetId,
+		Since:      params.Since,
+		Until:      params.Until,
+		Limit:      auditExportLimit,
+	})
+	if err != nil {
+		slog.Error("failed to list audit entries", "err", err)
+		return AdminExportAuditLog500JSONResponse{ErrorMessage: "failed to export the audit log"}, nil
+	}
+
+	body, err := auditCSV(entries)
+	if err != nil {
+		slog.Error("failed to write audit csv", "err", err)
+		return AdminExportAuditLog500JSONResponse{ErrorMessage: "failed to export the audit log"}, nil
+	}
+
+	// Who has taken the audit log away matters as much as anything in it.
+	audit(ctx, "audit_log.export", "", "", nil, nil)
+
+	return AdminExportAuditLog200TextcsvResponse{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+	}, nil
+}
+
+func auditCSV(entries []AuditEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	err := w.Write([]string{
+		"ID", "Occurred At", "Actor", "Credential", "IP", "Action", "Target Type", "Target ID", "Changes", "Method",
+		"Path", "Status", "Trace ID",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		changes := ""
+		if e.Changes != nil {
+			raw, err := json.Marshal(e.Changes)
+			if err != nil {
+				return nil, err
+			}
+			changes = string(raw)
+		}
+
+		credential := ""
+		if e.Credential != nil {
+			credential = string(*e.Credential)
+		}
+
+		err := w.Write([]string{
+			strconv.FormatInt(e.Id, 10),
+			e.OccurredAt.UTC().Format(time.RFC3339),
+			csvSafe(e.Actor),
+			credential,
+			deref(e.Ip),
+			csvSafe(e.Action),
+			csvSafe(deref(e.TargetType)),
+			csvSafe(deref(e.TargetId)),
+			csvSafe(changes),
+			e.Method,
+			csvSafe(e.Path),
+			strconv.Itoa(e.Status),
+			deref(e.TraceId),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("flushing csv: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,207 @@
+package rest_test
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newAuditedApp serves the admin API as the admin in ctx, recording their actions in the audit log.
+func newAuditedApp(t *testing.T, ctx context.Context) (*fiber.App, mocks) {
+	s, m := newTestServer(t)
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.SetUserContext(ctx)
+		return c.Next()
+	})
+	app.Use(rest.NewAuditor(m.db).Record)
+	rest.RegisterHandlers(app, rest.NewStrictHandler(s, nil))
+
+	return app, m
+}
+
+func TestAuditor_Record(t *testing.T) {
+	invitationID := uuid.New()
+
+	t.Run("records the named action", func(t *testing.T) {
+		app, m := newAuditedApp(t, commissionerContext())
+
+		m.db.EXPECT().DeleteInvitation(gomock.Any(), invitationID).Return(nil)
+		m.db.EXPECT().AddAuditEntry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry rest.AuditEntry) error {
+			assert.Equal(t, "invitation.remove", entry.Action)
+			assert.Equal(t, commissionerEmail, entry.Actor)
+			assert.Equal(t, rest.CredentialToken, *entry.Credential)
+			assert.Equal(t, "invitation", *entry.TargetType)
+			assert.Equal(t, invitationID.String(), *entry.TargetId)
+			assert.Equal(t, http.MethodDelete, entry.Method)
+			assert.Equal(t, fiber.StatusNoContent, entry.Status)
+			assert.Nil(t, entry.Changes)
+			return nil
+		})
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodDelete, "/api/v1/admin/invitations/"+invitationID.String(), nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, resp.StatusCode)
+	})
+
+	t.Run("records refused actions by their route", func(t *testing.T) {
+		app, m := newAuditedApp(t, adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies")))
+
+		m.db.EXPECT().AddAuditEntry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry rest.AuditEntry) error {
+			assert.Equal(t, "DELETE /api/v1/admin/invitations/:invitationID", entry.Action)
+			assert.Equal(t, fiber.StatusForbidden, entry.Status)
+			assert.Nil(t, entry.TargetType)
+			return nil
+		})
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodDelete, "/api/v1/admin/invitations/"+invitationID.String(), nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("doesn't record reads", func(t *testing.T) {
+		app, m := newAuditedApp(t, commissionerContext())
+
+		m.db.EXPECT().ListInvitations(gomock.Any()).Return(nil, nil)
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/v1/admin/invitations", nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("records the fields that changed", func(t *testing.T) {
+		app, m := newAuditedApp(t, commissionerContext())
+
+		member := rest.Member{
+			Id:          uuid.New(),
+			Name:        "Ada",
+			DateOfBirth: openapi_types.Date{Time: time.Date(2016, 3, 1, 0, 0, 0, 0, time.UTC)},
+			ParentName:  "Grace",
+			ParentEmail: "grace@example.com",
+			Unit:        "1st-brownies",
+		}
+		updated := member
+		updated.Name = "Ada Lovelace"
+
+		m.db.EXPECT().GetMember(gomock.Any(), member.Id).Return(member, nil)
+		m.db.EXPECT().UpdateMember(gomock.Any(), member.Id, gomock.Any()).Return(updated, nil)
+		m.db.EXPECT().AddAuditEntry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry rest.AuditEntry) error {
+			assert.Equal(t, "member.update", entry.Action)
+			require.NotNil(t, entry.Changes)
+			assert.Equal(t, map[string]rest.AuditChange{
+				"name": {Before: "Ada", After: "Ada Lovelace"},
+			}, *entry.Changes)
+			return nil
+		})
+
+		body := `{"name":"Ada Lovelace","dateOfBirth":"2016-03-01","parentName":"Grace","parentEmail":"grace@example.com"}`
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/members/"+member.Id.String(), strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestServer_AdminListAuditLog(t *testing.T) {
+	entries := func(ids ...int64) []rest.AuditEntry {
+		var e []rest.AuditEntry
+		for _, id := range ids {
+			e = append(e, rest.AuditEntry{Id: id, Action: "member.update", Actor: commissionerEmail})
+		}
+		return e
+	}
+
+	t.Run("pages through the entries", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := commissionerContext()
+
+		limit := 2
+		m.db.EXPECT().ListAuditEntries(ctx, rest.AuditFilter{Limit: 3}).Return(entries(9, 8, 7), nil)
+
+		resp, err := s.AdminListAuditLog(ctx, rest.AdminListAuditLogRequestObject{Params: rest.AdminListAuditLogParams{Limit: &limit}})
+		require.NoError(t, err)
+		require.IsType(t, rest.AdminListAuditLog200JSONResponse{}, resp)
+
+		log := resp.(rest.AdminListAuditLog200JSONResponse)
+		assert.Equal(t, entries(9, 8), log.Entries)
+		require.NotNil(t, log.NextBefore)
+		assert.Equal(t, int64(8), *log.NextBefore)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := commissionerContext()
+
+		before := int64(8)
+		m.db.EXPECT().ListAuditEntries(ctx, rest.AuditFilter{Before: &before, Limit: 101}).Return(entries(7), nil)
+
+		resp, err := s.AdminListAuditLog(ctx, rest.AdminListAuditLogRequestObject{Params: rest.AdminListAuditLogParams{Before: &before}})
+		require.NoError(t, err)
+		require.IsType(t, rest.AdminListAuditLog200JSONResponse{}, resp)
+
+		log := resp.(rest.AdminListAuditLog200JSONResponse)
+		assert.Equal(t, entries(7), log.Entries)
+		assert.Nil(t, log.NextBefore)
+	})
+
+	t.Run("unit leaders can't see the audit log", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+
+		resp, err := s.AdminListAuditLog(ctx, rest.AdminListAuditLogRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminListAuditLog403JSONResponse{}, resp)
+	})
+}
+
+func TestServer_AdminExportAuditLog(t *testing.T) {
+	s, m := newTestServer(t)
+	ctx := commissionerContext()
+
+	action := "member.update"
+	targetType := "member"
+	target := "=HYPERLINK(\"http://example.com\")"
+	m.db.EXPECT().ListAuditEntries(ctx, rest.AuditFilter{Action: &action, Limit: 10000}).Return([]rest.AuditEntry{{
+		Id:         3,
+		OccurredAt: time.Date(2026, 5, 1, 9, 30, 0, 0, time.UTC),
+		Actor:      commissionerEmail,
+		Action:     action,
+		TargetType: &targetType,
+		TargetId:   &target,
+		Method:     http.MethodPut,
+		Path:       "/api/v1/admin/members/1",
+		Status:     fiber.StatusOK,
+	}}, nil)
+
+	resp, err := s.AdminExportAuditLog(ctx, rest.AdminExportAuditLogRequestObject{Params: rest.AdminExportAuditLogParams{Action: &action}})
+	require.NoError(t, err)
+	require.IsType(t, rest.AdminExportAuditLog200TextcsvResponse{}, resp)
+
+	body, err := io.ReadAll(resp.(rest.AdminExportAuditLog200TextcsvResponse).Body)
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(strings.NewReader(string(body))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "Occurred At", rows[0][1])
+	assert.Equal(t, []string{
+		"3", "2026-05-01T09:30:00Z", commissionerEmail, "", "", action, "member", "'" + target, "", http.MethodPut,
+		"/api/v1/admin/members/1", "200", "",
+	}, rows[1])
+}
@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+)
+
+const (
+	calendarProductID = "-//Girlguiding Staplehurst District//Events//EN"
+	calendarName      = "Girlguiding Staplehurst District"
+	calendarUIDDomain = "staplehurstguiding.org.uk"
+
+	// calendarHistory is how far back the feed goes, so recent events don't vanish from subscribers' calendars as
+	// soon as they finish.
+	calendarHistory = 30 * 24 * time.Hour
+)
+
+func (s *Server) GetCalendar(ctx context.Context, request GetCalendarRequestObject) (GetCalendarResponseObject, error) {
+	now := time.Now()
+
+	events, err := s.db.ListEvents(ctx, EventFilter{
+		From:     now.Add(-calendarHistory),
+		To:       now.AddDate(1, 0, 0),
+		Unit:     request.Params.Unit,
+		Statuses: publicEventStatuses,
+	})
+	if err != nil {
+		slog.Error("failed to list events for calendar", "err", err)
+		return GetCalendar500JSONResponse{ErrorMessage: "failed to list events"}, nil
+	}
+
+	body := eventsCalendar(events).Serialize()
+
+	return GetCalendar200TextcalendarResponse{
+		Body:          strings.NewReader(body),
+		ContentLength: int64(len(body)),
+	}, nil
+}
+
+func eventsCalendar(events []AdminEvent) *ics.Calendar {
+	cal := ics.NewCalendar()
+	cal.SetMethod(ics.MethodPublish)
+	cal.SetProductId(calendarProductID)
+	cal.SetName(calendarName)
+	cal.SetXWRCalName(calendarName)
+	cal.SetRefreshInterval("PT1H")
+
+	for _, e := range events {
+		ve := cal.AddEvent(e.Id.String() + "@" + calendarUIDDomain)
+		ve.SetDtStampTime(e.UpdatedAt)
+		ve.SetCreatedTime(e.CreatedAt)
+		ve.SetModifiedAt(e.UpdatedAt)
+		ve.SetStartAt(e.Start)
+		ve.SetEndAt(e.End)
+		ve.SetSummary(e.Title)
+
+		if e.Description != nil {
+			ve.SetDescription(*e.Description)
+		}
+		if e.Location != nil {
+			ve.SetLocation(*e.Location)
+		}
+
+		if string(e.Status) == consts.EventStatusCancelled {
+			ve.SetStatus(ics.ObjectStatusCancelled)
+		} else {
+			ve.SetStatus(ics.ObjectStatusConfirmed)
+		}
+	}
+
+	return cal
+}
@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Denylist keeps the admins and sessions whose access has been revoked in memory, so every request can be checked
+// without going to the database. Each replica refreshes its own copy, so revocations reach them all within the
+// refresh interval.
+type Denylist struct {
+	loader RevocationLoader
+
+	mu       sync.RWMutex
+	emails   map[string]struct{}
+	sessions map[uuid.UUID]struct{}
+}
+
+func NewDenylist(loader RevocationLoader) *Denylist {
+	return &Denylist{
+		loader:   loader,
+		emails:   map[string]struct{}{},
+		sessions: map[uuid.UUID]struct{}{},
+	}
+}
+
+// Revoked reports whether the admin's access, or the session, has been revoked. Requests made with bearer tokens have
+// no session, so pass uuid.Nil.
+func (d *Denylist) Revoked(email string, session uuid.UUID) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if _, ok := d.emails[strings.ToLower(email)]; ok {
+		return true
+	}
+
+	_, ok := d.sessions[session]
+	return ok && session != uuid.Nil
+}
+
+// Refresh replaces the denylist with the revocations in the database.
+func (d *Denylist) Refresh(ctx context.Context) error {
+	revocations, err := d.loader.ListRevocations(ctx)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := d.loader.ListRevokedSessions(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	emails := make(map[string]struct{}, len(revocations))
+	for _, r := range revocations {
+		emails[strings.ToLower(string(r.Email))] = struct{}{}
+	}
+
+	revokedSessions := make(map[uuid.UUID]struct{}, len(sessions))
+	for _, id := range sessions {
+		revokedSessions[id] = struct{}{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.emails = emails
+	d.sessions = revokedSessions
+
+	return nil
+}
+
+// Run calls Refresh every interval until the context is done. If a refresh fails, the previous denylist is kept.
+func (d *Denylist) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.Refresh(ctx); err != nil {
+				slog.Error("failed to refresh denylist", "err", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package rest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	mock_rest "github.com/girlguidingstaplehurst/district/internal/rest/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDenylist(t *testing.T) {
+	ctx := context.Background()
+	session := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	loader := mock_rest.NewMockRevocationLoader(ctrl)
+	denylist := rest.NewDenylist(loader)
+
+	t.Run("nothing is revoked before the first refresh", func(t *testing.T) {
+		assert.False(t, denylist.Revoked("leaver@staplehurstguiding.org.uk", session))
+	})
+
+	loader.EXPECT().ListRevocations(ctx).Return([]rest.Revocation{{Email: "leaver@staplehurstguiding.org.uk"}}, nil)
+	loader.EXPECT().ListRevokedSessions(ctx, gomock.Any()).Return([]uuid.UUID{session}, nil)
+	require.NoError(t, denylist.Refresh(ctx))
+
+	t.Run("revoked admins", func(t *testing.T) {
+		assert.True(t, denylist.Revoked("Leaver@StaplehurstGuiding.org.uk", uuid.Nil))
+		assert.False(t, denylist.Revoked("leader@staplehurstguiding.org.uk", uuid.Nil))
+	})
+
+	t.Run("revoked sessions", func(t *testing.T) {
+		assert.True(t, denylist.Revoked("leader@staplehurstguiding.org.uk", session))
+		assert.False(t, denylist.Revoked("leader@staplehurstguiding.org.uk", uuid.New()))
+	})
+
+	t.Run("the denylist is kept if a refresh fails", func(t *testing.T) {
+		loader.EXPECT().ListRevocations(ctx).Return(nil, errors.New("connection refused"))
+
+		assert.Error(t, denylist.Refresh(ctx))
+		assert.True(t, denylist.Revoked("leaver@staplehurstguiding.org.uk", uuid.Nil))
+	})
+
+	t.Run("lifted revocations are removed", func(t *testing.T) {
+		loader.EXPECT().ListRevocations(ctx).Return(nil, nil)
+		loader.EXPECT().ListRevokedSessions(ctx, gomock.Any()).Return(nil, nil)
+
+		require.NoError(t, denylist.Refresh(ctx))
+		assert.False(t, denylist.Revoked("leaver@staplehurstguiding.org.uk", session))
+	})
+}
@@ -0,0 +1,308 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+)
+
+// publicEventStatuses are shown to the public. Cancelled events stay visible so that anyone planning to attend can
+// see that they are no longer happening.
+var publicEventStatuses = []string{consts.EventStatusApproved, consts.EventStatusCancelled}
+
+var eventStatuses = []string{
+	consts.EventStatusProvisional,
+	consts.EventStatusAwaitingDocuments,
+	consts.EventStatusApproved,
+	consts.EventStatusCancelled,
+}
+
+func (s *Server) ListEvents(ctx context.Context, request ListEventsRequestObject) (ListEventsResponseObject, error) {
+	filter, err := eventFilter(request.Params.From, request.Params.To, request.Params.Unit)
+	if err != nil {
+		return ListEvents422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+	filter.Statuses = publicEventStatuses
+
+	events, err := s.db.ListEvents(ctx, filter)
+	if err != nil {
+		slog.Error("failed to list events", "err", err)
+		return ListEvents500JSONResponse{ErrorMessage: "failed to list events"}, nil
+	}
+
+	resp := ListEvents200JSONResponse{Events: make([]Event, 0, len(events))}
+	for _, e := range events {
+		resp.Events = append(resp.Events, publicEvent(e))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) AdminListEvents(ctx context.Context, request AdminListEventsRequestObject) (AdminListEventsResponseObject, error) {
+	if !allowedAnywhere(ctx, PermissionRead) {
+		return AdminListEvents403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	filter, err := eventFilter(request.Params.From, request.Params.To, request.Params.Unit)
+	if err != nil {
+		return AdminListEvents422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	events, err := s.db.ListEvents(ctx, filter)
+	if err != nil {
+		slog.Error("failed to list events", "err", err)
+		return AdminListEvents500JSONResponse{ErrorMessage: "failed to list events"}, nil
+	}
+
+	// Admins only see the events of the units they can see, and district events if they have a district role.
+	p, _ := PrincipalFromContext(ctx)
+	events = slices.DeleteFunc(events, func(e AdminEvent) bool {
+		return !p.Allows(PermissionRead, e.Unit)
+	})
+
+	if events == nil {
+		events = []AdminEvent{}
+	}
+
+	for i := range events {
+		s.addRatios(ctx, &events[i])
+	}
+
+	return AdminListEvents200JSONResponse{Events: events}, nil
+}
+
+func (s *Server) AdminCreateEvent(ctx context.Context, request AdminCreateEventRequestObject) (AdminCreateEventResponseObject, error) {
+	eventDefaults(request.Body)
+	if err := validateEvent(request.Body); err != nil {
+		return AdminCreateEvent422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	if !allowed(ctx, PermissionWrite, request.Body.Unit) {
+		return AdminCreateEvent403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+
+	event, err := s.db.CreateEvent(ctx, *request.Body, email)
+	if err != nil {
+		slog.Error("failed to create event", "err", err)
+		return AdminCreateEvent500JSONResponse{ErrorMessage: "failed to create event"}, nil
+	}
+
+	audit(ctx, "event.create", "event", event.Id.String(), nil, event)
+
+	s.addRatios(ctx, &event)
+
+	return AdminCreateEvent201JSONResponse(event), nil
+}
+
+func (s *Server) AdminGetEvent(ctx context.Context, request AdminGetEventRequestObject) (AdminGetEventResponseObject, error) {
+	event, err := s.db.GetEvent(ctx, request.EventID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminGetEvent404JSONResponse{ErrorMessage: "event not found"}, nil
+	case err != nil:
+		slog.Error("failed to get event", "err", err)
+		return AdminGetEvent500JSONResponse{ErrorMessage: "failed to get event"}, nil
+	}
+
+	if !allowed(ctx, PermissionRead, event.Unit) {
+		return AdminGetEvent403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	s.addRatios(ctx, &event)
+
+	return AdminGetEvent200JSONResponse(event), nil
+}
+
+func (s *Server) AdminUpdateEvent(ctx context.Context, request AdminUpdateEventRequestObject) (AdminUpdateEventResponseObject, error) {
+	eventDefaults(request.Body)
+	if err := validateEvent(request.Body); err != nil {
+		return AdminUpdateEvent422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	current, err := s.db.GetEvent(ctx, request.EventID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminUpdateEvent404JSONResponse{ErrorMessage: "event not found"}, nil
+	case err != nil:
+		slog.Error("failed to get event", "err", err)
+		return AdminUpdateEvent500JSONResponse{ErrorMessage: "failed to get event"}, nil
+	}
+
+	// Moving an event to another unit needs permission for both units.
+	if !allowed(ctx, PermissionWrite, current.Unit) || !allowed(ctx, PermissionWrite, request.Body.Unit) {
+		return AdminUpdateEvent403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	if *request.Body.RatioEnforcement == consts.RatioEnforcementBlock {
+		rules, err := s.ratioRules(ctx)
+		if err != nil {
+			slog.Error("failed to list ratio rules", "err", err)
+			return AdminUpdateEvent500JSONResponse{ErrorMessage: "failed to list ratio rules"}, nil
+		}
+
+		girls := map[string]int{}
+		if current.ConfirmedBySection != nil {
+			girls = *current.ConfirmedBySection
+		}
+
+		check, err := ratioCheck(rules[string(*request.Body.Activity)], girls, *request.Body.AdultHelpers)
+		if err != nil {
+			return AdminUpdateEvent422JSONResponse{ErrorMessage: err.Error()}, nil
+		}
+
+		if !check.Met {
+			return AdminUpdateEvent422JSONResponse{
+				ErrorMessage: fmt.Sprintf("the confirmed sign-ups need at least %d adult helpers", check.RequiredAdults),
+			}, nil
+		}
+	}
+
+	event, err := s.db.UpdateEvent(ctx, request.EventID, *request.Body)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminUpdateEvent404JSONResponse{ErrorMessage: "event not found"}, nil
+	case err != nil:
+		slog.Error("failed to update event", "err", err)
+		return AdminUpdateEvent500JSONResponse{ErrorMessage: "failed to update event"}, nil
+	}
+
+	audit(ctx, "event.update", "event", event.Id.String(), current, event)
+
+	// The capacity or adult helpers may have been raised, so give any new places to the waiting list.
+	if event.Signups != nil {
+		s.promoteSignups(ctx, event.Id)
+	}
+
+	s.addRatios(ctx, &event)
+
+	return AdminUpdateEvent200JSONResponse(event), nil
+}
+
+func (s *Server) AdminDeleteEvent(ctx context.Context, request AdminDeleteEventRequestObject) (AdminDeleteEventResponseObject, error) {
+	event, err := s.db.GetEvent(ctx, request.EventID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminDeleteEvent404JSONResponse{ErrorMessage: "event not found"}, nil
+	case err != nil:
+		slog.Error("failed to get event", "err", err)
+		return AdminDeleteEvent500JSONResponse{ErrorMessage: "failed to delete event"}, nil
+	}
+
+	if !allowed(ctx, PermissionWrite, event.Unit) {
+		return AdminDeleteEvent403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	err = s.db.DeleteEvent(ctx, request.EventID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminDeleteEvent404JSONResponse{ErrorMessage: "event not found"}, nil
+	case err != nil:
+		slog.Error("failed to delete event", "err", err)
+		return AdminDeleteEvent500JSONResponse{ErrorMessage: "failed to delete event"}, nil
+	}
+
+	audit(ctx, "event.delete", "event", event.Id.String(), event, nil)
+
+	return AdminDeleteEvent204Response{}, nil
+}
+
+func eventFilter(from, to *time.Time, unit *string) (EventFilter, error) {
+	filter := EventFilter{
+		From: time.Now(),
+		Unit: unit,
+	}
+
+	if from != nil {
+		filter.From = *from
+	}
+
+	filter.To = filter.From.AddDate(1, 0, 0)
+	if to != nil {
+		filter.To = *to
+	}
+
+	if !filter.To.After(filter.From) {
+		return EventFilter{}, errors.New("to must be after from")
+	}
+
+	return filter, nil
+}
+
+func validateEvent(event *EventInput) error {
+	if strings.TrimSpace(event.Title) == "" {
+		return errors.New("title must not be empty")
+	}
+
+	if !event.End.After(event.Start) {
+		return errors.New("event must end after it starts")
+	}
+
+	if !slices.Contains(eventStatuses, string(event.Status)) {
+		return errors.New("status is not valid")
+	}
+
+	if !slices.Contains(activities, string(*event.Activity)) {
+		return errors.New("activity is not valid")
+	}
+
+	if *event.AdultHelpers < 0 {
+		return errors.New("adult helpers must not be negative")
+	}
+
+	if !slices.Contains(ratioEnforcements, string(*event.RatioEnforcement)) {
+		return errors.New("ratio enforcement is not valid")
+	}
+
+	if event.Signups != nil {
+		settings := event.Signups
+		if settings.Deadline.After(event.Start) {
+			return errors.New("sign-up deadline must be before the event starts")
+		}
+
+		if settings.MinAge != nil && settings.MaxAge != nil && *settings.MinAge > *settings.MaxAge {
+			return errors.New("sign-up minimum age must not be above the maximum age")
+		}
+	}
+
+	return nil
+}
+
+// eventDefaults fills in the optional ratio settings, so an event that leaves them out is a meeting that only flags a
+// broken ratio.
+func eventDefaults(event *EventInput) {
+	if event.Activity == nil {
+		activity := Activity(consts.ActivityMeeting)
+		event.Activity = &activity
+	}
+
+	if event.AdultHelpers == nil {
+		adultHelpers := 0
+		event.AdultHelpers = &adultHelpers
+	}
+
+	if event.RatioEnforcement == nil {
+		enforcement := RatioEnforcement(consts.RatioEnforcementFlag)
+		event.RatioEnforcement = &enforcement
+	}
+}
+
+func publicEvent(e AdminEvent) Event {
+	return Event{
+		Id:          e.Id,
+		Title:       e.Title,
+		Description: e.Description,
+		Location:    e.Location,
+		Unit:        e.Unit,
+		Start:       e.Start,
+		End:         e.End,
+		Status:      e.Status,
+	}
+}
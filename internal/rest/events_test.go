@@ -0,0 +1,168 @@
+package rest_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_ListEvents(t *testing.T) {
+	ctx := commissionerContext()
+	from := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	unit := "1st-brownies"
+
+	t.Run("only requests publicly visible events", func(t *testing.T) {
+		s, m := newTestServer(t)
+		m.db.EXPECT().ListEvents(ctx, rest.EventFilter{
+			From:     from,
+			To:       to,
+			Unit:     &unit,
+			Statuses: []string{consts.EventStatusApproved, consts.EventStatusCancelled},
+		}).Return([]rest.AdminEvent{{
+			Id:        uuid.New(),
+			Title:     "Thinking Day",
+			Start:     from.Add(time.Hour),
+			End:       from.Add(2 * time.Hour),
+			Status:    consts.EventStatusApproved,
+			CreatedBy: "leader@staplehurstguiding.org.uk",
+		}}, nil)
+
+		resp, err := s.ListEvents(ctx, rest.ListEventsRequestObject{
+			Params: rest.ListEventsParams{From: &from, To: &to, Unit: &unit},
+		})
+		require.NoError(t, err)
+		require.IsType(t, rest.ListEvents200JSONResponse{}, resp)
+
+		events := resp.(rest.ListEvents200JSONResponse).Events
+		require.Len(t, events, 1)
+		assert.Equal(t, "Thinking Day", events[0].Title)
+	})
+
+	t.Run("rejects a range that ends before it starts", func(t *testing.T) {
+		s, _ := newTestServer(t)
+
+		resp, err := s.ListEvents(ctx, rest.ListEventsRequestObject{
+			Params: rest.ListEventsParams{From: &to, To: &from},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.ListEvents422JSONResponse{ErrorMessage: "to must be after from"}, resp)
+	})
+}
+
+func TestServer_AdminCreateEvent(t *testing.T) {
+	ctx := commissionerContext()
+	start := time.Date(2026, time.February, 22, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		event   rest.EventInput
+		errResp string
+	}{
+		{
+			name:    "title is required",
+			event:   rest.EventInput{Title: " ", Start: start, End: start.Add(time.Hour), Status: consts.EventStatusProvisional},
+			errResp: "title must not be empty",
+		},
+		{
+			name:    "event must end after it starts",
+			event:   rest.EventInput{Title: "AGM", Start: start, End: start, Status: consts.EventStatusProvisional},
+			errResp: "event must end after it starts",
+		},
+		{
+			name:    "status must be known",
+			event:   rest.EventInput{Title: "AGM", Start: start, End: start.Add(time.Hour), Status: "maybe"},
+			errResp: "status is not valid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestServer(t)
+
+			resp, err := s.AdminCreateEvent(ctx, rest.AdminCreateEventRequestObject{Body: &tt.event})
+			require.NoError(t, err)
+			assert.Equal(t, rest.AdminCreateEvent422JSONResponse{ErrorMessage: tt.errResp}, resp)
+		})
+	}
+}
+
+func TestServer_AdminListEvents(t *testing.T) {
+	ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+	brownies, guides := "1st-brownies", "1st-guides"
+	events := []rest.AdminEvent{
+		{Id: uuid.New(), Title: "Brownie Revels", Unit: &brownies},
+		{Id: uuid.New(), Title: "Guides' camp", Unit: &guides},
+		{Id: uuid.New(), Title: "District AGM"},
+	}
+
+	t.Run("leaders only see their own units' events", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().ListEvents(ctx, gomock.Any()).Return(events, nil)
+		m.db.EXPECT().ListRatioRules(ctx).Return(nil, nil)
+
+		resp, err := s.AdminListEvents(ctx, rest.AdminListEventsRequestObject{})
+		require.NoError(t, err)
+		require.IsType(t, rest.AdminListEvents200JSONResponse{}, resp)
+
+		listed := resp.(rest.AdminListEvents200JSONResponse).Events
+		require.Len(t, listed, 1)
+		assert.Equal(t, "Brownie Revels", listed[0].Title)
+	})
+}
+
+func TestServer_AdminGetEvent(t *testing.T) {
+	ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+	guides := "1st-guides"
+	event := rest.AdminEvent{Id: uuid.New(), Title: "Guides' camp", Status: consts.EventStatusProvisional, Unit: &guides}
+
+	t.Run("leaders can't see other units' events", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().GetEvent(ctx, event.Id).Return(event, nil)
+
+		resp, err := s.AdminGetEvent(ctx, rest.AdminGetEventRequestObject{EventID: event.Id})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminGetEvent403JSONResponse{ErrorMessage: "your roles don't allow this"}, resp)
+	})
+}
+
+func TestServer_GetCalendar(t *testing.T) {
+	ctx := commissionerContext()
+	start := time.Date(2026, time.July, 10, 17, 0, 0, 0, time.UTC)
+	id := uuid.New()
+	location := "Staplehurst Village Hall"
+
+	s, m := newTestServer(t)
+	m.db.EXPECT().ListEvents(ctx, gomock.Any()).Return([]rest.AdminEvent{{
+		Id:        id,
+		Title:     "District Camp",
+		Location:  &location,
+		Start:     start,
+		End:       start.AddDate(0, 0, 2),
+		Status:    consts.EventStatusCancelled,
+		CreatedAt: start.AddDate(0, -3, 0),
+		UpdatedAt: start.AddDate(0, -1, 0),
+	}}, nil)
+
+	resp, err := s.GetCalendar(ctx, rest.GetCalendarRequestObject{})
+	require.NoError(t, err)
+	require.IsType(t, rest.GetCalendar200TextcalendarResponse{}, resp)
+
+	body, err := io.ReadAll(resp.(rest.GetCalendar200TextcalendarResponse).Body)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(body), "UID:"+id.String()+"@staplehurstguiding.org.uk")
+	assert.Contains(t, string(body), "SUMMARY:District Camp")
+	assert.Contains(t, string(body), "LOCATION:Staplehurst Village Hall")
+	assert.Contains(t, string(body), "DTSTART:20260710T170000Z")
+	assert.Contains(t, string(body), "STATUS:CANCELLED")
+}
@@ -9,19 +9,188 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/url"
 	"path"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gofiber/fiber/v2"
+	"github.com/oapi-codegen/runtime"
 )
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List the signed in admin's API keys, or everyone's for admins who can change district settings
+	// (GET /api/v1/admin/api-keys)
+	AdminListAPIKeys(c *fiber.Ctx) error
+	// Create an API key for scripts to use as the signed in admin
+	// (POST /api/v1/admin/api-keys)
+	AdminCreateAPIKey(c *fiber.Ctx) error
+	// Delete an API key, so it can no longer be used
+	// (DELETE /api/v1/admin/api-keys/{apiKeyID})
+	AdminDeleteAPIKey(c *fiber.Ctx, apiKeyID APIKeyID) error
+	// Search the audit log of admin actions, most recent first
+	// (GET /api/v1/admin/audit-log)
+	AdminListAuditLog(c *fiber.Ctx, params AdminListAuditLogParams) error
+	// Export the audit log entries matching the filters as CSV, most recent first
+	// (GET /api/v1/admin/audit-log.csv)
+	AdminExportAuditLog(c *fiber.Ctx, params AdminExportAuditLogParams) error
+	// List all events, regardless of status
+	// (GET /api/v1/admin/events)
+	AdminListEvents(c *fiber.Ctx, params AdminListEventsParams) error
+	// Create an event
+	// (POST /api/v1/admin/events)
+	AdminCreateEvent(c *fiber.Ctx) error
+	// Delete an event
+	// (DELETE /api/v1/admin/events/{eventID})
+	AdminDeleteEvent(c *fiber.Ctx, eventID EventID) error
+	// Get an event
+	// (GET /api/v1/admin/events/{eventID})
+	AdminGetEvent(c *fiber.Ctx, eventID EventID) error
+	// Update an event
+	// (PUT /api/v1/admin/events/{eventID})
+	AdminUpdateEvent(c *fiber.Ctx, eventID EventID) error
+	// Export the confirmed attendees of an event as CSV
+	// (GET /api/v1/admin/events/{eventID}/attendees.csv)
+	AdminExportEventAttendees(c *fiber.Ctx, eventID EventID) error
+	// List sign-ups for an event, including the waiting list
+	// (GET /api/v1/admin/events/{eventID}/signups)
+	AdminListEventSignups(c *fiber.Ctx, eventID EventID) error
+	// Cancel a sign-up, promoting from the waiting list if a place becomes free
+	// (DELETE /api/v1/admin/events/{eventID}/signups/{signupID})
+	AdminCancelEventSignup(c *fiber.Ctx, eventID EventID, signupID SignupID) error
+	// List the invitations for admins from outside the hosted domains
+	// (GET /api/v1/admin/invitations)
+	AdminListInvitations(c *fiber.Ctx) error
+	// Invite someone from outside the hosted domains to sign in as an admin
+	// (POST /api/v1/admin/invitations)
+	AdminCreateInvitation(c *fiber.Ctx) error
+	// Remove an invitation, so the admin can no longer sign in
+	// (DELETE /api/v1/admin/invitations/{invitationID})
+	AdminRemoveInvitation(c *fiber.Ctx, invitationID InvitationID) error
+	// List the changes made to a join request and its place offers, oldest first
+	// (GET /api/v1/admin/join-requests/{joinRequestID}/history)
+	AdminGetJoinRequestHistory(c *fiber.Ctx, joinRequestID JoinRequestID) error
+	// Get the signed in admin and their roles
+	// (GET /api/v1/admin/me)
+	AdminGetMe(c *fiber.Ctx) error
+	// Get a member, without their sensitive details
+	// (GET /api/v1/admin/members/{memberID})
+	AdminGetMember(c *fiber.Ctx, memberID MemberID) error
+	// Update a member, without their sensitive details
+	// (PUT /api/v1/admin/members/{memberID})
+	AdminUpdateMember(c *fiber.Ctx, memberID MemberID) error
+	// List who has read a member's sensitive details, most recent first
+	// (GET /api/v1/admin/members/{memberID}/access-log)
+	AdminGetMemberAccessLog(c *fiber.Ctx, memberID MemberID) error
+	// Get a member's emergency contacts and medical details. Every read is recorded in the access log.
+	// (GET /api/v1/admin/members/{memberID}/sensitive)
+	AdminGetMemberSensitive(c *fiber.Ctx, memberID MemberID) error
+	// Replace a member's emergency contacts and medical details
+	// (PUT /api/v1/admin/members/{memberID}/sensitive)
+	AdminSetMemberSensitive(c *fiber.Ctx, memberID MemberID) error
+	// Move a member to another unit, letting the leaders of both units know
+	// (POST /api/v1/admin/members/{memberID}/transfer)
+	AdminTransferMember(c *fiber.Ctx, memberID MemberID) error
+	// List the members who become old enough for the next section by the end of a term
+	// (GET /api/v1/admin/move-ups)
+	AdminGetMoveUpReport(c *fiber.Ctx, params AdminGetMoveUpReportParams) error
+	// Calculate the adult helpers needed for a planned activity
+	// (POST /api/v1/admin/ratio-check)
+	AdminCheckRatio(c *fiber.Ctx) error
+	// List the adult to child ratio rules
+	// (GET /api/v1/admin/ratio-rules)
+	AdminListRatioRules(c *fiber.Ctx) error
+	// Create or update adult to child ratio rules
+	// (PUT /api/v1/admin/ratio-rules)
+	AdminSaveRatioRules(c *fiber.Ctx) error
+	// List the admins whose access has been revoked
+	// (GET /api/v1/admin/revocations)
+	AdminListRevocations(c *fiber.Ctx) error
+	// Revoke an admin's access
+	// (POST /api/v1/admin/revocations)
+	AdminRevokeUser(c *fiber.Ctx) error
+	// Remove a revocation, so the admin can sign in again
+	// (DELETE /api/v1/admin/revocations/{revocationID})
+	AdminRemoveRevocation(c *fiber.Ctx, revocationID RevocationID) error
+	// List the roles assigned to admins
+	// (GET /api/v1/admin/roles)
+	AdminListRoles(c *fiber.Ctx) error
+	// Assign a role to an admin
+	// (POST /api/v1/admin/roles)
+	AdminAssignRole(c *fiber.Ctx) error
+	// Remove a role from an admin
+	// (DELETE /api/v1/admin/roles/{roleID})
+	AdminRemoveRole(c *fiber.Ctx, roleID RoleID) error
+	// Sign out of the current session
+	// (DELETE /api/v1/admin/session)
+	AdminDeleteSession(c *fiber.Ctx) error
+	// Start a cookie session for the admin signed in with the bearer ID token, so the admin site doesn't need to
+	// keep the token. The session lasts while it's in use, up to its lifetime.
+	// (POST /api/v1/admin/session)
+	AdminCreateSession(c *fiber.Ctx) error
+	// List the admins' active sessions
+	// (GET /api/v1/admin/sessions)
+	AdminListSessions(c *fiber.Ctx, params AdminListSessionsParams) error
+	// Revoke a session, signing it out on every replica within seconds
+	// (DELETE /api/v1/admin/sessions/{sessionID})
+	AdminRevokeSession(c *fiber.Ctx, sessionID SessionID) error
+	// List the units in the district with their capacity and membership
+	// (GET /api/v1/admin/units)
+	AdminListUnits(c *fiber.Ctx) error
+	// Update a unit's capacity and leader
+	// (PUT /api/v1/admin/units/{unitID})
+	AdminUpdateUnit(c *fiber.Ctx, unitID UnitID) error
+	// List the members of a unit
+	// (GET /api/v1/admin/units/{unitID}/members)
+	AdminListUnitMembers(c *fiber.Ctx, unitID UnitID) error
+	// Add a member to a unit
+	// (POST /api/v1/admin/units/{unitID}/members)
+	AdminCreateMember(c *fiber.Ctx, unitID UnitID) error
+	// List the children waiting to join a unit who are still the right age for it
+	// (GET /api/v1/admin/units/{unitID}/waiting-list)
+	AdminGetWaitingList(c *fiber.Ctx, unitID UnitID, params AdminGetWaitingListParams) error
+	// Offer a child on the waiting list a place in the unit
+	// (POST /api/v1/admin/units/{unitID}/waiting-list/{joinRequestID}/offer)
+	AdminOfferPlace(c *fiber.Ctx, unitID UnitID, joinRequestID JoinRequestID) error
+	// Combined iCalendar feed of district and unit events
+	// (GET /api/v1/calendar.ics)
+	GetCalendar(c *fiber.Ctx, params GetCalendarParams) error
 	// Send a contact us message
 	// (POST /api/v1/contact-us)
 	ContactUs(c *fiber.Ctx) error
+	// List publicly visible district and unit events
+	// (GET /api/v1/events)
+	ListEvents(c *fiber.Ctx, params ListEventsParams) error
+	// Sign up for an event, joining the waiting list if it is full
+	// (POST /api/v1/events/{eventID}/signups)
+	SignUpForEvent(c *fiber.Ctx, eventID EventID) error
+	// Cancel a sign-up using the token sent in the confirmation email
+	// (POST /api/v1/events/{eventID}/signups/{signupID}/cancel)
+	CancelEventSignup(c *fiber.Ctx, eventID EventID, signupID SignupID) error
+	// Get the signed in parent's children and their event sign-ups
+	// (GET /api/v1/family)
+	GetFamily(c *fiber.Ctx) error
+	// Sign the parent out
+	// (DELETE /api/v1/family/session)
+	DeleteParentSession(c *fiber.Ctx) error
+	// Ask for a child to join the waiting list for one or more units
+	// (POST /api/v1/join-requests)
+	CreateJoinRequest(c *fiber.Ctx) error
+	// Email a sign in link to a parent, if the district has any of their family's records. The response is the same
+	// whether or not it does, so it can't be used to find out who is a member.
+	// (POST /api/v1/parent-login)
+	CreateParentLogin(c *fiber.Ctx) error
+	// Swap the token from a sign in link for a session cookie. Each link can only be used once.
+	// (POST /api/v1/parent-login/session)
+	CreateParentSession(c *fiber.Ctx) error
+	// Accept or decline a place using the token sent in the offer email
+	// (POST /api/v1/place-offers/{offerID}/response)
+	RespondToPlaceOffer(c *fiber.Ctx, offerID OfferID) error
+	// List the units in the district
+	// (GET /api/v1/units)
+	ListUnits(c *fiber.Ctx) error
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -31,114 +200,5364 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc fiber.Handler
 
+// AdminListAPIKeys operation middleware
+func (siw *ServerInterfaceWrapper) AdminListAPIKeys(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminListAPIKeys(c)
+}
+
+// AdminCreateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateAPIKey(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminCreateAPIKey(c)
+}
+
+// AdminDeleteAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteAPIKey(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "apiKeyID" -------------
+	var apiKeyID APIKeyID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyID", c.Params("apiKeyID"), &apiKeyID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter apiKeyID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminDeleteAPIKey(c, apiKeyID)
+}
+
+// AdminListAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) AdminListAuditLog(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListAuditLogParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", query, &params.Actor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter actor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", query, &params.Action)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter action: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "targetType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetType", query, &params.TargetType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter targetType: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "targetId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetId", query, &params.TargetId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter targetId: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", query, &params.Since)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter since: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", query, &params.Until)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter until: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", query, &params.Before)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter before: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.AdminListAuditLog(c, params)
+}
+
+// AdminExportAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) AdminExportAuditLog(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminExportAuditLogParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", query, &params.Actor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter actor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", query, &params.Action)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter action: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "targetType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetType", query, &params.TargetType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter targetType: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "targetId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetId", query, &params.TargetId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter targetId: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", query, &params.Since)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter since: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", query, &params.Until)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter until: %w", err).Error())
+	}
+
+	return siw.Handler.AdminExportAuditLog(c, params)
+}
+
+// AdminListEvents operation middleware
+func (siw *ServerInterfaceWrapper) AdminListEvents(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListEventsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "unit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", query, &params.Unit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter unit: %w", err).Error())
+	}
+
+	return siw.Handler.AdminListEvents(c, params)
+}
+
+// AdminCreateEvent operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateEvent(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminCreateEvent(c)
+}
+
+// AdminDeleteEvent operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteEvent(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "eventID" -------------
+	var eventID EventID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventID", c.Params("eventID"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter eventID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminDeleteEvent(c, eventID)
+}
+
+// AdminGetEvent operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetEvent(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "eventID" -------------
+	var eventID EventID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventID", c.Params("eventID"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter eventID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminGetEvent(c, eventID)
+}
+
+// AdminUpdateEvent operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateEvent(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "eventID" -------------
+	var eventID EventID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventID", c.Params("eventID"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter eventID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminUpdateEvent(c, eventID)
+}
+
+// AdminExportEventAttendees operation middleware
+func (siw *ServerInterfaceWrapper) AdminExportEventAttendees(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "eventID" -------------
+	var eventID EventID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventID", c.Params("eventID"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter eventID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminExportEventAttendees(c, eventID)
+}
+
+// AdminListEventSignups operation middleware
+func (siw *ServerInterfaceWrapper) AdminListEventSignups(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "eventID" -------------
+	var eventID EventID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventID", c.Params("eventID"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter eventID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminListEventSignups(c, eventID)
+}
+
+// AdminCancelEventSignup operation middleware
+func (siw *ServerInterfaceWrapper) AdminCancelEventSignup(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "eventID" -------------
+	var eventID EventID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventID", c.Params("eventID"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter eventID: %w", err).Error())
+	}
+
+	// ------------- Path parameter "signupID" -------------
+	var signupID SignupID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "signupID", c.Params("signupID"), &signupID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter signupID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminCancelEventSignup(c, eventID, signupID)
+}
+
+// AdminListInvitations operation middleware
+func (siw *ServerInterfaceWrapper) AdminListInvitations(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminListInvitations(c)
+}
+
+// AdminCreateInvitation operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateInvitation(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminCreateInvitation(c)
+}
+
+// AdminRemoveInvitation operation middleware
+func (siw *ServerInterfaceWrapper) AdminRemoveInvitation(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "invitationID" -------------
+	var invitationID InvitationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationID", c.Params("invitationID"), &invitationID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter invitationID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminRemoveInvitation(c, invitationID)
+}
+
+// AdminGetJoinRequestHistory operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetJoinRequestHistory(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "joinRequestID" -------------
+	var joinRequestID JoinRequestID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "joinRequestID", c.Params("joinRequestID"), &joinRequestID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter joinRequestID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminGetJoinRequestHistory(c, joinRequestID)
+}
+
+// AdminGetMe operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetMe(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminGetMe(c)
+}
+
+// AdminGetMember operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetMember(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID MemberID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberID", c.Params("memberID"), &memberID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter memberID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminGetMember(c, memberID)
+}
+
+// AdminUpdateMember operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateMember(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID MemberID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberID", c.Params("memberID"), &memberID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter memberID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminUpdateMember(c, memberID)
+}
+
+// AdminGetMemberAccessLog operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetMemberAccessLog(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID MemberID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberID", c.Params("memberID"), &memberID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter memberID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminGetMemberAccessLog(c, memberID)
+}
+
+// AdminGetMemberSensitive operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetMemberSensitive(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID MemberID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberID", c.Params("memberID"), &memberID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter memberID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminGetMemberSensitive(c, memberID)
+}
+
+// AdminSetMemberSensitive operation middleware
+func (siw *ServerInterfaceWrapper) AdminSetMemberSensitive(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID MemberID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberID", c.Params("memberID"), &memberID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter memberID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminSetMemberSensitive(c, memberID)
+}
+
+// AdminTransferMember operation middleware
+func (siw *ServerInterfaceWrapper) AdminTransferMember(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID MemberID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberID", c.Params("memberID"), &memberID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter memberID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminTransferMember(c, memberID)
+}
+
+// AdminGetMoveUpReport operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetMoveUpReport(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminGetMoveUpReportParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "term", query, &params.Term)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter term: %w", err).Error())
+	}
+
+	return siw.Handler.AdminGetMoveUpReport(c, params)
+}
+
+// AdminCheckRatio operation middleware
+func (siw *ServerInterfaceWrapper) AdminCheckRatio(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminCheckRatio(c)
+}
+
+// AdminListRatioRules operation middleware
+func (siw *ServerInterfaceWrapper) AdminListRatioRules(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminListRatioRules(c)
+}
+
+// AdminSaveRatioRules operation middleware
+func (siw *ServerInterfaceWrapper) AdminSaveRatioRules(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminSaveRatioRules(c)
+}
+
+// AdminListRevocations operation middleware
+func (siw *ServerInterfaceWrapper) AdminListRevocations(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminListRevocations(c)
+}
+
+// AdminRevokeUser operation middleware
+func (siw *ServerInterfaceWrapper) AdminRevokeUser(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminRevokeUser(c)
+}
+
+// AdminRemoveRevocation operation middleware
+func (siw *ServerInterfaceWrapper) AdminRemoveRevocation(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "revocationID" -------------
+	var revocationID RevocationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revocationID", c.Params("revocationID"), &revocationID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter revocationID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminRemoveRevocation(c, revocationID)
+}
+
+// AdminListRoles operation middleware
+func (siw *ServerInterfaceWrapper) AdminListRoles(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminListRoles(c)
+}
+
+// AdminAssignRole operation middleware
+func (siw *ServerInterfaceWrapper) AdminAssignRole(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminAssignRole(c)
+}
+
+// AdminRemoveRole operation middleware
+func (siw *ServerInterfaceWrapper) AdminRemoveRole(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "roleID" -------------
+	var roleID RoleID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roleID", c.Params("roleID"), &roleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter roleID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminRemoveRole(c, roleID)
+}
+
+// AdminDeleteSession operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteSession(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminDeleteSession(c)
+}
+
+// AdminCreateSession operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateSession(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	return siw.Handler.AdminCreateSession(c)
+}
+
+// AdminListSessions operation middleware
+func (siw *ServerInterfaceWrapper) AdminListSessions(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListSessionsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", query, &params.Email)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter email: %w", err).Error())
+	}
+
+	return siw.Handler.AdminListSessions(c, params)
+}
+
+// AdminRevokeSession operation middleware
+func (siw *ServerInterfaceWrapper) AdminRevokeSession(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "sessionID" -------------
+	var sessionID SessionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionID", c.Params("sessionID"), &sessionID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sessionID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminRevokeSession(c, sessionID)
+}
+
+// AdminListUnits operation middleware
+func (siw *ServerInterfaceWrapper) AdminListUnits(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminListUnits(c)
+}
+
+// AdminUpdateUnit operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateUnit(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "unitID" -------------
+	var unitID UnitID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "unitID", c.Params("unitID"), &unitID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter unitID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminUpdateUnit(c, unitID)
+}
+
+// AdminListUnitMembers operation middleware
+func (siw *ServerInterfaceWrapper) AdminListUnitMembers(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "unitID" -------------
+	var unitID UnitID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "unitID", c.Params("unitID"), &unitID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter unitID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminListUnitMembers(c, unitID)
+}
+
+// AdminCreateMember operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateMember(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "unitID" -------------
+	var unitID UnitID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "unitID", c.Params("unitID"), &unitID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter unitID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminCreateMember(c, unitID)
+}
+
+// AdminGetWaitingList operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetWaitingList(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "unitID" -------------
+	var unitID UnitID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "unitID", c.Params("unitID"), &unitID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter unitID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminGetWaitingListParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", query, &params.Order)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter order: %w", err).Error())
+	}
+
+	return siw.Handler.AdminGetWaitingList(c, unitID, params)
+}
+
+// AdminOfferPlace operation middleware
+func (siw *ServerInterfaceWrapper) AdminOfferPlace(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "unitID" -------------
+	var unitID UnitID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "unitID", c.Params("unitID"), &unitID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter unitID: %w", err).Error())
+	}
+
+	// ------------- Path parameter "joinRequestID" -------------
+	var joinRequestID JoinRequestID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "joinRequestID", c.Params("joinRequestID"), &joinRequestID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter joinRequestID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(Admin_authScopes, []string{})
+
+	c.Context().SetUserValue(Admin_sessionScopes, []string{})
+
+	return siw.Handler.AdminOfferPlace(c, unitID, joinRequestID)
+}
+
+// GetCalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendar(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "unit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", query, &params.Unit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter unit: %w", err).Error())
+	}
+
+	return siw.Handler.GetCalendar(c, params)
+}
+
 // ContactUs operation middleware
 func (siw *ServerInterfaceWrapper) ContactUs(c *fiber.Ctx) error {
 
-	return siw.Handler.ContactUs(c)
-}
+	return siw.Handler.ContactUs(c)
+}
+
+// ListEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListEvents(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEventsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "unit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", query, &params.Unit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter unit: %w", err).Error())
+	}
+
+	return siw.Handler.ListEvents(c, params)
+}
+
+// SignUpForEvent operation middleware
+func (siw *ServerInterfaceWrapper) SignUpForEvent(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "eventID" -------------
+	var eventID EventID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventID", c.Params("eventID"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter eventID: %w", err).Error())
+	}
+
+	return siw.Handler.SignUpForEvent(c, eventID)
+}
+
+// CancelEventSignup operation middleware
+func (siw *ServerInterfaceWrapper) CancelEventSignup(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "eventID" -------------
+	var eventID EventID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventID", c.Params("eventID"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter eventID: %w", err).Error())
+	}
+
+	// ------------- Path parameter "signupID" -------------
+	var signupID SignupID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "signupID", c.Params("signupID"), &signupID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter signupID: %w", err).Error())
+	}
+
+	return siw.Handler.CancelEventSignup(c, eventID, signupID)
+}
+
+// GetFamily operation middleware
+func (siw *ServerInterfaceWrapper) GetFamily(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Parent_sessionScopes, []string{})
+
+	return siw.Handler.GetFamily(c)
+}
+
+// DeleteParentSession operation middleware
+func (siw *ServerInterfaceWrapper) DeleteParentSession(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(Parent_sessionScopes, []string{})
+
+	return siw.Handler.DeleteParentSession(c)
+}
+
+// CreateJoinRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateJoinRequest(c *fiber.Ctx) error {
+
+	return siw.Handler.CreateJoinRequest(c)
+}
+
+// CreateParentLogin operation middleware
+func (siw *ServerInterfaceWrapper) CreateParentLogin(c *fiber.Ctx) error {
+
+	return siw.Handler.CreateParentLogin(c)
+}
+
+// CreateParentSession operation middleware
+func (siw *ServerInterfaceWrapper) CreateParentSession(c *fiber.Ctx) error {
+
+	return siw.Handler.CreateParentSession(c)
+}
+
+// RespondToPlaceOffer operation middleware
+func (siw *ServerInterfaceWrapper) RespondToPlaceOffer(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "offerID" -------------
+	var offerID OfferID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "offerID", c.Params("offerID"), &offerID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offerID: %w", err).Error())
+	}
+
+	return siw.Handler.RespondToPlaceOffer(c, offerID)
+}
+
+// ListUnits operation middleware
+func (siw *ServerInterfaceWrapper) ListUnits(c *fiber.Ctx) error {
+
+	return siw.Handler.ListUnits(c)
+}
+
+// FiberServerOptions provides options for the Fiber server.
+type FiberServerOptions struct {
+	BaseURL     string
+	Middlewares []MiddlewareFunc
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router fiber.Router, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	for _, m := range options.Middlewares {
+		router.Use(fiber.Handler(m))
+	}
+
+	router.Get(options.BaseURL+"/api/v1/admin/api-keys", wrapper.AdminListAPIKeys)
+
+	router.Post(options.BaseURL+"/api/v1/admin/api-keys", wrapper.AdminCreateAPIKey)
+
+	router.Delete(options.BaseURL+"/api/v1/admin/api-keys/:apiKeyID", wrapper.AdminDeleteAPIKey)
+
+	router.Get(options.BaseURL+"/api/v1/admin/audit-log", wrapper.AdminListAuditLog)
+
+	router.Get(options.BaseURL+"/api/v1/admin/audit-log.csv", wrapper.AdminExportAuditLog)
+
+	router.Get(options.BaseURL+"/api/v1/admin/events", wrapper.AdminListEvents)
+
+	router.Post(options.BaseURL+"/api/v1/admin/events", wrapper.AdminCreateEvent)
+
+	router.Delete(options.BaseURL+"/api/v1/admin/events/:eventID", wrapper.AdminDeleteEvent)
+
+	router.Get(options.BaseURL+"/api/v1/admin/events/:eventID", wrapper.AdminGetEvent)
+
+	router.Put(options.BaseURL+"/api/v1/admin/events/:eventID", wrapper.AdminUpdateEvent)
+
+	router.Get(options.BaseURL+"/api/v1/admin/events/:eventID/attendees.csv", wrapper.AdminExportEventAttendees)
+
+	router.Get(options.BaseURL+"/api/v1/admin/events/:eventID/signups", wrapper.AdminListEventSignups)
+
+	router.Delete(options.BaseURL+"/api/v1/admin/events/:eventID/signups/:signupID", wrapper.AdminCancelEventSignup)
+
+	router.Get(options.BaseURL+"/api/v1/admin/invitations", wrapper.AdminListInvitations)
+
+	router.Post(options.BaseURL+"/api/v1/admin/invitations", wrapper.AdminCreateInvitation)
+
+	router.Delete(options.BaseURL+"/api/v1/admin/invitations/:invitationID", wrapper.AdminRemoveInvitation)
+
+	router.Get(options.BaseURL+"/api/v1/admin/join-requests/:joinRequestID/history", wrapper.AdminGetJoinRequestHistory)
+
+	router.Get(options.BaseURL+"/api/v1/admin/me", wrapper.AdminGetMe)
+
+	router.Get(options.BaseURL+"/api/v1/admin/members/:memberID", wrapper.AdminGetMember)
+
+	router.Put(options.BaseURL+"/api/v1/admin/members/:memberID", wrapper.AdminUpdateMember)
+
+	router.Get(options.BaseURL+"/api/v1/admin/members/:memberID/access-log", wrapper.AdminGetMemberAccessLog)
+
+	router.Get(options.BaseURL+"/api/v1/admin/members/:memberID/sensitive", wrapper.AdminGetMemberSensitive)
+
+	router.Put(options.BaseURL+"/api/v1/admin/members/:memberID/sensitive", wrapper.AdminSetMemberSensitive)
+
+	router.Post(options.BaseURL+"/api/v1/admin/members/:memberID/transfer", wrapper.AdminTransferMember)
+
+	router.Get(options.BaseURL+"/api/v1/admin/move-ups", wrapper.AdminGetMoveUpReport)
+
+	router.Post(options.BaseURL+"/api/v1/admin/ratio-check", wrapper.AdminCheckRatio)
+
+	router.Get(options.BaseURL+"/api/v1/admin/ratio-rules", wrapper.AdminListRatioRules)
+
+	router.Put(options.BaseURL+"/api/v1/admin/ratio-rules", wrapper.AdminSaveRatioRules)
+
+	router.Get(options.BaseURL+"/api/v1/admin/revocations", wrapper.AdminListRevocations)
+
+	router.Post(options.BaseURL+"/api/v1/admin/revocations", wrapper.AdminRevokeUser)
+
+	router.Delete(options.BaseURL+"/api/v1/admin/revocations/:revocationID", wrapper.AdminRemoveRevocation)
+
+	router.Get(options.BaseURL+"/api/v1/admin/roles", wrapper.AdminListRoles)
+
+	router.Post(options.BaseURL+"/api/v1/admin/roles", wrapper.AdminAssignRole)
+
+	router.Delete(options.BaseURL+"/api/v1/admin/roles/:roleID", wrapper.AdminRemoveRole)
+
+	router.Delete(options.BaseURL+"/api/v1/admin/session", wrapper.AdminDeleteSession)
+
+	router.Post(options.BaseURL+"/api/v1/admin/session", wrapper.AdminCreateSession)
+
+	router.Get(options.BaseURL+"/api/v1/admin/sessions", wrapper.AdminListSessions)
+
+	router.Delete(options.BaseURL+"/api/v1/admin/sessions/:sessionID", wrapper.AdminRevokeSession)
+
+	router.Get(options.BaseURL+"/api/v1/admin/units", wrapper.AdminListUnits)
+
+	router.Put(options.BaseURL+"/api/v1/admin/units/:unitID", wrapper.AdminUpdateUnit)
+
+	router.Get(options.BaseURL+"/api/v1/admin/units/:unitID/members", wrapper.AdminListUnitMembers)
+
+	router.Post(options.BaseURL+"/api/v1/admin/units/:unitID/members", wrapper.AdminCreateMember)
+
+	router.Get(options.BaseURL+"/api/v1/admin/units/:unitID/waiting-list", wrapper.AdminGetWaitingList)
+
+	router.Post(options.BaseURL+"/api/v1/admin/units/:unitID/waiting-list/:joinRequestID/offer", wrapper.AdminOfferPlace)
+
+	router.Get(options.BaseURL+"/api/v1/calendar.ics", wrapper.GetCalendar)
+
+	router.Post(options.BaseURL+"/api/v1/contact-us", wrapper.ContactUs)
+
+	router.Get(options.BaseURL+"/api/v1/events", wrapper.ListEvents)
+
+	router.Post(options.BaseURL+"/api/v1/events/:eventID/signups", wrapper.SignUpForEvent)
+
+	router.Post(options.BaseURL+"/api/v1/events/:eventID/signups/:signupID/cancel", wrapper.CancelEventSignup)
+
+	router.Get(options.BaseURL+"/api/v1/family", wrapper.GetFamily)
+
+	router.Delete(options.BaseURL+"/api/v1/family/session", wrapper.DeleteParentSession)
+
+	router.Post(options.BaseURL+"/api/v1/join-requests", wrapper.CreateJoinRequest)
+
+	router.Post(options.BaseURL+"/api/v1/parent-login", wrapper.CreateParentLogin)
+
+	router.Post(options.BaseURL+"/api/v1/parent-login/session", wrapper.CreateParentSession)
+
+	router.Post(options.BaseURL+"/api/v1/place-offers/:offerID/response", wrapper.RespondToPlaceOffer)
+
+	router.Get(options.BaseURL+"/api/v1/units", wrapper.ListUnits)
+
+}
+
+type AdminListAPIKeysRequestObject struct {
+}
+
+type AdminListAPIKeysResponseObject interface {
+	VisitAdminListAPIKeysResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListAPIKeys200JSONResponse APIKeys
+
+func (response AdminListAPIKeys200JSONResponse) VisitAdminListAPIKeysResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListAPIKeys403JSONResponse ErrorResponse
+
+func (response AdminListAPIKeys403JSONResponse) VisitAdminListAPIKeysResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListAPIKeys500JSONResponse ErrorResponse
+
+func (response AdminListAPIKeys500JSONResponse) VisitAdminListAPIKeysResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateAPIKeyRequestObject struct {
+	Body *AdminCreateAPIKeyJSONRequestBody
+}
+
+type AdminCreateAPIKeyResponseObject interface {
+	VisitAdminCreateAPIKeyResponse(ctx *fiber.Ctx) error
+}
+
+type AdminCreateAPIKey201JSONResponse CreatedAPIKey
+
+func (response AdminCreateAPIKey201JSONResponse) VisitAdminCreateAPIKeyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateAPIKey400JSONResponse ErrorResponse
+
+func (response AdminCreateAPIKey400JSONResponse) VisitAdminCreateAPIKeyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateAPIKey403JSONResponse ErrorResponse
+
+func (response AdminCreateAPIKey403JSONResponse) VisitAdminCreateAPIKeyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateAPIKey409JSONResponse ErrorResponse
+
+func (response AdminCreateAPIKey409JSONResponse) VisitAdminCreateAPIKeyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateAPIKey500JSONResponse ErrorResponse
+
+func (response AdminCreateAPIKey500JSONResponse) VisitAdminCreateAPIKeyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminDeleteAPIKeyRequestObject struct {
+	ApiKeyID APIKeyID `json:"apiKeyID"`
+}
+
+type AdminDeleteAPIKeyResponseObject interface {
+	VisitAdminDeleteAPIKeyResponse(ctx *fiber.Ctx) error
+}
+
+type AdminDeleteAPIKey204Response struct {
+}
+
+func (response AdminDeleteAPIKey204Response) VisitAdminDeleteAPIKeyResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type AdminDeleteAPIKey403JSONResponse ErrorResponse
+
+func (response AdminDeleteAPIKey403JSONResponse) VisitAdminDeleteAPIKeyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminDeleteAPIKey404JSONResponse ErrorResponse
+
+func (response AdminDeleteAPIKey404JSONResponse) VisitAdminDeleteAPIKeyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminDeleteAPIKey500JSONResponse ErrorResponse
+
+func (response AdminDeleteAPIKey500JSONResponse) VisitAdminDeleteAPIKeyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListAuditLogRequestObject struct {
+	Params AdminListAuditLogParams
+}
+
+type AdminListAuditLogResponseObject interface {
+	VisitAdminListAuditLogResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListAuditLog200JSONResponse AuditLog
+
+func (response AdminListAuditLog200JSONResponse) VisitAdminListAuditLogResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListAuditLog403JSONResponse ErrorResponse
+
+func (response AdminListAuditLog403JSONResponse) VisitAdminListAuditLogResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListAuditLog500JSONResponse ErrorResponse
+
+func (response AdminListAuditLog500JSONResponse) VisitAdminListAuditLogResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminExportAuditLogRequestObject struct {
+	Params AdminExportAuditLogParams
+}
+
+type AdminExportAuditLogResponseObject interface {
+	VisitAdminExportAuditLogResponse(ctx *fiber.Ctx) error
+}
+
+type AdminExportAuditLog200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response AdminExportAuditLog200TextcsvResponse) VisitAdminExportAuditLogResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type AdminExportAuditLog403JSONResponse ErrorResponse
+
+func (response AdminExportAuditLog403JSONResponse) VisitAdminExportAuditLogResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminExportAuditLog500JSONResponse ErrorResponse
+
+func (response AdminExportAuditLog500JSONResponse) VisitAdminExportAuditLogResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListEventsRequestObject struct {
+	Params AdminListEventsParams
+}
+
+type AdminListEventsResponseObject interface {
+	VisitAdminListEventsResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListEvents200JSONResponse AdminListEventsResponse
+
+func (response AdminListEvents200JSONResponse) VisitAdminListEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListEvents403JSONResponse ErrorResponse
+
+func (response AdminListEvents403JSONResponse) VisitAdminListEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListEvents422JSONResponse ErrorResponse
+
+func (response AdminListEvents422JSONResponse) VisitAdminListEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListEvents500JSONResponse ErrorResponse
+
+func (response AdminListEvents500JSONResponse) VisitAdminListEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateEventRequestObject struct {
+	Body *AdminCreateEventJSONRequestBody
+}
+
+type AdminCreateEventResponseObject interface {
+	VisitAdminCreateEventResponse(ctx *fiber.Ctx) error
+}
+
+type AdminCreateEvent201JSONResponse AdminEvent
+
+func (response AdminCreateEvent201JSONResponse) VisitAdminCreateEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateEvent403JSONResponse ErrorResponse
+
+func (response AdminCreateEvent403JSONResponse) VisitAdminCreateEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateEvent422JSONResponse ErrorResponse
+
+func (response AdminCreateEvent422JSONResponse) VisitAdminCreateEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateEvent500JSONResponse ErrorResponse
+
+func (response AdminCreateEvent500JSONResponse) VisitAdminCreateEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminDeleteEventRequestObject struct {
+	EventID EventID `json:"eventID"`
+}
+
+type AdminDeleteEventResponseObject interface {
+	VisitAdminDeleteEventResponse(ctx *fiber.Ctx) error
+}
+
+type AdminDeleteEvent204Response struct {
+}
+
+func (response AdminDeleteEvent204Response) VisitAdminDeleteEventResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type AdminDeleteEvent403JSONResponse ErrorResponse
+
+func (response AdminDeleteEvent403JSONResponse) VisitAdminDeleteEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminDeleteEvent404JSONResponse ErrorResponse
+
+func (response AdminDeleteEvent404JSONResponse) VisitAdminDeleteEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminDeleteEvent500JSONResponse ErrorResponse
+
+func (response AdminDeleteEvent500JSONResponse) VisitAdminDeleteEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetEventRequestObject struct {
+	EventID EventID `json:"eventID"`
+}
+
+type AdminGetEventResponseObject interface {
+	VisitAdminGetEventResponse(ctx *fiber.Ctx) error
+}
+
+type AdminGetEvent200JSONResponse AdminEvent
+
+func (response AdminGetEvent200JSONResponse) VisitAdminGetEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetEvent403JSONResponse ErrorResponse
+
+func (response AdminGetEvent403JSONResponse) VisitAdminGetEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetEvent404JSONResponse ErrorResponse
+
+func (response AdminGetEvent404JSONResponse) VisitAdminGetEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetEvent500JSONResponse ErrorResponse
+
+func (response AdminGetEvent500JSONResponse) VisitAdminGetEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateEventRequestObject struct {
+	EventID EventID `json:"eventID"`
+	Body    *AdminUpdateEventJSONRequestBody
+}
+
+type AdminUpdateEventResponseObject interface {
+	VisitAdminUpdateEventResponse(ctx *fiber.Ctx) error
+}
+
+type AdminUpdateEvent200JSONResponse AdminEvent
+
+func (response AdminUpdateEvent200JSONResponse) VisitAdminUpdateEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateEvent403JSONResponse ErrorResponse
+
+func (response AdminUpdateEvent403JSONResponse) VisitAdminUpdateEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateEvent404JSONResponse ErrorResponse
+
+func (response AdminUpdateEvent404JSONResponse) VisitAdminUpdateEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateEvent422JSONResponse ErrorResponse
+
+func (response AdminUpdateEvent422JSONResponse) VisitAdminUpdateEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateEvent500JSONResponse ErrorResponse
+
+func (response AdminUpdateEvent500JSONResponse) VisitAdminUpdateEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminExportEventAttendeesRequestObject struct {
+	EventID EventID `json:"eventID"`
+}
+
+type AdminExportEventAttendeesResponseObject interface {
+	VisitAdminExportEventAttendeesResponse(ctx *fiber.Ctx) error
+}
+
+type AdminExportEventAttendees200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response AdminExportEventAttendees200TextcsvResponse) VisitAdminExportEventAttendeesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type AdminExportEventAttendees403JSONResponse ErrorResponse
+
+func (response AdminExportEventAttendees403JSONResponse) VisitAdminExportEventAttendeesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminExportEventAttendees404JSONResponse ErrorResponse
+
+func (response AdminExportEventAttendees404JSONResponse) VisitAdminExportEventAttendeesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminExportEventAttendees500JSONResponse ErrorResponse
+
+func (response AdminExportEventAttendees500JSONResponse) VisitAdminExportEventAttendeesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListEventSignupsRequestObject struct {
+	EventID EventID `json:"eventID"`
+}
+
+type AdminListEventSignupsResponseObject interface {
+	VisitAdminListEventSignupsResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListEventSignups200JSONResponse AdminListEventSignupsResponse
+
+func (response AdminListEventSignups200JSONResponse) VisitAdminListEventSignupsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListEventSignups403JSONResponse ErrorResponse
+
+func (response AdminListEventSignups403JSONResponse) VisitAdminListEventSignupsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListEventSignups404JSONResponse ErrorResponse
+
+func (response AdminListEventSignups404JSONResponse) VisitAdminListEventSignupsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListEventSignups500JSONResponse ErrorResponse
+
+func (response AdminListEventSignups500JSONResponse) VisitAdminListEventSignupsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCancelEventSignupRequestObject struct {
+	EventID  EventID  `json:"eventID"`
+	SignupID SignupID `json:"signupID"`
+}
+
+type AdminCancelEventSignupResponseObject interface {
+	VisitAdminCancelEventSignupResponse(ctx *fiber.Ctx) error
+}
+
+type AdminCancelEventSignup204Response struct {
+}
+
+func (response AdminCancelEventSignup204Response) VisitAdminCancelEventSignupResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type AdminCancelEventSignup403JSONResponse ErrorResponse
+
+func (response AdminCancelEventSignup403JSONResponse) VisitAdminCancelEventSignupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCancelEventSignup404JSONResponse ErrorResponse
+
+func (response AdminCancelEventSignup404JSONResponse) VisitAdminCancelEventSignupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCancelEventSignup500JSONResponse ErrorResponse
+
+func (response AdminCancelEventSignup500JSONResponse) VisitAdminCancelEventSignupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListInvitationsRequestObject struct {
+}
+
+type AdminListInvitationsResponseObject interface {
+	VisitAdminListInvitationsResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListInvitations200JSONResponse Invitations
+
+func (response AdminListInvitations200JSONResponse) VisitAdminListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListInvitations403JSONResponse ErrorResponse
+
+func (response AdminListInvitations403JSONResponse) VisitAdminListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListInvitations500JSONResponse ErrorResponse
+
+func (response AdminListInvitations500JSONResponse) VisitAdminListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateInvitationRequestObject struct {
+	Body *AdminCreateInvitationJSONRequestBody
+}
+
+type AdminCreateInvitationResponseObject interface {
+	VisitAdminCreateInvitationResponse(ctx *fiber.Ctx) error
+}
+
+type AdminCreateInvitation201JSONResponse Invitation
+
+func (response AdminCreateInvitation201JSONResponse) VisitAdminCreateInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateInvitation403JSONResponse ErrorResponse
+
+func (response AdminCreateInvitation403JSONResponse) VisitAdminCreateInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateInvitation409JSONResponse ErrorResponse
+
+func (response AdminCreateInvitation409JSONResponse) VisitAdminCreateInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateInvitation500JSONResponse ErrorResponse
+
+func (response AdminCreateInvitation500JSONResponse) VisitAdminCreateInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRemoveInvitationRequestObject struct {
+	InvitationID InvitationID `json:"invitationID"`
+}
+
+type AdminRemoveInvitationResponseObject interface {
+	VisitAdminRemoveInvitationResponse(ctx *fiber.Ctx) error
+}
+
+type AdminRemoveInvitation204Response struct {
+}
+
+func (response AdminRemoveInvitation204Response) VisitAdminRemoveInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type AdminRemoveInvitation403JSONResponse ErrorResponse
+
+func (response AdminRemoveInvitation403JSONResponse) VisitAdminRemoveInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRemoveInvitation404JSONResponse ErrorResponse
+
+func (response AdminRemoveInvitation404JSONResponse) VisitAdminRemoveInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRemoveInvitation500JSONResponse ErrorResponse
+
+func (response AdminRemoveInvitation500JSONResponse) VisitAdminRemoveInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetJoinRequestHistoryRequestObject struct {
+	JoinRequestID JoinRequestID `json:"joinRequestID"`
+}
+
+type AdminGetJoinRequestHistoryResponseObject interface {
+	VisitAdminGetJoinRequestHistoryResponse(ctx *fiber.Ctx) error
+}
+
+type AdminGetJoinRequestHistory200JSONResponse JoinRequestHistory
+
+func (response AdminGetJoinRequestHistory200JSONResponse) VisitAdminGetJoinRequestHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetJoinRequestHistory403JSONResponse ErrorResponse
+
+func (response AdminGetJoinRequestHistory403JSONResponse) VisitAdminGetJoinRequestHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetJoinRequestHistory404JSONResponse ErrorResponse
+
+func (response AdminGetJoinRequestHistory404JSONResponse) VisitAdminGetJoinRequestHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetJoinRequestHistory500JSONResponse ErrorResponse
+
+func (response AdminGetJoinRequestHistory500JSONResponse) VisitAdminGetJoinRequestHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMeRequestObject struct {
+}
+
+type AdminGetMeResponseObject interface {
+	VisitAdminGetMeResponse(ctx *fiber.Ctx) error
+}
+
+type AdminGetMe200JSONResponse AdminUser
+
+func (response AdminGetMe200JSONResponse) VisitAdminGetMeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMe403JSONResponse ErrorResponse
+
+func (response AdminGetMe403JSONResponse) VisitAdminGetMeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMe500JSONResponse ErrorResponse
+
+func (response AdminGetMe500JSONResponse) VisitAdminGetMeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMemberRequestObject struct {
+	MemberID MemberID `json:"memberID"`
+}
+
+type AdminGetMemberResponseObject interface {
+	VisitAdminGetMemberResponse(ctx *fiber.Ctx) error
+}
+
+type AdminGetMember200JSONResponse Member
+
+func (response AdminGetMember200JSONResponse) VisitAdminGetMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMember403JSONResponse ErrorResponse
+
+func (response AdminGetMember403JSONResponse) VisitAdminGetMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMember404JSONResponse ErrorResponse
+
+func (response AdminGetMember404JSONResponse) VisitAdminGetMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMember500JSONResponse ErrorResponse
+
+func (response AdminGetMember500JSONResponse) VisitAdminGetMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateMemberRequestObject struct {
+	MemberID MemberID `json:"memberID"`
+	Body     *AdminUpdateMemberJSONRequestBody
+}
+
+type AdminUpdateMemberResponseObject interface {
+	VisitAdminUpdateMemberResponse(ctx *fiber.Ctx) error
+}
+
+type AdminUpdateMember200JSONResponse Member
+
+func (response AdminUpdateMember200JSONResponse) VisitAdminUpdateMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateMember403JSONResponse ErrorResponse
+
+func (response AdminUpdateMember403JSONResponse) VisitAdminUpdateMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateMember404JSONResponse ErrorResponse
+
+func (response AdminUpdateMember404JSONResponse) VisitAdminUpdateMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateMember422JSONResponse ErrorResponse
+
+func (response AdminUpdateMember422JSONResponse) VisitAdminUpdateMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateMember500JSONResponse ErrorResponse
+
+func (response AdminUpdateMember500JSONResponse) VisitAdminUpdateMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMemberAccessLogRequestObject struct {
+	MemberID MemberID `json:"memberID"`
+}
+
+type AdminGetMemberAccessLogResponseObject interface {
+	VisitAdminGetMemberAccessLogResponse(ctx *fiber.Ctx) error
+}
+
+type AdminGetMemberAccessLog200JSONResponse MemberAccessLog
+
+func (response AdminGetMemberAccessLog200JSONResponse) VisitAdminGetMemberAccessLogResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMemberAccessLog403JSONResponse ErrorResponse
+
+func (response AdminGetMemberAccessLog403JSONResponse) VisitAdminGetMemberAccessLogResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMemberAccessLog404JSONResponse ErrorResponse
+
+func (response AdminGetMemberAccessLog404JSONResponse) VisitAdminGetMemberAccessLogResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMemberAccessLog500JSONResponse ErrorResponse
+
+func (response AdminGetMemberAccessLog500JSONResponse) VisitAdminGetMemberAccessLogResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMemberSensitiveRequestObject struct {
+	MemberID MemberID `json:"memberID"`
+}
+
+type AdminGetMemberSensitiveResponseObject interface {
+	VisitAdminGetMemberSensitiveResponse(ctx *fiber.Ctx) error
+}
+
+type AdminGetMemberSensitive200JSONResponse MemberSensitive
+
+func (response AdminGetMemberSensitive200JSONResponse) VisitAdminGetMemberSensitiveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMemberSensitive403JSONResponse ErrorResponse
+
+func (response AdminGetMemberSensitive403JSONResponse) VisitAdminGetMemberSensitiveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMemberSensitive404JSONResponse ErrorResponse
+
+func (response AdminGetMemberSensitive404JSONResponse) VisitAdminGetMemberSensitiveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMemberSensitive500JSONResponse ErrorResponse
+
+func (response AdminGetMemberSensitive500JSONResponse) VisitAdminGetMemberSensitiveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminSetMemberSensitiveRequestObject struct {
+	MemberID MemberID `json:"memberID"`
+	Body     *AdminSetMemberSensitiveJSONRequestBody
+}
+
+type AdminSetMemberSensitiveResponseObject interface {
+	VisitAdminSetMemberSensitiveResponse(ctx *fiber.Ctx) error
+}
+
+type AdminSetMemberSensitive204Response struct {
+}
+
+func (response AdminSetMemberSensitive204Response) VisitAdminSetMemberSensitiveResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type AdminSetMemberSensitive403JSONResponse ErrorResponse
+
+func (response AdminSetMemberSensitive403JSONResponse) VisitAdminSetMemberSensitiveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminSetMemberSensitive404JSONResponse ErrorResponse
+
+func (response AdminSetMemberSensitive404JSONResponse) VisitAdminSetMemberSensitiveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminSetMemberSensitive422JSONResponse ErrorResponse
+
+func (response AdminSetMemberSensitive422JSONResponse) VisitAdminSetMemberSensitiveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminSetMemberSensitive500JSONResponse ErrorResponse
+
+func (response AdminSetMemberSensitive500JSONResponse) VisitAdminSetMemberSensitiveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminTransferMemberRequestObject struct {
+	MemberID MemberID `json:"memberID"`
+	Body     *AdminTransferMemberJSONRequestBody
+}
+
+type AdminTransferMemberResponseObject interface {
+	VisitAdminTransferMemberResponse(ctx *fiber.Ctx) error
+}
+
+type AdminTransferMember200JSONResponse Member
+
+func (response AdminTransferMember200JSONResponse) VisitAdminTransferMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminTransferMember403JSONResponse ErrorResponse
+
+func (response AdminTransferMember403JSONResponse) VisitAdminTransferMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminTransferMember404JSONResponse ErrorResponse
+
+func (response AdminTransferMember404JSONResponse) VisitAdminTransferMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminTransferMember409JSONResponse ErrorResponse
+
+func (response AdminTransferMember409JSONResponse) VisitAdminTransferMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type AdminTransferMember422JSONResponse ErrorResponse
+
+func (response AdminTransferMember422JSONResponse) VisitAdminTransferMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminTransferMember500JSONResponse ErrorResponse
+
+func (response AdminTransferMember500JSONResponse) VisitAdminTransferMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMoveUpReportRequestObject struct {
+	Params AdminGetMoveUpReportParams
+}
+
+type AdminGetMoveUpReportResponseObject interface {
+	VisitAdminGetMoveUpReportResponse(ctx *fiber.Ctx) error
+}
+
+type AdminGetMoveUpReport200JSONResponse MoveUpReport
+
+func (response AdminGetMoveUpReport200JSONResponse) VisitAdminGetMoveUpReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMoveUpReport403JSONResponse ErrorResponse
+
+func (response AdminGetMoveUpReport403JSONResponse) VisitAdminGetMoveUpReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMoveUpReport422JSONResponse ErrorResponse
+
+func (response AdminGetMoveUpReport422JSONResponse) VisitAdminGetMoveUpReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetMoveUpReport500JSONResponse ErrorResponse
+
+func (response AdminGetMoveUpReport500JSONResponse) VisitAdminGetMoveUpReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCheckRatioRequestObject struct {
+	Body *AdminCheckRatioJSONRequestBody
+}
+
+type AdminCheckRatioResponseObject interface {
+	VisitAdminCheckRatioResponse(ctx *fiber.Ctx) error
+}
+
+type AdminCheckRatio200JSONResponse RatioCheck
+
+func (response AdminCheckRatio200JSONResponse) VisitAdminCheckRatioResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCheckRatio403JSONResponse ErrorResponse
+
+func (response AdminCheckRatio403JSONResponse) VisitAdminCheckRatioResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCheckRatio422JSONResponse ErrorResponse
+
+func (response AdminCheckRatio422JSONResponse) VisitAdminCheckRatioResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCheckRatio500JSONResponse ErrorResponse
+
+func (response AdminCheckRatio500JSONResponse) VisitAdminCheckRatioResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListRatioRulesRequestObject struct {
+}
+
+type AdminListRatioRulesResponseObject interface {
+	VisitAdminListRatioRulesResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListRatioRules200JSONResponse RatioRules
+
+func (response AdminListRatioRules200JSONResponse) VisitAdminListRatioRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListRatioRules403JSONResponse ErrorResponse
+
+func (response AdminListRatioRules403JSONResponse) VisitAdminListRatioRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListRatioRules500JSONResponse ErrorResponse
+
+func (response AdminListRatioRules500JSONResponse) VisitAdminListRatioRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminSaveRatioRulesRequestObject struct {
+	Body *AdminSaveRatioRulesJSONRequestBody
+}
+
+type AdminSaveRatioRulesResponseObject interface {
+	VisitAdminSaveRatioRulesResponse(ctx *fiber.Ctx) error
+}
+
+type AdminSaveRatioRules200JSONResponse RatioRules
+
+func (response AdminSaveRatioRules200JSONResponse) VisitAdminSaveRatioRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminSaveRatioRules403JSONResponse ErrorResponse
+
+func (response AdminSaveRatioRules403JSONResponse) VisitAdminSaveRatioRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminSaveRatioRules422JSONResponse ErrorResponse
+
+func (response AdminSaveRatioRules422JSONResponse) VisitAdminSaveRatioRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminSaveRatioRules500JSONResponse ErrorResponse
+
+func (response AdminSaveRatioRules500JSONResponse) VisitAdminSaveRatioRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListRevocationsRequestObject struct {
+}
+
+type AdminListRevocationsResponseObject interface {
+	VisitAdminListRevocationsResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListRevocations200JSONResponse Revocations
+
+func (response AdminListRevocations200JSONResponse) VisitAdminListRevocationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListRevocations403JSONResponse ErrorResponse
+
+func (response AdminListRevocations403JSONResponse) VisitAdminListRevocationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListRevocations500JSONResponse ErrorResponse
+
+func (response AdminListRevocations500JSONResponse) VisitAdminListRevocationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRevokeUserRequestObject struct {
+	Body *AdminRevokeUserJSONRequestBody
+}
+
+type AdminRevokeUserResponseObject interface {
+	VisitAdminRevokeUserResponse(ctx *fiber.Ctx) error
+}
+
+type AdminRevokeUser201JSONResponse Revocation
+
+func (response AdminRevokeUser201JSONResponse) VisitAdminRevokeUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRevokeUser400JSONResponse ErrorResponse
+
+func (response AdminRevokeUser400JSONResponse) VisitAdminRevokeUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRevokeUser403JSONResponse ErrorResponse
+
+func (response AdminRevokeUser403JSONResponse) VisitAdminRevokeUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRevokeUser409JSONResponse ErrorResponse
+
+func (response AdminRevokeUser409JSONResponse) VisitAdminRevokeUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRevokeUser500JSONResponse ErrorResponse
+
+func (response AdminRevokeUser500JSONResponse) VisitAdminRevokeUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRemoveRevocationRequestObject struct {
+	RevocationID RevocationID `json:"revocationID"`
+}
+
+type AdminRemoveRevocationResponseObject interface {
+	VisitAdminRemoveRevocationResponse(ctx *fiber.Ctx) error
+}
+
+type AdminRemoveRevocation204Response struct {
+}
+
+func (response AdminRemoveRevocation204Response) VisitAdminRemoveRevocationResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type AdminRemoveRevocation403JSONResponse ErrorResponse
+
+func (response AdminRemoveRevocation403JSONResponse) VisitAdminRemoveRevocationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRemoveRevocation404JSONResponse ErrorResponse
+
+func (response AdminRemoveRevocation404JSONResponse) VisitAdminRemoveRevocationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRemoveRevocation500JSONResponse ErrorResponse
+
+func (response AdminRemoveRevocation500JSONResponse) VisitAdminRemoveRevocationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListRolesRequestObject struct {
+}
+
+type AdminListRolesResponseObject interface {
+	VisitAdminListRolesResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListRoles200JSONResponse RoleAssignments
+
+func (response AdminListRoles200JSONResponse) VisitAdminListRolesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListRoles403JSONResponse ErrorResponse
+
+func (response AdminListRoles403JSONResponse) VisitAdminListRolesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListRoles500JSONResponse ErrorResponse
+
+func (response AdminListRoles500JSONResponse) VisitAdminListRolesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminAssignRoleRequestObject struct {
+	Body *AdminAssignRoleJSONRequestBody
+}
+
+type AdminAssignRoleResponseObject interface {
+	VisitAdminAssignRoleResponse(ctx *fiber.Ctx) error
+}
+
+type AdminAssignRole201JSONResponse RoleAssignment
+
+func (response AdminAssignRole201JSONResponse) VisitAdminAssignRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AdminAssignRole403JSONResponse ErrorResponse
+
+func (response AdminAssignRole403JSONResponse) VisitAdminAssignRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminAssignRole409JSONResponse ErrorResponse
+
+func (response AdminAssignRole409JSONResponse) VisitAdminAssignRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type AdminAssignRole422JSONResponse ErrorResponse
+
+func (response AdminAssignRole422JSONResponse) VisitAdminAssignRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminAssignRole500JSONResponse ErrorResponse
+
+func (response AdminAssignRole500JSONResponse) VisitAdminAssignRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRemoveRoleRequestObject struct {
+	RoleID RoleID `json:"roleID"`
+}
+
+type AdminRemoveRoleResponseObject interface {
+	VisitAdminRemoveRoleResponse(ctx *fiber.Ctx) error
+}
+
+type AdminRemoveRole204Response struct {
+}
+
+func (response AdminRemoveRole204Response) VisitAdminRemoveRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type AdminRemoveRole403JSONResponse ErrorResponse
+
+func (response AdminRemoveRole403JSONResponse) VisitAdminRemoveRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRemoveRole404JSONResponse ErrorResponse
+
+func (response AdminRemoveRole404JSONResponse) VisitAdminRemoveRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRemoveRole500JSONResponse ErrorResponse
+
+func (response AdminRemoveRole500JSONResponse) VisitAdminRemoveRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminDeleteSessionRequestObject struct {
+}
+
+type AdminDeleteSessionResponseObject interface {
+	VisitAdminDeleteSessionResponse(ctx *fiber.Ctx) error
+}
+
+type AdminDeleteSession204ResponseHeaders struct {
+	SetCookie string
+}
+
+type AdminDeleteSession204Response struct {
+	Headers AdminDeleteSession204ResponseHeaders
+}
+
+func (response AdminDeleteSession204Response) VisitAdminDeleteSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	ctx.Status(204)
+	return nil
+}
+
+type AdminDeleteSession500JSONResponse ErrorResponse
+
+func (response AdminDeleteSession500JSONResponse) VisitAdminDeleteSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateSessionRequestObject struct {
+}
+
+type AdminCreateSessionResponseObject interface {
+	VisitAdminCreateSessionResponse(ctx *fiber.Ctx) error
+}
+
+type AdminCreateSession201ResponseHeaders struct {
+	SetCookie string
+}
+
+type AdminCreateSession201JSONResponse struct {
+	Body    AdminUser
+	Headers AdminCreateSession201ResponseHeaders
+}
+
+func (response AdminCreateSession201JSONResponse) VisitAdminCreateSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response.Body)
+}
+
+type AdminCreateSession400JSONResponse ErrorResponse
+
+func (response AdminCreateSession400JSONResponse) VisitAdminCreateSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateSession500JSONResponse ErrorResponse
+
+func (response AdminCreateSession500JSONResponse) VisitAdminCreateSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListSessionsRequestObject struct {
+	Params AdminListSessionsParams
+}
+
+type AdminListSessionsResponseObject interface {
+	VisitAdminListSessionsResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListSessions200JSONResponse AdminSessions
+
+func (response AdminListSessions200JSONResponse) VisitAdminListSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListSessions403JSONResponse ErrorResponse
+
+func (response AdminListSessions403JSONResponse) VisitAdminListSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListSessions500JSONResponse ErrorResponse
+
+func (response AdminListSessions500JSONResponse) VisitAdminListSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRevokeSessionRequestObject struct {
+	SessionID SessionID `json:"sessionID"`
+}
+
+type AdminRevokeSessionResponseObject interface {
+	VisitAdminRevokeSessionResponse(ctx *fiber.Ctx) error
+}
+
+type AdminRevokeSession204Response struct {
+}
+
+func (response AdminRevokeSession204Response) VisitAdminRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type AdminRevokeSession403JSONResponse ErrorResponse
+
+func (response AdminRevokeSession403JSONResponse) VisitAdminRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRevokeSession404JSONResponse ErrorResponse
+
+func (response AdminRevokeSession404JSONResponse) VisitAdminRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminRevokeSession500JSONResponse ErrorResponse
+
+func (response AdminRevokeSession500JSONResponse) VisitAdminRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListUnitsRequestObject struct {
+}
+
+type AdminListUnitsResponseObject interface {
+	VisitAdminListUnitsResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListUnits200JSONResponse AdminListUnitsResponse
+
+func (response AdminListUnits200JSONResponse) VisitAdminListUnitsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListUnits403JSONResponse ErrorResponse
+
+func (response AdminListUnits403JSONResponse) VisitAdminListUnitsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListUnits500JSONResponse ErrorResponse
+
+func (response AdminListUnits500JSONResponse) VisitAdminListUnitsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateUnitRequestObject struct {
+	UnitID UnitID `json:"unitID"`
+	Body   *AdminUpdateUnitJSONRequestBody
+}
+
+type AdminUpdateUnitResponseObject interface {
+	VisitAdminUpdateUnitResponse(ctx *fiber.Ctx) error
+}
+
+type AdminUpdateUnit200JSONResponse AdminUnit
+
+func (response AdminUpdateUnit200JSONResponse) VisitAdminUpdateUnitResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateUnit403JSONResponse ErrorResponse
+
+func (response AdminUpdateUnit403JSONResponse) VisitAdminUpdateUnitResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateUnit404JSONResponse ErrorResponse
+
+func (response AdminUpdateUnit404JSONResponse) VisitAdminUpdateUnitResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateUnit422JSONResponse ErrorResponse
+
+func (response AdminUpdateUnit422JSONResponse) VisitAdminUpdateUnitResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminUpdateUnit500JSONResponse ErrorResponse
+
+func (response AdminUpdateUnit500JSONResponse) VisitAdminUpdateUnitResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListUnitMembersRequestObject struct {
+	UnitID UnitID `json:"unitID"`
+}
+
+type AdminListUnitMembersResponseObject interface {
+	VisitAdminListUnitMembersResponse(ctx *fiber.Ctx) error
+}
+
+type AdminListUnitMembers200JSONResponse ListMembersResponse
+
+func (response AdminListUnitMembers200JSONResponse) VisitAdminListUnitMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListUnitMembers403JSONResponse ErrorResponse
+
+func (response AdminListUnitMembers403JSONResponse) VisitAdminListUnitMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListUnitMembers404JSONResponse ErrorResponse
+
+func (response AdminListUnitMembers404JSONResponse) VisitAdminListUnitMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminListUnitMembers500JSONResponse ErrorResponse
+
+func (response AdminListUnitMembers500JSONResponse) VisitAdminListUnitMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateMemberRequestObject struct {
+	UnitID UnitID `json:"unitID"`
+	Body   *AdminCreateMemberJSONRequestBody
+}
+
+type AdminCreateMemberResponseObject interface {
+	VisitAdminCreateMemberResponse(ctx *fiber.Ctx) error
+}
+
+type AdminCreateMember201JSONResponse Member
+
+func (response AdminCreateMember201JSONResponse) VisitAdminCreateMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateMember403JSONResponse ErrorResponse
+
+func (response AdminCreateMember403JSONResponse) VisitAdminCreateMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateMember404JSONResponse ErrorResponse
+
+func (response AdminCreateMember404JSONResponse) VisitAdminCreateMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateMember422JSONResponse ErrorResponse
+
+func (response AdminCreateMember422JSONResponse) VisitAdminCreateMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminCreateMember500JSONResponse ErrorResponse
+
+func (response AdminCreateMember500JSONResponse) VisitAdminCreateMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetWaitingListRequestObject struct {
+	UnitID UnitID `json:"unitID"`
+	Params AdminGetWaitingListParams
+}
+
+type AdminGetWaitingListResponseObject interface {
+	VisitAdminGetWaitingListResponse(ctx *fiber.Ctx) error
+}
+
+type AdminGetWaitingList200JSONResponse WaitingList
+
+func (response AdminGetWaitingList200JSONResponse) VisitAdminGetWaitingListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetWaitingList403JSONResponse ErrorResponse
+
+func (response AdminGetWaitingList403JSONResponse) VisitAdminGetWaitingListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetWaitingList404JSONResponse ErrorResponse
+
+func (response AdminGetWaitingList404JSONResponse) VisitAdminGetWaitingListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminGetWaitingList500JSONResponse ErrorResponse
+
+func (response AdminGetWaitingList500JSONResponse) VisitAdminGetWaitingListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdminOfferPlaceRequestObject struct {
+	UnitID        UnitID        `json:"unitID"`
+	JoinRequestID JoinRequestID `json:"joinRequestID"`
+}
+
+type AdminOfferPlaceResponseObject interface {
+	VisitAdminOfferPlaceResponse(ctx *fiber.Ctx) error
+}
+
+type AdminOfferPlace201JSONResponse PlaceOffer
+
+func (response AdminOfferPlace201JSONResponse) VisitAdminOfferPlaceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AdminOfferPlace403JSONResponse ErrorResponse
+
+func (response AdminOfferPlace403JSONResponse) VisitAdminOfferPlaceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type AdminOfferPlace404JSONResponse ErrorResponse
+
+func (response AdminOfferPlace404JSONResponse) VisitAdminOfferPlaceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdminOfferPlace409JSONResponse ErrorResponse
+
+func (response AdminOfferPlace409JSONResponse) VisitAdminOfferPlaceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type AdminOfferPlace422JSONResponse ErrorResponse
+
+func (response AdminOfferPlace422JSONResponse) VisitAdminOfferPlaceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type AdminOfferPlace500JSONResponse ErrorResponse
+
+func (response AdminOfferPlace500JSONResponse) VisitAdminOfferPlaceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetCalendarRequestObject struct {
+	Params GetCalendarParams
+}
+
+type GetCalendarResponseObject interface {
+	VisitGetCalendarResponse(ctx *fiber.Ctx) error
+}
+
+type GetCalendar200TextcalendarResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetCalendar200TextcalendarResponse) VisitGetCalendarResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/calendar")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetCalendar500JSONResponse ErrorResponse
+
+func (response GetCalendar500JSONResponse) VisitGetCalendarResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ContactUsRequestObject struct {
+	Body *ContactUsJSONRequestBody
+}
+
+type ContactUsResponseObject interface {
+	VisitContactUsResponse(ctx *fiber.Ctx) error
+}
+
+type ContactUs200Response struct {
+}
+
+func (response ContactUs200Response) VisitContactUsResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type ContactUs422JSONResponse ErrorResponse
+
+func (response ContactUs422JSONResponse) VisitContactUsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type ContactUs500JSONResponse ErrorResponse
+
+func (response ContactUs500JSONResponse) VisitContactUsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ListEventsRequestObject struct {
+	Params ListEventsParams
+}
+
+type ListEventsResponseObject interface {
+	VisitListEventsResponse(ctx *fiber.Ctx) error
+}
+
+type ListEvents200JSONResponse ListEventsResponse
+
+func (response ListEvents200JSONResponse) VisitListEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListEvents422JSONResponse ErrorResponse
+
+func (response ListEvents422JSONResponse) VisitListEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type ListEvents500JSONResponse ErrorResponse
+
+func (response ListEvents500JSONResponse) VisitListEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpForEventRequestObject struct {
+	EventID EventID `json:"eventID"`
+	Body    *SignUpForEventJSONRequestBody
+}
+
+type SignUpForEventResponseObject interface {
+	VisitSignUpForEventResponse(ctx *fiber.Ctx) error
+}
+
+type SignUpForEvent201JSONResponse EventSignupResult
+
+func (response SignUpForEvent201JSONResponse) VisitSignUpForEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpForEvent404JSONResponse ErrorResponse
+
+func (response SignUpForEvent404JSONResponse) VisitSignUpForEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpForEvent409JSONResponse ErrorResponse
+
+func (response SignUpForEvent409JSONResponse) VisitSignUpForEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpForEvent422JSONResponse ErrorResponse
+
+func (response SignUpForEvent422JSONResponse) VisitSignUpForEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpForEvent500JSONResponse ErrorResponse
+
+func (response SignUpForEvent500JSONResponse) VisitSignUpForEventResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CancelEventSignupRequestObject struct {
+	EventID  EventID  `json:"eventID"`
+	SignupID SignupID `json:"signupID"`
+	Body     *CancelEventSignupJSONRequestBody
+}
+
+type CancelEventSignupResponseObject interface {
+	VisitCancelEventSignupResponse(ctx *fiber.Ctx) error
+}
+
+type CancelEventSignup204Response struct {
+}
+
+func (response CancelEventSignup204Response) VisitCancelEventSignupResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type CancelEventSignup404JSONResponse ErrorResponse
+
+func (response CancelEventSignup404JSONResponse) VisitCancelEventSignupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type CancelEventSignup500JSONResponse ErrorResponse
+
+func (response CancelEventSignup500JSONResponse) VisitCancelEventSignupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetFamilyRequestObject struct {
+}
+
+type GetFamilyResponseObject interface {
+	VisitGetFamilyResponse(ctx *fiber.Ctx) error
+}
+
+type GetFamily200JSONResponse Family
+
+func (response GetFamily200JSONResponse) VisitGetFamilyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFamily500JSONResponse ErrorResponse
+
+func (response GetFamily500JSONResponse) VisitGetFamilyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteParentSessionRequestObject struct {
+}
+
+type DeleteParentSessionResponseObject interface {
+	VisitDeleteParentSessionResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteParentSession204ResponseHeaders struct {
+	SetCookie string
+}
+
+type DeleteParentSession204Response struct {
+	Headers DeleteParentSession204ResponseHeaders
+}
+
+func (response DeleteParentSession204Response) VisitDeleteParentSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteParentSession500JSONResponse ErrorResponse
+
+func (response DeleteParentSession500JSONResponse) VisitDeleteParentSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CreateJoinRequestRequestObject struct {
+	Body *CreateJoinRequestJSONRequestBody
+}
+
+type CreateJoinRequestResponseObject interface {
+	VisitCreateJoinRequestResponse(ctx *fiber.Ctx) error
+}
+
+type CreateJoinRequest201JSONResponse JoinRequestResult
+
+func (response CreateJoinRequest201JSONResponse) VisitCreateJoinRequestResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateJoinRequest422JSONResponse ErrorResponse
+
+func (response CreateJoinRequest422JSONResponse) VisitCreateJoinRequestResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type CreateJoinRequest429JSONResponse ErrorResponse
+
+func (response CreateJoinRequest429JSONResponse) VisitCreateJoinRequestResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type CreateJoinRequest500JSONResponse ErrorResponse
+
+func (response CreateJoinRequest500JSONResponse) VisitCreateJoinRequestResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CreateParentLoginRequestObject struct {
+	Body *CreateParentLoginJSONRequestBody
+}
+
+type CreateParentLoginResponseObject interface {
+	VisitCreateParentLoginResponse(ctx *fiber.Ctx) error
+}
+
+type CreateParentLogin202Response struct {
+}
+
+func (response CreateParentLogin202Response) VisitCreateParentLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Status(202)
+	return nil
+}
+
+type CreateParentLogin422JSONResponse ErrorResponse
+
+func (response CreateParentLogin422JSONResponse) VisitCreateParentLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type CreateParentLogin429JSONResponse ErrorResponse
+
+func (response CreateParentLogin429JSONResponse) VisitCreateParentLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type CreateParentLogin500JSONResponse ErrorResponse
+
+func (response CreateParentLogin500JSONResponse) VisitCreateParentLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CreateParentSessionRequestObject struct {
+	Body *CreateParentSessionJSONRequestBody
+}
+
+type CreateParentSessionResponseObject interface {
+	VisitCreateParentSessionResponse(ctx *fiber.Ctx) error
+}
+
+type CreateParentSession201ResponseHeaders struct {
+	SetCookie string
+}
+
+type CreateParentSession201JSONResponse struct {
+	Body    Parent
+	Headers CreateParentSession201ResponseHeaders
+}
+
+func (response CreateParentSession201JSONResponse) VisitCreateParentSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response.Body)
+}
+
+type CreateParentSession401JSONResponse ErrorResponse
+
+func (response CreateParentSession401JSONResponse) VisitCreateParentSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateParentSession500JSONResponse ErrorResponse
+
+func (response CreateParentSession500JSONResponse) VisitCreateParentSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type RespondToPlaceOfferRequestObject struct {
+	OfferID OfferID `json:"offerID"`
+	Body    *RespondToPlaceOfferJSONRequestBody
+}
+
+type RespondToPlaceOfferResponseObject interface {
+	VisitRespondToPlaceOfferResponse(ctx *fiber.Ctx) error
+}
+
+type RespondToPlaceOffer200JSONResponse PlaceOffer
+
+func (response RespondToPlaceOffer200JSONResponse) VisitRespondToPlaceOfferResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type RespondToPlaceOffer404JSONResponse ErrorResponse
+
+func (response RespondToPlaceOffer404JSONResponse) VisitRespondToPlaceOfferResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RespondToPlaceOffer409JSONResponse ErrorResponse
+
+func (response RespondToPlaceOffer409JSONResponse) VisitRespondToPlaceOfferResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type RespondToPlaceOffer500JSONResponse ErrorResponse
+
+func (response RespondToPlaceOffer500JSONResponse) VisitRespondToPlaceOfferResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ListUnitsRequestObject struct {
+}
+
+type ListUnitsResponseObject interface {
+	VisitListUnitsResponse(ctx *fiber.Ctx) error
+}
+
+type ListUnits200JSONResponse ListUnitsResponse
+
+func (response ListUnits200JSONResponse) VisitListUnitsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListUnits500JSONResponse ErrorResponse
+
+func (response ListUnits500JSONResponse) VisitListUnitsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List the signed in admin's API keys, or everyone's for admins who can change district settings
+	// (GET /api/v1/admin/api-keys)
+	AdminListAPIKeys(ctx context.Context, request AdminListAPIKeysRequestObject) (AdminListAPIKeysResponseObject, error)
+	// Create an API key for scripts to use as the signed in admin
+	// (POST /api/v1/admin/api-keys)
+	AdminCreateAPIKey(ctx context.Context, request AdminCreateAPIKeyRequestObject) (AdminCreateAPIKeyResponseObject, error)
+	// Delete an API key, so it can no longer be used
+	// (DELETE /api/v1/admin/api-keys/{apiKeyID})
+	AdminDeleteAPIKey(ctx context.Context, request AdminDeleteAPIKeyRequestObject) (AdminDeleteAPIKeyResponseObject, error)
+	// Search the audit log of admin actions, most recent first
+	// (GET /api/v1/admin/audit-log)
+	AdminListAuditLog(ctx context.Context, request AdminListAuditLogRequestObject) (AdminListAuditLogResponseObject, error)
+	// Export the audit log entries matching the filters as CSV, most recent first
+	// (GET /api/v1/admin/audit-log.csv)
+	AdminExportAuditLog(ctx context.Context, request AdminExportAuditLogRequestObject) (AdminExportAuditLogResponseObject, error)
+	// List all events, regardless of status
+	// (GET /api/v1/admin/events)
+	AdminListEvents(ctx context.Context, request AdminListEventsRequestObject) (AdminListEventsResponseObject, error)
+	// Create an event
+	// (POST /api/v1/admin/events)
+	AdminCreateEvent(ctx context.Context, request AdminCreateEventRequestObject) (AdminCreateEventResponseObject, error)
+	// Delete an event
+	// (DELETE /api/v1/admin/events/{eventID})
+	AdminDeleteEvent(ctx context.Context, request AdminDeleteEventRequestObject) (AdminDeleteEventResponseObject, error)
+	// Get an event
+	// (GET /api/v1/admin/events/{eventID})
+	AdminGetEvent(ctx context.Context, request AdminGetEventRequestObject) (AdminGetEventResponseObject, error)
+	// Update an event
+	// (PUT /api/v1/admin/events/{eventID})
+	AdminUpdateEvent(ctx context.Context, request AdminUpdateEventRequestObject) (AdminUpdateEventResponseObject, error)
+	// Export the confirmed attendees of an event as CSV
+	// (GET /api/v1/admin/events/{eventID}/attendees.csv)
+	AdminExportEventAttendees(ctx context.Context, request AdminExportEventAttendeesRequestObject) (AdminExportEventAttendeesResponseObject, error)
+	// List sign-ups for an event, including the waiting list
+	// (GET /api/v1/admin/events/{eventID}/signups)
+	AdminListEventSignups(ctx context.Context, request AdminListEventSignupsRequestObject) (AdminListEventSignupsResponseObject, error)
+	// Cancel a sign-up, promoting from the waiting list if a place becomes free
+	// (DELETE /api/v1/admin/events/{eventID}/signups/{signupID})
+	AdminCancelEventSignup(ctx context.Context, request AdminCancelEventSignupRequestObject) (AdminCancelEventSignupResponseObject, error)
+	// List the invitations for admins from outside the hosted domains
+	// (GET /api/v1/admin/invitations)
+	AdminListInvitations(ctx context.Context, request AdminListInvitationsRequestObject) (AdminListInvitationsResponseObject, error)
+	// Invite someone from outside the hosted domains to sign in as an admin
+	// (POST /api/v1/admin/invitations)
+	AdminCreateInvitation(ctx context.Context, request AdminCreateInvitationRequestObject) (AdminCreateInvitationResponseObject, error)
+	// Remove an invitation, so the admin can no longer sign in
+	// (DELETE /api/v1/admin/invitations/{invitationID})
+	AdminRemoveInvitation(ctx context.Context, request AdminRemoveInvitationRequestObject) (AdminRemoveInvitationResponseObject, error)
+	// List the changes made to a join request and its place offers, oldest first
+	// (GET /api/v1/admin/join-requests/{joinRequestID}/history)
+	AdminGetJoinRequestHistory(ctx context.Context, request AdminGetJoinRequestHistoryRequestObject) (AdminGetJoinRequestHistoryResponseObject, error)
+	// Get the signed in admin and their roles
+	// (GET /api/v1/admin/me)
+	AdminGetMe(ctx context.Context, request AdminGetMeRequestObject) (AdminGetMeResponseObject, error)
+	// Get a member, without their sensitive details
+	// (GET /api/v1/admin/members/{memberID})
+	AdminGetMember(ctx context.Context, request AdminGetMemberRequestObject) (AdminGetMemberResponseObject, error)
+	// Update a member, without their sensitive details
+	// (PUT /api/v1/admin/members/{memberID})
+	AdminUpdateMember(ctx context.Context, request AdminUpdateMemberRequestObject) (AdminUpdateMemberResponseObject, error)
+	// List who has read a member's sensitive details, most recent first
+	// (GET /api/v1/admin/members/{memberID}/access-log)
+	AdminGetMemberAccessLog(ctx context.Context, request AdminGetMemberAccessLogRequestObject) (AdminGetMemberAccessLogResponseObject, error)
+	// Get a member's emergency contacts and medical details. Every read is recorded in the access log.
+	// (GET /api/v1/admin/members/{memberID}/sensitive)
+	AdminGetMemberSensitive(ctx context.Context, request AdminGetMemberSensitiveRequestObject) (AdminGetMemberSensitiveResponseObject, error)
+	// Replace a member's emergency contacts and medical details
+	// (PUT /api/v1/admin/members/{memberID}/sensitive)
+	AdminSetMemberSensitive(ctx context.Context, request AdminSetMemberSensitiveRequestObject) (AdminSetMemberSensitiveResponseObject, error)
+	// Move a member to another unit, letting the leaders of both units know
+	// (POST /api/v1/admin/members/{memberID}/transfer)
+	AdminTransferMember(ctx context.Context, request AdminTransferMemberRequestObject) (AdminTransferMemberResponseObject, error)
+	// List the members who become old enough for the next section by the end of a term
+	// (GET /api/v1/admin/move-ups)
+	AdminGetMoveUpReport(ctx context.Context, request AdminGetMoveUpReportRequestObject) (AdminGetMoveUpReportResponseObject, error)
+	// Calculate the adult helpers needed for a planned activity
+	// (POST /api/v1/admin/ratio-check)
+	AdminCheckRatio(ctx context.Context, request AdminCheckRatioRequestObject) (AdminCheckRatioResponseObject, error)
+	// List the adult to child ratio rules
+	// (GET /api/v1/admin/ratio-rules)
+	AdminListRatioRules(ctx context.Context, request AdminListRatioRulesRequestObject) (AdminListRatioRulesResponseObject, error)
+	// Create or update adult to child ratio rules
+	// (PUT /api/v1/admin/ratio-rules)
+	AdminSaveRatioRules(ctx context.Context, request AdminSaveRatioRulesRequestObject) (AdminSaveRatioRulesResponseObject, error)
+	// List the admins whose access has been revoked
+	// (GET /api/v1/admin/revocations)
+	AdminListRevocations(ctx context.Context, request AdminListRevocationsRequestObject) (AdminListRevocationsResponseObject, error)
+	// Revoke an admin's access
+	// (POST /api/v1/admin/revocations)
+	AdminRevokeUser(ctx context.Context, request AdminRevokeUserRequestObject) (AdminRevokeUserResponseObject, error)
+	// Remove a revocation, so the admin can sign in again
+	// (DELETE /api/v1/admin/revocations/{revocationID})
+	AdminRemoveRevocation(ctx context.Context, request AdminRemoveRevocationRequestObject) (AdminRemoveRevocationResponseObject, error)
+	// List the roles assigned to admins
+	// (GET /api/v1/admin/roles)
+	AdminListRoles(ctx context.Context, request AdminListRolesRequestObject) (AdminListRolesResponseObject, error)
+	// Assign a role to an admin
+	// (POST /api/v1/admin/roles)
+	AdminAssignRole(ctx context.Context, request AdminAssignRoleRequestObject) (AdminAssignRoleResponseObject, error)
+	// Remove a role from an admin
+	// (DELETE /api/v1/admin/roles/{roleID})
+	AdminRemoveRole(ctx context.Context, request AdminRemoveRoleRequestObject) (AdminRemoveRoleResponseObject, error)
+	// Sign out of the current session
+	// (DELETE /api/v1/admin/session)
+	AdminDeleteSession(ctx context.Context, request AdminDeleteSessionRequestObject) (AdminDeleteSessionResponseObject, error)
+	// Start a cookie session for the admin signed in with the bearer ID token, so the admin site doesn't need to
+	// keep the token. The session lasts while it's in use, up to its lifetime.
+	// (POST /api/v1/admin/session)
+	AdminCreateSession(ctx context.Context, request AdminCreateSessionRequestObject) (AdminCreateSessionResponseObject, error)
+	// List the admins' active sessions
+	// (GET /api/v1/admin/sessions)
+	AdminListSessions(ctx context.Context, request AdminListSessionsRequestObject) (AdminListSessionsResponseObject, error)
+	// Revoke a session, signing it out on every replica within seconds
+	// (DELETE /api/v1/admin/sessions/{sessionID})
+	AdminRevokeSession(ctx context.Context, request AdminRevokeSessionRequestObject) (AdminRevokeSessionResponseObject, error)
+	// List the units in the district with their capacity and membership
+	// (GET /api/v1/admin/units)
+	AdminListUnits(ctx context.Context, request AdminListUnitsRequestObject) (AdminListUnitsResponseObject, error)
+	// Update a unit's capacity and leader
+	// (PUT /api/v1/admin/units/{unitID})
+	AdminUpdateUnit(ctx context.Context, request AdminUpdateUnitRequestObject) (AdminUpdateUnitResponseObject, error)
+	// List the members of a unit
+	// (GET /api/v1/admin/units/{unitID}/members)
+	AdminListUnitMembers(ctx context.Context, request AdminListUnitMembersRequestObject) (AdminListUnitMembersResponseObject, error)
+	// Add a member to a unit
+	// (POST /api/v1/admin/units/{unitID}/members)
+	AdminCreateMember(ctx context.Context, request AdminCreateMemberRequestObject) (AdminCreateMemberResponseObject, error)
+	// List the children waiting to join a unit who are still the right age for it
+	// (GET /api/v1/admin/units/{unitID}/waiting-list)
+	AdminGetWaitingList(ctx context.Context, request AdminGetWaitingListRequestObject) (AdminGetWaitingListResponseObject, error)
+	// Offer a child on the waiting list a place in the unit
+	// (POST /api/v1/admin/units/{unitID}/waiting-list/{joinRequestID}/offer)
+	AdminOfferPlace(ctx context.Context, request AdminOfferPlaceRequestObject) (AdminOfferPlaceResponseObject, error)
+	// Combined iCalendar feed of district and unit events
+	// (GET /api/v1/calendar.ics)
+	GetCalendar(ctx context.Context, request GetCalendarRequestObject) (GetCalendarResponseObject, error)
+	// Send a contact us message
+	// (POST /api/v1/contact-us)
+	ContactUs(ctx context.Context, request ContactUsRequestObject) (ContactUsResponseObject, error)
+	// List publicly visible district and unit events
+	// (GET /api/v1/events)
+	ListEvents(ctx context.Context, request ListEventsRequestObject) (ListEventsResponseObject, error)
+	// Sign up for an event, joining the waiting list if it is full
+	// (POST /api/v1/events/{eventID}/signups)
+	SignUpForEvent(ctx context.Context, request SignUpForEventRequestObject) (SignUpForEventResponseObject, error)
+	// Cancel a sign-up using the token sent in the confirmation email
+	// (POST /api/v1/events/{eventID}/signups/{signupID}/cancel)
+	CancelEventSignup(ctx context.Context, request CancelEventSignupRequestObject) (CancelEventSignupResponseObject, error)
+	// Get the signed in parent's children and their event sign-ups
+	// (GET /api/v1/family)
+	GetFamily(ctx context.Context, request GetFamilyRequestObject) (GetFamilyResponseObject, error)
+	// Sign the parent out
+	// (DELETE /api/v1/family/session)
+	DeleteParentSession(ctx context.Context, request DeleteParentSessionRequestObject) (DeleteParentSessionResponseObject, error)
+	// Ask for a child to join the waiting list for one or more units
+	// (POST /api/v1/join-requests)
+	CreateJoinRequest(ctx context.Context, request CreateJoinRequestRequestObject) (CreateJoinRequestResponseObject, error)
+	// Email a sign in link to a parent, if the district has any of their family's records. The response is the same
+	// whether or not it does, so it can't be used to find out who is a member.
+	// (POST /api/v1/parent-login)
+	CreateParentLogin(ctx context.Context, request CreateParentLoginRequestObject) (CreateParentLoginResponseObject, error)
+	// Swap the token from a sign in link for a session cookie. Each link can only be used once.
+	// (POST /api/v1/parent-login/session)
+	CreateParentSession(ctx context.Context, request CreateParentSessionRequestObject) (CreateParentSessionResponseObject, error)
+	// Accept or decline a place using the token sent in the offer email
+	// (POST /api/v1/place-offers/{offerID}/response)
+	RespondToPlaceOffer(ctx context.Context, request RespondToPlaceOfferRequestObject) (RespondToPlaceOfferResponseObject, error)
+	// List the units in the district
+	// (GET /api/v1/units)
+	ListUnits(ctx context.Context, request ListUnitsRequestObject) (ListUnitsResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// AdminListAPIKeys operation middleware
+func (sh *strictHandler) AdminListAPIKeys(ctx *fiber.Ctx) error {
+	var request AdminListAPIKeysRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListAPIKeys(ctx.UserContext(), request.(AdminListAPIKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListAPIKeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListAPIKeysResponseObject); ok {
+		if err := validResponse.VisitAdminListAPIKeysResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminCreateAPIKey operation middleware
+func (sh *strictHandler) AdminCreateAPIKey(ctx *fiber.Ctx) error {
+	var request AdminCreateAPIKeyRequestObject
+
+	var body AdminCreateAPIKeyJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminCreateAPIKey(ctx.UserContext(), request.(AdminCreateAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminCreateAPIKey")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminCreateAPIKeyResponseObject); ok {
+		if err := validResponse.VisitAdminCreateAPIKeyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminDeleteAPIKey operation middleware
+func (sh *strictHandler) AdminDeleteAPIKey(ctx *fiber.Ctx, apiKeyID APIKeyID) error {
+	var request AdminDeleteAPIKeyRequestObject
+
+	request.ApiKeyID = apiKeyID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminDeleteAPIKey(ctx.UserContext(), request.(AdminDeleteAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminDeleteAPIKey")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminDeleteAPIKeyResponseObject); ok {
+		if err := validResponse.VisitAdminDeleteAPIKeyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminListAuditLog operation middleware
+func (sh *strictHandler) AdminListAuditLog(ctx *fiber.Ctx, params AdminListAuditLogParams) error {
+	var request AdminListAuditLogRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListAuditLog(ctx.UserContext(), request.(AdminListAuditLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListAuditLog")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListAuditLogResponseObject); ok {
+		if err := validResponse.VisitAdminListAuditLogResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminExportAuditLog operation middleware
+func (sh *strictHandler) AdminExportAuditLog(ctx *fiber.Ctx, params AdminExportAuditLogParams) error {
+	var request AdminExportAuditLogRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminExportAuditLog(ctx.UserContext(), request.(AdminExportAuditLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminExportAuditLog")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminExportAuditLogResponseObject); ok {
+		if err := validResponse.VisitAdminExportAuditLogResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminListEvents operation middleware
+func (sh *strictHandler) AdminListEvents(ctx *fiber.Ctx, params AdminListEventsParams) error {
+	var request AdminListEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListEvents(ctx.UserContext(), request.(AdminListEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListEventsResponseObject); ok {
+		if err := validResponse.VisitAdminListEventsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminCreateEvent operation middleware
+func (sh *strictHandler) AdminCreateEvent(ctx *fiber.Ctx) error {
+	var request AdminCreateEventRequestObject
+
+	var body AdminCreateEventJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminCreateEvent(ctx.UserContext(), request.(AdminCreateEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminCreateEvent")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminCreateEventResponseObject); ok {
+		if err := validResponse.VisitAdminCreateEventResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminDeleteEvent operation middleware
+func (sh *strictHandler) AdminDeleteEvent(ctx *fiber.Ctx, eventID EventID) error {
+	var request AdminDeleteEventRequestObject
+
+	request.EventID = eventID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminDeleteEvent(ctx.UserContext(), request.(AdminDeleteEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminDeleteEvent")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminDeleteEventResponseObject); ok {
+		if err := validResponse.VisitAdminDeleteEventResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminGetEvent operation middleware
+func (sh *strictHandler) AdminGetEvent(ctx *fiber.Ctx, eventID EventID) error {
+	var request AdminGetEventRequestObject
+
+	request.EventID = eventID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetEvent(ctx.UserContext(), request.(AdminGetEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetEvent")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminGetEventResponseObject); ok {
+		if err := validResponse.VisitAdminGetEventResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminUpdateEvent operation middleware
+func (sh *strictHandler) AdminUpdateEvent(ctx *fiber.Ctx, eventID EventID) error {
+	var request AdminUpdateEventRequestObject
+
+	request.EventID = eventID
+
+	var body AdminUpdateEventJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminUpdateEvent(ctx.UserContext(), request.(AdminUpdateEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminUpdateEvent")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminUpdateEventResponseObject); ok {
+		if err := validResponse.VisitAdminUpdateEventResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminExportEventAttendees operation middleware
+func (sh *strictHandler) AdminExportEventAttendees(ctx *fiber.Ctx, eventID EventID) error {
+	var request AdminExportEventAttendeesRequestObject
+
+	request.EventID = eventID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminExportEventAttendees(ctx.UserContext(), request.(AdminExportEventAttendeesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminExportEventAttendees")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminExportEventAttendeesResponseObject); ok {
+		if err := validResponse.VisitAdminExportEventAttendeesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminListEventSignups operation middleware
+func (sh *strictHandler) AdminListEventSignups(ctx *fiber.Ctx, eventID EventID) error {
+	var request AdminListEventSignupsRequestObject
+
+	request.EventID = eventID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListEventSignups(ctx.UserContext(), request.(AdminListEventSignupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListEventSignups")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListEventSignupsResponseObject); ok {
+		if err := validResponse.VisitAdminListEventSignupsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminCancelEventSignup operation middleware
+func (sh *strictHandler) AdminCancelEventSignup(ctx *fiber.Ctx, eventID EventID, signupID SignupID) error {
+	var request AdminCancelEventSignupRequestObject
+
+	request.EventID = eventID
+	request.SignupID = signupID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminCancelEventSignup(ctx.UserContext(), request.(AdminCancelEventSignupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminCancelEventSignup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminCancelEventSignupResponseObject); ok {
+		if err := validResponse.VisitAdminCancelEventSignupResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminListInvitations operation middleware
+func (sh *strictHandler) AdminListInvitations(ctx *fiber.Ctx) error {
+	var request AdminListInvitationsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListInvitations(ctx.UserContext(), request.(AdminListInvitationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListInvitations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListInvitationsResponseObject); ok {
+		if err := validResponse.VisitAdminListInvitationsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminCreateInvitation operation middleware
+func (sh *strictHandler) AdminCreateInvitation(ctx *fiber.Ctx) error {
+	var request AdminCreateInvitationRequestObject
+
+	var body AdminCreateInvitationJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminCreateInvitation(ctx.UserContext(), request.(AdminCreateInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminCreateInvitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminCreateInvitationResponseObject); ok {
+		if err := validResponse.VisitAdminCreateInvitationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminRemoveInvitation operation middleware
+func (sh *strictHandler) AdminRemoveInvitation(ctx *fiber.Ctx, invitationID InvitationID) error {
+	var request AdminRemoveInvitationRequestObject
+
+	request.InvitationID = invitationID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminRemoveInvitation(ctx.UserContext(), request.(AdminRemoveInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminRemoveInvitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminRemoveInvitationResponseObject); ok {
+		if err := validResponse.VisitAdminRemoveInvitationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminGetJoinRequestHistory operation middleware
+func (sh *strictHandler) AdminGetJoinRequestHistory(ctx *fiber.Ctx, joinRequestID JoinRequestID) error {
+	var request AdminGetJoinRequestHistoryRequestObject
+
+	request.JoinRequestID = joinRequestID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetJoinRequestHistory(ctx.UserContext(), request.(AdminGetJoinRequestHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetJoinRequestHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminGetJoinRequestHistoryResponseObject); ok {
+		if err := validResponse.VisitAdminGetJoinRequestHistoryResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminGetMe operation middleware
+func (sh *strictHandler) AdminGetMe(ctx *fiber.Ctx) error {
+	var request AdminGetMeRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetMe(ctx.UserContext(), request.(AdminGetMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetMe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminGetMeResponseObject); ok {
+		if err := validResponse.VisitAdminGetMeResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminGetMember operation middleware
+func (sh *strictHandler) AdminGetMember(ctx *fiber.Ctx, memberID MemberID) error {
+	var request AdminGetMemberRequestObject
+
+	request.MemberID = memberID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetMember(ctx.UserContext(), request.(AdminGetMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminGetMemberResponseObject); ok {
+		if err := validResponse.VisitAdminGetMemberResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminUpdateMember operation middleware
+func (sh *strictHandler) AdminUpdateMember(ctx *fiber.Ctx, memberID MemberID) error {
+	var request AdminUpdateMemberRequestObject
+
+	request.MemberID = memberID
+
+	var body AdminUpdateMemberJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminUpdateMember(ctx.UserContext(), request.(AdminUpdateMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminUpdateMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminUpdateMemberResponseObject); ok {
+		if err := validResponse.VisitAdminUpdateMemberResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminGetMemberAccessLog operation middleware
+func (sh *strictHandler) AdminGetMemberAccessLog(ctx *fiber.Ctx, memberID MemberID) error {
+	var request AdminGetMemberAccessLogRequestObject
+
+	request.MemberID = memberID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetMemberAccessLog(ctx.UserContext(), request.(AdminGetMemberAccessLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetMemberAccessLog")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminGetMemberAccessLogResponseObject); ok {
+		if err := validResponse.VisitAdminGetMemberAccessLogResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminGetMemberSensitive operation middleware
+func (sh *strictHandler) AdminGetMemberSensitive(ctx *fiber.Ctx, memberID MemberID) error {
+	var request AdminGetMemberSensitiveRequestObject
+
+	request.MemberID = memberID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetMemberSensitive(ctx.UserContext(), request.(AdminGetMemberSensitiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetMemberSensitive")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminGetMemberSensitiveResponseObject); ok {
+		if err := validResponse.VisitAdminGetMemberSensitiveResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminSetMemberSensitive operation middleware
+func (sh *strictHandler) AdminSetMemberSensitive(ctx *fiber.Ctx, memberID MemberID) error {
+	var request AdminSetMemberSensitiveRequestObject
+
+	request.MemberID = memberID
+
+	var body AdminSetMemberSensitiveJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminSetMemberSensitive(ctx.UserContext(), request.(AdminSetMemberSensitiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminSetMemberSensitive")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminSetMemberSensitiveResponseObject); ok {
+		if err := validResponse.VisitAdminSetMemberSensitiveResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminTransferMember operation middleware
+func (sh *strictHandler) AdminTransferMember(ctx *fiber.Ctx, memberID MemberID) error {
+	var request AdminTransferMemberRequestObject
+
+	request.MemberID = memberID
+
+	var body AdminTransferMemberJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminTransferMember(ctx.UserContext(), request.(AdminTransferMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminTransferMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminTransferMemberResponseObject); ok {
+		if err := validResponse.VisitAdminTransferMemberResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminGetMoveUpReport operation middleware
+func (sh *strictHandler) AdminGetMoveUpReport(ctx *fiber.Ctx, params AdminGetMoveUpReportParams) error {
+	var request AdminGetMoveUpReportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetMoveUpReport(ctx.UserContext(), request.(AdminGetMoveUpReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetMoveUpReport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminGetMoveUpReportResponseObject); ok {
+		if err := validResponse.VisitAdminGetMoveUpReportResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminCheckRatio operation middleware
+func (sh *strictHandler) AdminCheckRatio(ctx *fiber.Ctx) error {
+	var request AdminCheckRatioRequestObject
+
+	var body AdminCheckRatioJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminCheckRatio(ctx.UserContext(), request.(AdminCheckRatioRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminCheckRatio")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminCheckRatioResponseObject); ok {
+		if err := validResponse.VisitAdminCheckRatioResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminListRatioRules operation middleware
+func (sh *strictHandler) AdminListRatioRules(ctx *fiber.Ctx) error {
+	var request AdminListRatioRulesRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListRatioRules(ctx.UserContext(), request.(AdminListRatioRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListRatioRules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListRatioRulesResponseObject); ok {
+		if err := validResponse.VisitAdminListRatioRulesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminSaveRatioRules operation middleware
+func (sh *strictHandler) AdminSaveRatioRules(ctx *fiber.Ctx) error {
+	var request AdminSaveRatioRulesRequestObject
+
+	var body AdminSaveRatioRulesJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminSaveRatioRules(ctx.UserContext(), request.(AdminSaveRatioRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminSaveRatioRules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminSaveRatioRulesResponseObject); ok {
+		if err := validResponse.VisitAdminSaveRatioRulesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminListRevocations operation middleware
+func (sh *strictHandler) AdminListRevocations(ctx *fiber.Ctx) error {
+	var request AdminListRevocationsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListRevocations(ctx.UserContext(), request.(AdminListRevocationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListRevocations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListRevocationsResponseObject); ok {
+		if err := validResponse.VisitAdminListRevocationsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminRevokeUser operation middleware
+func (sh *strictHandler) AdminRevokeUser(ctx *fiber.Ctx) error {
+	var request AdminRevokeUserRequestObject
+
+	var body AdminRevokeUserJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminRevokeUser(ctx.UserContext(), request.(AdminRevokeUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminRevokeUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminRevokeUserResponseObject); ok {
+		if err := validResponse.VisitAdminRevokeUserResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminRemoveRevocation operation middleware
+func (sh *strictHandler) AdminRemoveRevocation(ctx *fiber.Ctx, revocationID RevocationID) error {
+	var request AdminRemoveRevocationRequestObject
+
+	request.RevocationID = revocationID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminRemoveRevocation(ctx.UserContext(), request.(AdminRemoveRevocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminRemoveRevocation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminRemoveRevocationResponseObject); ok {
+		if err := validResponse.VisitAdminRemoveRevocationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminListRoles operation middleware
+func (sh *strictHandler) AdminListRoles(ctx *fiber.Ctx) error {
+	var request AdminListRolesRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListRoles(ctx.UserContext(), request.(AdminListRolesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListRoles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListRolesResponseObject); ok {
+		if err := validResponse.VisitAdminListRolesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminAssignRole operation middleware
+func (sh *strictHandler) AdminAssignRole(ctx *fiber.Ctx) error {
+	var request AdminAssignRoleRequestObject
+
+	var body AdminAssignRoleJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminAssignRole(ctx.UserContext(), request.(AdminAssignRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminAssignRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminAssignRoleResponseObject); ok {
+		if err := validResponse.VisitAdminAssignRoleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminRemoveRole operation middleware
+func (sh *strictHandler) AdminRemoveRole(ctx *fiber.Ctx, roleID RoleID) error {
+	var request AdminRemoveRoleRequestObject
+
+	request.RoleID = roleID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminRemoveRole(ctx.UserContext(), request.(AdminRemoveRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminRemoveRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminRemoveRoleResponseObject); ok {
+		if err := validResponse.VisitAdminRemoveRoleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminDeleteSession operation middleware
+func (sh *strictHandler) AdminDeleteSession(ctx *fiber.Ctx) error {
+	var request AdminDeleteSessionRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminDeleteSession(ctx.UserContext(), request.(AdminDeleteSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminDeleteSession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminDeleteSessionResponseObject); ok {
+		if err := validResponse.VisitAdminDeleteSessionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminCreateSession operation middleware
+func (sh *strictHandler) AdminCreateSession(ctx *fiber.Ctx) error {
+	var request AdminCreateSessionRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminCreateSession(ctx.UserContext(), request.(AdminCreateSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminCreateSession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminCreateSessionResponseObject); ok {
+		if err := validResponse.VisitAdminCreateSessionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminListSessions operation middleware
+func (sh *strictHandler) AdminListSessions(ctx *fiber.Ctx, params AdminListSessionsParams) error {
+	var request AdminListSessionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListSessions(ctx.UserContext(), request.(AdminListSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListSessionsResponseObject); ok {
+		if err := validResponse.VisitAdminListSessionsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminRevokeSession operation middleware
+func (sh *strictHandler) AdminRevokeSession(ctx *fiber.Ctx, sessionID SessionID) error {
+	var request AdminRevokeSessionRequestObject
+
+	request.SessionID = sessionID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminRevokeSession(ctx.UserContext(), request.(AdminRevokeSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminRevokeSession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminRevokeSessionResponseObject); ok {
+		if err := validResponse.VisitAdminRevokeSessionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminListUnits operation middleware
+func (sh *strictHandler) AdminListUnits(ctx *fiber.Ctx) error {
+	var request AdminListUnitsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListUnits(ctx.UserContext(), request.(AdminListUnitsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListUnits")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListUnitsResponseObject); ok {
+		if err := validResponse.VisitAdminListUnitsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminUpdateUnit operation middleware
+func (sh *strictHandler) AdminUpdateUnit(ctx *fiber.Ctx, unitID UnitID) error {
+	var request AdminUpdateUnitRequestObject
+
+	request.UnitID = unitID
+
+	var body AdminUpdateUnitJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminUpdateUnit(ctx.UserContext(), request.(AdminUpdateUnitRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminUpdateUnit")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminUpdateUnitResponseObject); ok {
+		if err := validResponse.VisitAdminUpdateUnitResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminListUnitMembers operation middleware
+func (sh *strictHandler) AdminListUnitMembers(ctx *fiber.Ctx, unitID UnitID) error {
+	var request AdminListUnitMembersRequestObject
+
+	request.UnitID = unitID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListUnitMembers(ctx.UserContext(), request.(AdminListUnitMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListUnitMembers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminListUnitMembersResponseObject); ok {
+		if err := validResponse.VisitAdminListUnitMembersResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminCreateMember operation middleware
+func (sh *strictHandler) AdminCreateMember(ctx *fiber.Ctx, unitID UnitID) error {
+	var request AdminCreateMemberRequestObject
+
+	request.UnitID = unitID
+
+	var body AdminCreateMemberJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminCreateMember(ctx.UserContext(), request.(AdminCreateMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminCreateMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminCreateMemberResponseObject); ok {
+		if err := validResponse.VisitAdminCreateMemberResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminGetWaitingList operation middleware
+func (sh *strictHandler) AdminGetWaitingList(ctx *fiber.Ctx, unitID UnitID, params AdminGetWaitingListParams) error {
+	var request AdminGetWaitingListRequestObject
+
+	request.UnitID = unitID
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetWaitingList(ctx.UserContext(), request.(AdminGetWaitingListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetWaitingList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminGetWaitingListResponseObject); ok {
+		if err := validResponse.VisitAdminGetWaitingListResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdminOfferPlace operation middleware
+func (sh *strictHandler) AdminOfferPlace(ctx *fiber.Ctx, unitID UnitID, joinRequestID JoinRequestID) error {
+	var request AdminOfferPlaceRequestObject
+
+	request.UnitID = unitID
+	request.JoinRequestID = joinRequestID
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminOfferPlace(ctx.UserContext(), request.(AdminOfferPlaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminOfferPlace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(AdminOfferPlaceResponseObject); ok {
+		if err := validResponse.VisitAdminOfferPlaceResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCalendar operation middleware
+func (sh *strictHandler) GetCalendar(ctx *fiber.Ctx, params GetCalendarParams) error {
+	var request GetCalendarRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalendar(ctx.UserContext(), request.(GetCalendarRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalendar")
+	}
+
+	response, err := handler(ctx, request)
 
-// FiberServerOptions provides options for the Fiber server.
-type FiberServerOptions struct {
-	BaseURL     string
-	Middlewares []MiddlewareFunc
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetCalendarResponseObject); ok {
+		if err := validResponse.VisitGetCalendarResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router fiber.Router, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
-}
+// ContactUs operation middleware
+func (sh *strictHandler) ContactUs(ctx *fiber.Ctx) error {
+	var request ContactUsRequestObject
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	var body ContactUsJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
+	request.Body = &body
 
-	for _, m := range options.Middlewares {
-		router.Use(fiber.Handler(m))
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ContactUs(ctx.UserContext(), request.(ContactUsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ContactUs")
 	}
 
-	router.Post(options.BaseURL+"/api/v1/contact-us", wrapper.ContactUs)
+	response, err := handler(ctx, request)
 
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ContactUsResponseObject); ok {
+		if err := validResponse.VisitContactUsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type ContactUsRequestObject struct {
-	Body *ContactUsJSONRequestBody
-}
+// ListEvents operation middleware
+func (sh *strictHandler) ListEvents(ctx *fiber.Ctx, params ListEventsParams) error {
+	var request ListEventsRequestObject
 
-type ContactUsResponseObject interface {
-	VisitContactUsResponse(ctx *fiber.Ctx) error
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEvents(ctx.UserContext(), request.(ListEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListEventsResponseObject); ok {
+		if err := validResponse.VisitListEventsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type ContactUs200Response struct {
+// SignUpForEvent operation middleware
+func (sh *strictHandler) SignUpForEvent(ctx *fiber.Ctx, eventID EventID) error {
+	var request SignUpForEventRequestObject
+
+	request.EventID = eventID
+
+	var body SignUpForEventJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SignUpForEvent(ctx.UserContext(), request.(SignUpForEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SignUpForEvent")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(SignUpForEventResponseObject); ok {
+		if err := validResponse.VisitSignUpForEventResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response ContactUs200Response) VisitContactUsResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
+// CancelEventSignup operation middleware
+func (sh *strictHandler) CancelEventSignup(ctx *fiber.Ctx, eventID EventID, signupID SignupID) error {
+	var request CancelEventSignupRequestObject
+
+	request.EventID = eventID
+	request.SignupID = signupID
+
+	var body CancelEventSignupJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelEventSignup(ctx.UserContext(), request.(CancelEventSignupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelEventSignup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CancelEventSignupResponseObject); ok {
+		if err := validResponse.VisitCancelEventSignupResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type ContactUs422JSONResponse ErrorResponse
+// GetFamily operation middleware
+func (sh *strictHandler) GetFamily(ctx *fiber.Ctx) error {
+	var request GetFamilyRequestObject
 
-func (response ContactUs422JSONResponse) VisitContactUsResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(422)
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFamily(ctx.UserContext(), request.(GetFamilyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFamily")
+	}
 
-	return ctx.JSON(&response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetFamilyResponseObject); ok {
+		if err := validResponse.VisitGetFamilyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type ContactUs500JSONResponse ErrorResponse
+// DeleteParentSession operation middleware
+func (sh *strictHandler) DeleteParentSession(ctx *fiber.Ctx) error {
+	var request DeleteParentSessionRequestObject
 
-func (response ContactUs500JSONResponse) VisitContactUsResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteParentSession(ctx.UserContext(), request.(DeleteParentSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteParentSession")
+	}
 
-	return ctx.JSON(&response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(DeleteParentSessionResponseObject); ok {
+		if err := validResponse.VisitDeleteParentSessionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Send a contact us message
-	// (POST /api/v1/contact-us)
-	ContactUs(ctx context.Context, request ContactUsRequestObject) (ContactUsResponseObject, error)
+// CreateJoinRequest operation middleware
+func (sh *strictHandler) CreateJoinRequest(ctx *fiber.Ctx) error {
+	var request CreateJoinRequestRequestObject
+
+	var body CreateJoinRequestJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateJoinRequest(ctx.UserContext(), request.(CreateJoinRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateJoinRequest")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateJoinRequestResponseObject); ok {
+		if err := validResponse.VisitCreateJoinRequestResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+// CreateParentLogin operation middleware
+func (sh *strictHandler) CreateParentLogin(ctx *fiber.Ctx) error {
+	var request CreateParentLoginRequestObject
 
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	var body CreateParentLoginJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateParentLogin(ctx.UserContext(), request.(CreateParentLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateParentLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateParentLoginResponseObject); ok {
+		if err := validResponse.VisitCreateParentLoginResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+// CreateParentSession operation middleware
+func (sh *strictHandler) CreateParentSession(ctx *fiber.Ctx) error {
+	var request CreateParentSessionRequestObject
+
+	var body CreateParentSessionJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateParentSession(ctx.UserContext(), request.(CreateParentSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateParentSession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateParentSessionResponseObject); ok {
+		if err := validResponse.VisitCreateParentSessionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// ContactUs operation middleware
-func (sh *strictHandler) ContactUs(ctx *fiber.Ctx) error {
-	var request ContactUsRequestObject
+// RespondToPlaceOffer operation middleware
+func (sh *strictHandler) RespondToPlaceOffer(ctx *fiber.Ctx, offerID OfferID) error {
+	var request RespondToPlaceOfferRequestObject
 
-	var body ContactUsJSONRequestBody
+	request.OfferID = offerID
+
+	var body RespondToPlaceOfferJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.ContactUs(ctx.UserContext(), request.(ContactUsRequestObject))
+		return sh.ssi.RespondToPlaceOffer(ctx.UserContext(), request.(RespondToPlaceOfferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ContactUs")
+		handler = middleware(handler, "RespondToPlaceOffer")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(ContactUsResponseObject); ok {
-		if err := validResponse.VisitContactUsResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(RespondToPlaceOfferResponseObject); ok {
+		if err := validResponse.VisitRespondToPlaceOfferResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListUnits operation middleware
+func (sh *strictHandler) ListUnits(ctx *fiber.Ctx) error {
+	var request ListUnitsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUnits(ctx.UserContext(), request.(ListUnitsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUnits")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListUnitsResponseObject); ok {
+		if err := validResponse.VisitListUnitsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -150,16 +5569,159 @@ func (sh *strictHandler) ContactUs(ctx *fiber.Ctx) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7xTT1PbPhD9Kpr9/Y7CDtBefKOFMnTawhBoDx2GEfLGFmNLqrQmzTD+7p2VHNKQ9Nbp",
-	"Kcr+efv2+e0zaNd7Z9FShOoZom6xV+n53llSmm7jZ4xRNcgxH5zHQAZTBfbKdPxYuNArgmqKSKCVR6gg",
-	"UjC2gVFCv8HYyVnV70uMEgL+GEzAGqrvuUq+TFgD3r0Mcw+PqIkBz0Jw4Rqjdzbuo83p+z8zejV4u3x3",
-	"3ighoh6CodWc1ctDVN0be68GavnfA6qA4cNapo/fbkBmrRkpZzeytUQeRgY2duG4v8aog/FknIUKbi5P",
-	"L0FCZzROC2YJ4fzLrThZLDA4cX71SRwXM5AwhG7CjFVZLpfLorFD4UJTTgCxVI3vDo6LWYG2aKnvWEQy",
-	"1DHmqWFdNImTqwuQ8IQhZhaHxayYcaXzaJU3UAFDHIMEr6hNKpTKm/LpsNTZSwdDinoXiX/5oyje6aKG",
-	"auM3yPpjpHeuXnEht6NNPcr7zujUVT5GJrI2Lb/+D7iACv4rN64uJ0uXO35OCm8ru0797gAKAyZLZD+l",
-	"DY5ms93PMh+0xhgXQ9etRGS+o4Q3R0d/bYNtX++h/1V1pk7IAn9qzOFRwttM99+QmLseqTW2EUu0JJbB",
-	"TUcVh75XYcUlaGuhxOQKMUTRvwhPqol8dn546IyGuzRhCr5W/CrViOuz+Q3bk50zXcLUPcqdlmCeFKE4",
-	"4ftka2e59kCkC4bxbvwVAAD//+MNBKMkBQAA",
+	"H4sIAAAAAAAC/+x9a3PcOJLgX0HwNsJ3EVRJ7undiNV9UvvR7bnusdeSZi6i2+eGyKwqtEiAA4CS6zT6",
+	"7xt4kSAJvqSqkluuTy6LeCQSmYlEIh93UcLyglGgUkSnd1GBOc5BAtf/O/vw7v/A5t1r9ZvQ6DQqsFxH",
+	"cURxDtFphAtiPscRh3+WhEManUpeQhyJZA05Vv2WjOdYRqdRWZI0iiO5KVRfITmhq+j+Po7OypTIs0QS",
+	"Rv+rBL5RvVIQCSeF+lt0Gr2n2QYRmmRlCkiuiUDXhKaILRHW3WIkymSNsEA55FfAF2WRYgmLKDZg/1MP",
+	"W8OtO0U+lP1QMT4FKDOkQFcbAx9Oc0IH5md8yvTnhCYwZ3osEeMILyVwA4ckeS8ahBo9Cu6Vwt6R6tu/",
+	"YReYr0C+ez0HPEYNVBwSxtM+uKQZOZ2CIQPFxaaAh8DhqMjA06GiQfjUnFMgvKSSZLNICJaMw/j2lWrg",
+	"h2zfmxugspepwX59HE+/5Syfsmg9m0BAU0JXPeSLXsMSl5kUSDJE2W0fQpac5Q/Bxzt6QyRW0PUihfhN",
+	"HoeZvzJCP8I/SxD9e/BHo83j5vtF03LvVLn7/LhZ3i+XA5Ow5XILc3yEG5YM7xP3mzxyNpZB/zzm4+Nm",
+	"OAchhhYjqu+PnIesaFn0T+M+P26WCzaD34XEXCqO7wi7BrNjtAHsBMLvir9/7xXK7CGsf0lJPxeW5uMQ",
+	"WsIjTkFESlSnRB7dkhotmKbu55JZEaig6D8CiBw8g+7dR0+dU79wlr1fRqe/3kX/xmEZnUb/47jWA49t",
+	"l2Or/tGilNF9fBcVnBXAJQE9WsIBS0jP5FR8xxFJJ9BSHGVYyEvhhm4i8R9rUIc3oGvYoFsskGqMSgFp",
+	"rCjmlsg1oQijnNDSaIDTQHNzvvsQwGMcsVsKvAvNxRqMqodu10wAUnJBVOCVAkQDAsixPrK7ZFOT2K+R",
+	"RoqZMPaw/Knqx67+gERG95+UjuHt0Wl7i+BLQTiIUTwKyQqBbhm/JnQVo9s1SdYoL4VEV1CjVPHidIQa",
+	"Eu1Oi2U1LdFkrobM8Zefga7kOjp9eXISRzmh1f8DY4uEFWaFREKuf4zT8bnqpHrnhL4z3eqxMed409kJ",
+	"vYZqutjDZ3cz4sifprPwI8QBp6dIAFh1U8SIybXWdDC1Kqd4gQRQQSS5AZSCxCQTi9/oEbrlRMIpStaY",
+	"rqoB9JeqvRlbiRDbanBItRpa5mqVCrAojvQUarWudfQpgHqzSNGlNXMZnLsp0X01R3gL3LBBhCeS3BC5",
+	"CfOlf0VUrRxdp5CQ1HIpV7oC4qXhWiwRLops0zyEcgB1VC08jNk/KTYt7Q8OgqRAJcFZGG9KSmjNe7r8",
+	"Nc27khd76x5EsWt3H0c4LTP5E2SFvdpbAAmVsAKuWiSMLgnPIf1hcw7mhqzmSlOifuPsQwOGbv/uDtBS",
+	"EaDag2pspLSNo7IQiFAEOFkjYeZaRIENfsARY7v8sAmKcb3fY2j7qBq9WkNyXXV5Q5eMJ5Db7Rvt7be/",
+	"jyNjjpi1jltMZEaEhNSobyJM5DWKK8QyI9fVAErDUoMskNY9Cg4CqES3TvZrXQNJfA2i6u9tRLW5LZ6s",
+	"cexvkb/MuKbRFukFENpzsCmG+ZkIqbnAIuEjiIJRAV3xI2osTRI/3qijMsiNHZRBDTAHADR63XTxWMuL",
+	"MfDsyIPQKbV0ADilSM6ETY04CpoZtxcyewPqwvMQxi85t+zZ0XTsMUuEUjkU4durlf7NzUVbK5M5To3C",
+	"43HBFWMZYKrmMPqbD1KPRhc3Va+ta8fnAHRQq3ML3KKG7BS6Ccqrw4ovHDywfezUGzdGJQGdQ3hfplOu",
+	"HW+c7d3ovZBpHph8nFuO6dA6LnDSq8XkTEinyemdVSyFEky12F6gsyst0skSEYnWWCDKlNYOFAnQ98ac",
+	"UJIrteUkDhzaSw7wIcMJjJ4vhW6FMlhKf1YFkltBcP7unBngFPib6bxklz8GoSUkh60JB5kbeOAEuhTm",
+	"3tfaM8GXF+waaBgmqT4pZhNAU6XpKCz936NX5x/fHuluaK1xoJnRCSCrgVrlHdONXNvbmEImWVHQQ+ku",
+	"+DdqqdOo8l3x80BJ1SNO9B33hVESFBC2z6OFR6wtadP5VxnlzoSCIp90Mto1m0mCbKxM9K80yrvLP0NL",
+	"Aln6QqAbnJXgjFXqiuVs1M5sv0C2sdlEJXZxmkJqWcJ2jXVfRqFuxSFnN3U7Pa5Ca0vjV3+OTu/u48iM",
+	"pH7f963nDZXG/tS9NjA6cCc3loyUpD3vaTFiZs05yDVL9WI4KyVoa5V7v1AEykqJMFKbvghRg3kAG7am",
+	"IMnYtY/h4KGvd04MXVQGjwNv94M3GL2lwoPCsmcaq5e+1voa9xZ3F+ws8id26yG74mv/eqnFh76Jm4Mq",
+	"jnBBPl/DpnuzjKMvR6rb0Q3mChyh+r+qpr+wI9V/Oa/GrP9mr+KfujoIofI/vg8KcVIE2dlQRvATS7SA",
+	"nqXUaYNsaDAhsSx7ToSfLi4+INPA3zmutV/Fky0Fz1tV9fQYmtN79wt+5jiBYNegfa/GRlw9CFcP0xaN",
+	"sbNI2+X2SrCf2arL70AlJ3PMZLXs6AjWOKLwRf7gZE8L6x+wEEpa/G6E0+/q6FuBESqqHyrwCmKrLnBQ",
+	"WjijTdWzj9LaEt2uKYSJV5gmkHkXO/uE1sWMdEf38EaZZsGpGJU4kZfiFxACr0LXvTnqTTXGA5Vua6d0",
+	"M7gBg5BblfxBTwEB7fUa+sxvsEFECsiWzvCWYPpCW5Q5qE1Upx5eYRKS7K31NUWfr6O9yYGvgCYbuyPd",
+	"jXAYHDEpF2tGp7TjkOn3RbEOSsDwxpjBQ9vxhnPGB4wG6vPnIQLhgEX4UN8gTO0p4664CSuz1G4CLuUa",
+	"qCSJIocYCYaSjOinJ325gCxDt1oxYChlmotPlbk5J0IQuvqseePUKYTaEk3oDc5I+tnOdqrZ/6yUa8bJ",
+	"/9dIc2ovEQoIjNRImdKrMAdutObGSHYSWanUFQlJXgoJ6f+uNFJDSaqz0U2rzkoOYUTh1htfM8pnyuTn",
+	"G+BkSSA9tSdFwkoqXwjTonoCca2M8k2kQAVnNyQFroez3fSAOMvYbWs8C7YDVYnAGnA9JKb6KUCPRtln",
+	"ra82h1D6YaUsxOiqlIgCpAJh/ejkdFN7N0xZdYGwjwT61P9scXPasBGooUEdjCFsuq1QNx5/P0K3GSIc",
+	"eShFMWWgtznHMln7EzqcgRCf1YP9dRf95quGTN8lbTPTs5TrzyXFN5hk+CoDuxgLuU/iibLhqmVJvjFL",
+	"QmLNuMw2wVtT+6hp8F6QeZ05v3U5dMbuiZbbrnH8sfbaliobEBtA0+0bpqzrRXBCzz470Sx7DlISuhJW",
+	"3eMz1MZaOxyfyzRVCg+RWVjGltbM091E9QXxklJF89UOLdDr0Ov+Gt+AuuO5F/0JWqKBya3fbNugNmh8",
+	"u8Kvwdt4NhqiY90WrU1jZS+iSlot7Z3RYabhy0Vh3ES1VVIepNFtPPX8ieh8RNNxVD+iIz+IQs3Kg6JT",
+	"aXEzbIO2x9/67Ev2+wen3vU9GM65laqP75c/EC7XnU6h9salcpoQnShrKZONd1j/yswlSUiBaT9SRP2+",
+	"O0Q47hl4MqVZgq5IbRoJ6RU6HHUX0MR3DXxz7+Mm7VQQD3vQNMixR2wmuJDJGl/03BnjxxPtCCOOkvBc",
+	"eqyIp2XutBqjFteMrzAlQklysVY6Fbqm7LY2DKYEJOYbZDcyByrFImzC6dDjyHrnU+c0OtsSYTXoYYSk",
+	"PoIoswBNTeTyh3BdiLmmiePqIOrad6YqorNetvAXdeqPKMJvS65fb91fkPKN7jo4+FrEy7AWgdOMUGjg",
+	"fVDK5/jL2QqGYTdmLapd40TsXC9SvPGwpE9GMa7n5IT2z0fo1uez5N6j1rmv5qUixxtzwyqLBTqjG/fZ",
+	"/2CoA/JCbtTkk0yOHg8PPuRUu9dPwhWrOCu6vqIL/SIQxRF25JKypNTSSv2xUI0g1VxNE8gySIPeW29x",
+	"TrLAk8rgy2SyJlnKLccUWL9NWv8DK1Kc++MkXBlH/pBp1ow91v+DadXUUbtQWyJy7ObBnuMUJkM7x7/G",
+	"wl8/9MaDLjd1vMZ0+2Xdp8+dGScJFHLE6VjHgUBqzWpLwoVsPuHMclKbo3NOeaWto1TcA21cOwYYmwhQ",
+	"5BY6HdyJp5VFTtD1LnQq1e2bniJDXrafmvE6PW7PUzWx4HPxMMkFvFBI8+Mk9qgHHOUOf/gQbF5AURc2",
+	"LYP6L0d7uPtkZEWuMjifetpYuakfx1mWIqCsXK2VuUCf/MYbQrttrYiQwA0hP+602c61C+icO4Dp0Ls1",
+	"5nO/zl8wIROW9nzksAT1unjZ8evrtG1jYprO6RHdkOJZk19b2a7g70AboJkGtpq4nnzR8yDusdsO+kes",
+	"cVEABc81oibAGOkAN/XDCdcYpZBkRB0MjLsniYf7Qmi/RMMbmCodsD6Wq8cKnKYchNBOGmIjJOSLLZ09",
+	"Jnxvm1eWcfKpY7Td9rpH8snb/BMRkoUcYWa64nYo5xEOuX7050PNDb5IH7lKP9gysG35NgLnHGk3NlRH",
+	"9oXN9o2zRhs4MnIN7pyJjeNlNZjR9RonTWfm6XFFDxOLAzJw1Cbh0V2fTeLBJzUJHtSSpXizt6M5aFJs",
+	"L2gEMd0rpL0zRlYE6ouik/Bq66yIr3TW8OVxB9EBj5ZDCiZzoRwAyrvdPuqGOtnr1sC11ViFR4Yp2CV1",
+	"gJgrWCfql4pzRq6gBnvItNR/caHEW/DG3bcWO+OBgIbEpQ2AHpCMFUb7d/dMOxuE9EH193mqkuvTE382",
+	"xWfIGyL2YRhbQNAH0Pafy8MWJaPBmW70fth6tJzZiskcPeJptJSw61freO8j1H4EnlfhuB2Z8NpE89rI",
+	"VQ4IaMI36nCy12TJzKVEO51nG/WSc6vjEvTNQlRv80amvKiTDbSIKMuAr0iPZggtF7wZp1irZ+j0zyEl",
+	"Cc7+1qOYdiw4bVj6EXvBMRVL4ANeopfTnsBNu+BM7AYui35dS6XJ6bH4uy25UqSjLPyMWj9KsQZ0BQnL",
+	"oaF4uc3UDrdeVO0ob+XVGTftcGc3wNMy9FLBS1AGR6FfZwTCGQecbnwgq3wfgCTw3DxWQBoOt5PsfOZT",
+	"YFDTiPyR4ibu69X0b99HKBgPKcw0DeBgDSbgTm/ZslrptJ3Q880Q1rp9jxUn4EgSmlMB13s2D96fiAs1",
+	"9G4ELlJNSaOc3agHl7JAksXGz1GugXC05AA2nmzyBWF6zKleUNshxWE2HtD1PlTPKE8V7LXYQyhXZTVy",
+	"7p+tpwLjm1sKSD1mnfy20ROA1Yj3rNDZvwk/sxWhDzWQPM78P+EybWB06ZvCUMoxglHZjBqeqxmh1+Nu",
+	"gf0xCTqMU+fh2k48tZ9IYZJtUt+RPfOjJShtyDfXZ+W7y3Is1YmebRb7jZv2k6pN61FFDJ1t3Sev3qwH",
+	"eUs111LdgypbaYPfgtkahimo//ZtzB8emI3zekZYjbOkjEDSNco8xhTjpfbormw0Q0oOPQt3qztTQwQ7",
+	"t1DQ6tDJkaFmCuGlhr9Xa92Gl++w48iK8Gww5HKGP2/LhVgPjbCUNiGkFpIjaWICDwc28YgBtBePLU/f",
+	"3kcf0fZ7ShmYOPccwEXOKtdnyaxFVDsSN32dlxk2oRjqx6k3GBEox/xaCcd6UOeFpwcy0R6VR4ZSrYQk",
+	"WVb7S+mBrzKWXJ/6nhtYWsv2FQd8XQ8Y9qEy98R6Nc6RO8HUxPQjDmmZaL0gY7f1cH7oqlpdFEcaln4O",
+	"/FhmsB3K1Vv8AbhmpAbtvezzsap4tN/NquXTbggAboArTc0SaX1+W1coQ7WT/a4eeqfx6Lt2WGyiwV9n",
+	"L/WrPQgYvXg5Kx6/2s0xrdyMG4Smytk53Z2n7rP/7IQ2Fmmym0vdfjybX3tdjwkrrSMDvex6/35y8nAv",
+	"mBq6EOE0P04jn6rPOP14wwdha2aGmE5JjX5bpKbhRGQPftSaqs196uBkCxTFjNgew6e2b47HTekDhGXg",
+	"8kC6rDLKkMV1Ik2ccCaMYeF2rVr6Tx3TLnMa5nGCCRH0LjOT9GckqRAYSCHplv85YbmOtGQU+KmL+FQH",
+	"lEsa42GuwplSEhTiP5sEPKZfjilegTXMqK+xTVGr1AIixVhiSqnETMndcI30ljpIlcluzyB86uJobZgW",
+	"FD2BUUPq0evPL8S0qWhqA3AD1hantwQxay9WFl9RHFWLjeLIwBXUcTyrpRufY0Kv2K1QuhFnt5SA+rkq",
+	"Sap/cAUYF+HRfPd+b8hK+4v8bIGjrszOph0KSGjS27mKldVJTow5iFB0+fFnUXtAvRTyyK1mMeud8ZE6",
+	"kP8g6IYK8ZJaqx/V0B+eMKyyzcxYFUrM8w+jaav37cdn6vAG683X4YTv+ON44DE8iiuYPg0vpso1NO24",
+	"9Z1VAz7YK3hfytdY9oRBqAPXczxxjyCSMf3G4F5ArHCY/gbSVrFrMEIHq6HfkhO5OVfLcuaDnNDPKjhd",
+	"/c+kNHjrpv3rPy5cnm5tOtBfazjWUhbmHq7GEHVWxB67m2e9TRi7JrBAH4fsyiaRAc6ENVL3WKirNONm",
+	"0DrP+OfPPzEhj6qc5XViIkd1Oktv/UY6vIaOCfpJFrE0MR2dNdxrV/YlC8D+/vX7KI4ykoC1jdmhf/zb",
+	"JTpTZimGfvzwM/rL4kQdHTyzWytOj49vb28XK1ouGF8d2wHEMV4V2dFfFicLoIu1zDMvPDdyIeTo7MO7",
+	"KI5ugBuERi8XJ4sT1ZIVQHFBotNIDfEXmy1IE+MxLsjxzctjTS3qP0fXNjfzytiwFNeZe0YandZpQl12",
+	"Z2f7tJ4D352cuOhcp1wXRUaMPn78h71m1GnoxzPJCIPn1oFTan+CZZllG2SOM7V6pGG/j6PvT/6yNTCa",
+	"iVcCwFxY07w5+BzbGb00ZTp/SWbMIEQD9+8nJ/sD7pzlYJjiVsflcWbFmBNMWhD7IunXT/ex+0vFnL9+",
+	"uv8UR6LMc6yEuPa+QjK4cLcP2r6vNU1G4YVxIbDeBOoxQGlplm8rvU64EziOJF7pDGW6h044VjAhBxII",
+	"CaSvBOoxuZEmxqh1Oo9UtkEcZMmpc3vQwt/ejTyZUuV29ZKkqAw3v1E/w6G9eKitjVFGch3uI5nLWa+E",
+	"ls4GbzKIBNjIJFgyVG5rR4CQP7B0s2UWsnfV+/t2gYr7Dve+3NrUzfRRYzxsN8Hw7sl+eVeRT5UuyAJi",
+	"3Pb1hkD61UuU70/+c7/AaZAqr421ZjpdZsM83ROhLwPPRdgZUkaYOtmmZZmZWJvsSwGKYALyMCDJ7uOe",
+	"Q/f4zpXJuzdyLgOj3gZkx2v90ZMdDS7+PnBF87nNDP1noOvv9wec21vKlGGppOlzIV9DKx756hxu9mij",
+	"DGWMroCjK3N5D5+9XrHHnntb3eS4KgapbYstYi9TIo8ytpqgYrp8lbPnbxVkvI8nd6kqS07t0y4rOK/f",
+	"u9fzenllHqd28aoKqi5DFbfMPb5RaYtRiIM5OvsKTZnO4Qpb/Zk774JjaaWqMVRq3kld7R+TW8G+VQy9",
+	"591/6sjILd5THJ2OKTkCME/W1hdfMwJSjLBvKaw3vaWJC4CuRTRoe7X68HO6zJzrfWnuinnS1VqOcVq0",
+	"cV4cEv3yQLiQkw53J+8WibgZlnlvvhSMH6Te1qXeOO9L+CKP7f4MlshrkuOr879rMqloxllCDxz9xBxt",
+	"WKnF0e5802lGnd/MkmRSuyEL9Or87w9k8jomb1ijMbF9szm7LpE7gfwv2OSmdQXK3Z6PPYWPJtr1LHK/",
+	"+rvKd9/tD7i/q0y7Ln9LAoVzjHg2pkWcZXbjY8RhhXmagVDewajyXO23EPba2jQB7sjU5uVT3bOlzS8A",
+	"NsfMdmCm589MtekKLO1PPMyO72x596m2qJq3DqaoxwKncfmcDVF9xBgPKFA/guyhsZOnEKVVaYoD6X4D",
+	"pPsjyGG6nafRG2Xhtda7i7KP4i91La2vSWl5Ek6zxWIPfDbCZwelaUvMbvjuUUrTsYnxABBT7X56Q89c",
+	"r2jHNqs6gXIF6IG/voFzzDOMBUhAWzMt1VuL2FYPu3G28RIOTzClubI5ezNetYuLTzRhuUi7A4t9Ayym",
+	"7Vdux43Xm2UpP2CiHVP5NHx2fGd+TLnmd4omzr/s1/EGB0bwcWSI5fmxgiEZXb5PrzBGBWc5k1XAdpsL",
+	"VCIIbBK+VD77Sw6wTe4YfxexRV6CnNRK4T18SPnJwHd4RvnTTDyR/GUc/KX36C/tId73iNbcwEopiE2R",
+	"smZ6n1KWY0LnOkTXk1SFKet001emDodaoq4PWZWDdKXa0Xua+B3U8ykWUqCSSpIhnfnHVSNf/EYvOtUO",
+	"dORFqOakXMPGPTd7VSeH3KNr2t6RCaRT72G/jzfe+sZY1yL54I3cBs5kNV97eeR0+QoPX89BhGhKASRY",
+	"DozCmMTQacZcjVZRFfidYsvwRNTxXf2fKTriRy0VWjw7S0W0cuWgIPrA1Qh9fjqiIRlFoDWpaQflKtam",
+	"5adsqXoLKqEn+oO6nkp+c2TPHHF856fSen1/vK6LBgw+WgXqDOxQFwzMNlEl1DLEdTlwXw2cQmlVGP15",
+	"mivqgiE2+k0yhNEf/sJ1FJ0U9nKmU6uJWAVTg+j3lJvLkX7RiyBL5jDKb7/Azu2BlwJ4cIt8tlqxYITk",
+	"4bq1p+faAO5d3jJi08ZM0YZsVpPjO/PDakEjFGiTCe+MCu0MU0gwt00PEr0GzqDvmXop2B03eZRZKS3B",
+	"d3zBtyCubeL+aX4MHlds/xbvlxDYsyfDRF48eDFMZMSDG8OW3RgeIRAmnIbHprTHeEhndTDWRUh2zpX1",
+	"VNMuQU8TuWOSRglXBKBRY6MdnXM4J7d151GBUmud5gGnFZO8EF2+mBYU9IizcwqXCb+4ywQmq4vB7JzJ",
+	"6qmm6KNdsXPguW9NN9WVSG39HVfhXOjLmS3iUyWORG9UAiHDo8RlcYSqeIY5e1R032J/6ux5mMd2pdS2",
+	"2GtMsR0zsD+VHvrn47qDIro1E78xGs5m/wdqpNLW61L4eIwE6A8ndBXB9nCnbRcf+zpvtw7l/HDDDQoW",
+	"ZhIRtwTMvh/KNQhEILVnBwm3RQn3C7upxZt+PKFMrsHlyc5MXkN95nlH4BWTa91CoGvKbidJO3YDR6MO",
+	"0uoW4Bek6yhCXdKQwPM6X/N3J9/9xxEuZZnTZnEStYKk5FwhT3VZoPOCq6Xx0nkx/RXTEvONav2Lyqui",
+	"Rs1z4L9R/fms4CRTH8/KVWnflcxMpvs5FLJC42tI9O++bEOugltFHAWWErhq+f9+PTn6z093398f/U+h",
+	"QfyXAeNfZrL/9W+B5L67zILQ2JDRixJQtan2VZbbToeg7W8oA4JfI1GZKow77FgZT+Xdp/4GNNXBHMhy",
+	"yKhg0SLkKKkKXg2kUlBtdDmXHek93dJVe9Z5agBGGTXBWVJmh5wK31ROBbfngRJYFECZJ7Rnr3KVoGp7",
+	"vEJME/mwqq007F/ulWfaNTuYWSa6EmlYkVnEwd1hjwdGqLqc3YegeazfxoVvoEVdOxL0HmHtWcJPI2mB",
+	"b0xycFlyqvN9+2hdHMT+N5ZKh3FrQp3HbF1B36yCNiLovca7ZAtvmqmi3u9yEPX7FPUu3aOo3kHUc6YO",
+	"fbCVBGeGDek2upKCQaryFWDLyl1AQyRiz4nu3WtTY8GUGuXwByQ6YohRU/hB3VwVfrXnAaFIQMJoKkzQ",
+	"kEu6X0UprDChNsbI3HodZTXjjcLRQh/1irVj5o4Oq3Yhyf3GCdXTT8hMZDZ/37UUzgxFml01QFg6YbfU",
+	"kugheKmvTJPHwo0oJm83n8dbkKYLTFsLn3lkHt/V/5kemOQx0SEwaRvA1Qh9voFJ3kEUiEtqnF5b8EHw",
+	"jpmga1BVa3REYWS7Ngq0iqJOVRfZwSawZ0XRLBMLiwD1OKV6zk6ha3Zb7fuuNKxQgeU9a1mt2rwjRO2Q",
+	"etBqxgtEOVI82CS2yOSGVl2WBf3uPD3OW/VRihTLYIYK5fj/oDw9WnlSe4YrafOcNSi1Uv26P0CfszUl",
+	"TbchHalRUnY0cfW5bT2bqGsrSRRHpqSs7nkO8uiVKSk7nJvxuZTPUSKothVV/iFe7d/5qfp7d+XlE4Ty",
+	"Com5Tm/j1rRAF9pCZSBzFbTMIWebvFDpEz++NQayxcMpZO/lMF3A961niVDUAFSqWSE1VR4rXPw5pFWT",
+	"YtV+ImyrSbuFVA4Vuqt3btiqluDqyjqzZ+s6KIgElDIQ6lyhoHXt3+g1QKEbGULQhOMmNGmVbtckA1OL",
+	"VhlABcSoLNRhTqRAGVmCJDksfgsxUp/om3BDPHctR5zDjN+2uUwQ0a4qLvo8s1zB+0AZuL5a+DuvQVMt",
+	"eWrazqr94b6674eNF8ZrAupNmEH9x3f21zS9VlkkH6wFNKztB9XWYsgKuGeo0hrztSPLuM6lJ40ONPz0",
+	"tQW999yRdkj11d7E48L/UjfbR9pkPdPcfMlmFQepu0epq1Huwuqq0oBO8yEcJbjACZEbG6ejHVPXpJgi",
+	"lvXYx3fqHyuQ51H8pe44KcmEaroj86Qa+tw48osnqZah13ZIM/EA4C67oTcHC+SWk0wo7n4hmmLCxLrM",
+	"FxEuqG/aQfaLbbxD/lNT2WnmnmVuKQeGHGTIZxm3oSMwSnMkPVbt8w/BMePZ15Vq6eW+g1Fxmh5OwMMJ",
+	"uK83uDRthn72cfzYoWcLIRypg2M0vvMfprESOaMWPJ4CV4FhHFZESDMYUsd2M39nrHybrzYIr+BIXWVN",
+	"C8EYrZo0g0HNeMAh7bMCMm7O/3oHgZa5QkrdN4ojvIL3pYw+7dcm6KNwRo7cRtWWg5D5dk517ebPgVYU",
+	"IJlJkWs4XkdpYg5ISJJZF2ayWkvFT/ppYbtqwBxp0sldrdP3PvwiHs9O5DuotLxX0HzIcAK7fO7TE+ip",
+	"Rrldo0e/9+nEJQcu73A5483s0E+b18IE4BChwXDcaV7ziEkutHeNpwNWVx44s9tzEZSatxC2y2a0W93J",
+	"8pMzM47rSQnOgKaYL0jSbwz4EeQr2y56iDj9L62z3H+aWHLTTTWv7iZxIKIlfFXe/HV8G8uviBY5DVDV",
+	"JbayByujjj7rTEE5b/OK8iojSWv3TGqno1L0pxR4Zdpc7irGtBr/FxACr3py9JhPEy26Q44iQPcvar7y",
+	"y1XtdQE01U4XekdQKVBeIX6IjCyt9bF/VZxTzOb+t5zllvvHVZoLNrnpLKnyOKukWflco6RF6YFSw5Sq",
+	"VX5DitkG3RBBrjJ4qBQcqnH7iKrxQWmqXPEui7eM77xovK2R+BRWQG/+jyDKbPz2bnXpskC64l5qwiDC",
+	"l/knruG+T9X53C9Sq7BhCz9zQEnGBBzshL1HmXJ5LYtWdV91IwrV9kVkibzEew8TF16p3mNTR/cRAmRW",
+	"DdQ+YRMuCLwDFa49z6y0UDNrEh/K/rZuBa0SvqgUjsS1Q6tWed2FzpaUtwzs3DwHiH2Jc5Jthm52b02L",
+	"HWpRdobBMwRVcH2ll/UCc6ByTkEk0+OFMNd1Dn5xJHMOVFXr6z20+xXYwylBFybe4oOe+BB1MW8D9Ymj",
+	"dtA0tOsf2pdG9cKBC7h+MfbstTuS4r5F+Cm0Rm/+iVrjsKL4NahF33+3T0MrYyjHdNMw/Iq6jrvAuXLe",
+	"TrlN8/G1nWRn4trm5TMGSvd609HWVCNGdaannHHrGjlykBmuVEVwCB3jNSMAf9ZNd8Nr3gwzeO27cFag",
+	"jNDrOrOQ0JouMZFepvDyFajKrMK8fVtJ9G1ziEsKoVAnEBbXNiXkn4ZX3uiNxY2FmP01hF5RQGUTWesi",
+	"05s6X5QhhBeuZodoh8yJChG/0ds16GzZzFRgIFKHUenwKlMX4oWuYV8KI4+XhJrkVOrFlYjK+6IZIzXG",
+	"pr7GMs6uvr6yK4Z1nv1PcTwaCL7ySMiX+32/qyQffCnULmgHmU6CplJ8ne8557fYCzy04ddNjjbHod0m",
+	"Gw25QG9wsjbfE0wRU/F/jvkYTWAxxmPqie/IFAg+vtP/KpuFI5H5Vov3ZogBS4TBVHrBvDf+HTFqNUFz",
+	"d/YXCjDDjcHAkD6BaUPD98ROAZryfPYNcm+FIyTZV6m1JkqbUaCnkGSEQvWIPmSIMWufYoEZDhjbS6zY",
+	"48PEvsr3o96oquCO3Fd/bOvgH3Qb9PHN+QU6+/BO1N6Vtvd93OnCyQ2WgLR3Ve3yGRjClSRvj1A11WeE",
+	"UZtEOyS+eZrUg7o7wKf7/x4AylV3EjUgAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,80 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+)
+
+func (s *Server) AdminListInvitations(ctx context.Context, request AdminListInvitationsRequestObject) (AdminListInvitationsResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminListInvitations403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	invitations, err := s.db.ListInvitations(ctx)
+	if err != nil {
+		slog.Error("failed to list invitations", "err", err)
+		return AdminListInvitations500JSONResponse{ErrorMessage: "failed to list invitations"}, nil
+	}
+
+	if invitations == nil {
+		invitations = []Invitation{}
+	}
+
+	return AdminListInvitations200JSONResponse{Invitations: invitations}, nil
+}
+
+func (s *Server) AdminCreateInvitation(ctx context.Context, request AdminCreateInvitationRequestObject) (AdminCreateInvitationResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminCreateInvitation403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+
+	invitation, err := s.db.CreateInvitation(ctx, *request.Body, email, time.Now().Add(s.cfg.InvitationExpiry))
+	switch {
+	case errors.Is(err, consts.ErrConflict):
+		return AdminCreateInvitation409JSONResponse{ErrorMessage: "this email has already been invited"}, nil
+	case err != nil:
+		slog.Error("failed to create invitation", "err", err)
+		return AdminCreateInvitation500JSONResponse{ErrorMessage: "failed to invite admin"}, nil
+	}
+
+	slog.Info("admin invited", "email", invitation.Email, "by", email)
+	audit(ctx, "invitation.create", "invitation", invitation.Id.String(), nil, invitation)
+
+	vars := map[string]any{
+		"InvitedBy": email,
+		"ExpiresAt": invitation.ExpiresAt,
+	}
+
+	if err := s.sendEmail(ctx, string(invitation.Email), "admin-invited", vars); err != nil {
+		slog.Error("failed to send invitation email", "err", err, "invitation", invitation.Id)
+	}
+
+	return AdminCreateInvitation201JSONResponse(invitation), nil
+}
+
+func (s *Server) AdminRemoveInvitation(ctx context.Context, request AdminRemoveInvitationRequestObject) (AdminRemoveInvitationResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminRemoveInvitation403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	err := s.db.DeleteInvitation(ctx, request.InvitationID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminRemoveInvitation404JSONResponse{ErrorMessage: "invitation not found"}, nil
+	case err != nil:
+		slog.Error("failed to remove invitation", "err", err)
+		return AdminRemoveInvitation500JSONResponse{ErrorMessage: "failed to remove invitation"}, nil
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+	slog.Info("invitation removed", "id", request.InvitationID, "by", email)
+	audit(ctx, "invitation.remove", "invitation", request.InvitationID.String(), nil, nil)
+
+	return AdminRemoveInvitation204Response{}, nil
+}
@@ -0,0 +1,56 @@
+package rest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_AdminCreateInvitation(t *testing.T) {
+	ctx := commissionerContext()
+	input := rest.InvitationInput{Email: "volunteer@gmail.com"}
+
+	t.Run("the invited admin is emailed", func(t *testing.T) {
+		s, m := newTestServer(t)
+		invitation := rest.Invitation{Id: uuid.New(), Email: input.Email, InvitedBy: commissionerEmail}
+
+		m.db.EXPECT().CreateInvitation(ctx, input, commissionerEmail, gomock.Any()).
+			DoAndReturn(func(_ any, _ rest.InvitationInput, _ string, expiresAt time.Time) (rest.Invitation, error) {
+				assert.WithinDuration(t, time.Now().Add(testInvitationExpiry), expiresAt, time.Minute)
+				return invitation, nil
+			})
+		m.content.EXPECT().EmailTemplate(ctx, "admin-invited", gomock.Any()).
+			Return(rest.EmailContent{Subject: "subject", Body: "body"}, nil)
+		m.email.EXPECT().Send(ctx, "volunteer@gmail.com", "subject", "body").Return(nil)
+
+		resp, err := s.AdminCreateInvitation(ctx, rest.AdminCreateInvitationRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminCreateInvitation201JSONResponse(invitation), resp)
+	})
+
+	t.Run("an email can't be invited twice", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().CreateInvitation(ctx, input, commissionerEmail, gomock.Any()).
+			Return(rest.Invitation{}, consts.ErrConflict)
+
+		resp, err := s.AdminCreateInvitation(ctx, rest.AdminCreateInvitationRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminCreateInvitation409JSONResponse{ErrorMessage: "this email has already been invited"}, resp)
+	})
+
+	t.Run("only commissioners can invite admins", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+
+		resp, err := s.AdminCreateInvitation(ctx, rest.AdminCreateInvitationRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminCreateInvitation403JSONResponse{}, resp)
+	})
+}
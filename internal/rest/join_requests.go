@@ -0,0 +1,116 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	errNotEligible     = errors.New("children can join from age 4 until they turn 18")
+	errNoPostcode      = errors.New("postcode must not be empty")
+	errUnknownUnit     = errors.New("preferred units must be units in the district")
+	errRepeatedUnit    = errors.New("preferred units must not be repeated")
+	errUnitNotEligible = errors.New("the child is not the right age for one of the preferred units")
+)
+
+func (s *Server) CreateJoinRequest(ctx context.Context, request CreateJoinRequestRequestObject) (CreateJoinRequestResponseObject, error) {
+	ip, _ := UserIPFromContext(ctx)
+	if err := s.captcha.Verify(ctx, request.Body.CaptchaToken, ip); err != nil {
+		slog.Error("captcha verification failed", "err", err)
+		return CreateJoinRequest422JSONResponse{ErrorMessage: "captcha verification failed"}, nil
+	}
+
+	limit := s.limits.Load().JoinRequests
+
+	recent, err := s.db.CountJoinRequestsFromIP(ctx, ip, time.Now().Add(-limit.Window))
+	if err != nil {
+		slog.Error("failed to count join requests", "err", err)
+		return CreateJoinRequest500JSONResponse{ErrorMessage: "failed to join the waiting list"}, nil
+	}
+
+	if recent >= limit.Limit {
+		slog.Warn("too many join requests", "ip", ip, "count", recent)
+		return CreateJoinRequest429JSONResponse{ErrorMessage: "too many requests, please try again later"}, nil
+	}
+
+	units, err := s.db.ListUnits(ctx)
+	if err != nil {
+		slog.Error("failed to list units", "err", err)
+		return CreateJoinRequest500JSONResponse{ErrorMessage: "failed to join the waiting list"}, nil
+	}
+
+	eligible, err := validateJoinRequest(request.Body, units, time.Now())
+	if err != nil {
+		return CreateJoinRequest422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	id, err := s.db.AddJoinRequest(ctx, *request.Body, eligible, ip)
+	if err != nil {
+		slog.Error("failed to add join request", "err", err)
+		return CreateJoinRequest500JSONResponse{ErrorMessage: "failed to join the waiting list"}, nil
+	}
+
+	vars := map[string]any{
+		"ChildName":        request.Body.ChildName,
+		"ParentName":       request.Body.ParentName,
+		"PreferredUnits":   unitNames(units, request.Body.PreferredUnits),
+		"EligibleSections": eligible,
+	}
+
+	if err := s.sendEmail(ctx, string(request.Body.ParentEmail), "join-request-received", vars); err != nil {
+		slog.Error("failed to send join request email", "err", err, "joinRequest", id)
+	}
+
+	return CreateJoinRequest201JSONResponse{
+		Id:               id,
+		EligibleSections: eligible,
+	}, nil
+}
+
+// validateJoinRequest checks the join request against the district's units, returning the sections the child can
+// join today.
+func validateJoinRequest(joinRequest *JoinRequestInput, units []Unit, now time.Time) ([]Section, error) {
+	if strings.TrimSpace(joinRequest.Postcode) == "" {
+		return nil, errNoPostcode
+	}
+
+	eligible := eligibleSections(joinRequest.DateOfBirth.Time, now)
+
+	if len(eligible) == 0 {
+		return nil, errNotEligible
+	}
+
+	seen := map[string]bool{}
+	for _, id := range joinRequest.PreferredUnits {
+		if seen[id] {
+			return nil, errRepeatedUnit
+		}
+		seen[id] = true
+
+		i := slices.IndexFunc(units, func(u Unit) bool { return u.Id == id })
+		if i < 0 {
+			return nil, errUnknownUnit
+		}
+
+		if !slices.Contains(eligible, units[i].Section) {
+			return nil, errUnitNotEligible
+		}
+	}
+
+	return eligible, nil
+}
+
+func unitNames(units []Unit, ids []string) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if i := slices.IndexFunc(units, func(u Unit) bool { return u.Id == id }); i >= 0 {
+			names = append(names, units[i].Name)
+		}
+	}
+
+	return names
+}
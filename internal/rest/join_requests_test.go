@@ -0,0 +1,149 @@
+package rest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func districtUnits() []rest.Unit {
+	return []rest.Unit{
+		{Id: "2nd-rainbows", Name: "2nd Staplehurst Rainbows", Section: consts.SectionRainbows},
+		{Id: "1st-brownies", Name: "1st Staplehurst Brownies", Section: consts.SectionBrownies},
+		{Id: "1st-guides", Name: "1st Staplehurst Guides", Section: consts.SectionGuides},
+	}
+}
+
+func TestServer_CreateJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	today := time.Now()
+
+	joinRequest := rest.JoinRequestInput{
+		ChildName:      "Ada",
+		DateOfBirth:    openapi_types.Date{Time: today.AddDate(-8, 0, 0)},
+		Postcode:       "TN12 0AA",
+		PreferredUnits: []string{"1st-brownies"},
+		ParentName:     "Parent",
+		ParentEmail:    "parent@example.com",
+		CaptchaToken:   "token",
+	}
+
+	t.Run("eligible children join the waiting list", func(t *testing.T) {
+		s, m := newTestServer(t)
+		id := uuid.New()
+		eligible := []rest.Section{consts.SectionBrownies}
+
+		m.captcha.EXPECT().Verify(ctx, "token", "").Return(nil)
+		m.db.EXPECT().CountJoinRequestsFromIP(ctx, "", gomock.Any()).Return(0, nil)
+		m.db.EXPECT().ListUnits(ctx).Return(districtUnits(), nil)
+		m.db.EXPECT().AddJoinRequest(ctx, joinRequest, eligible, "").Return(id, nil)
+		m.content.EXPECT().EmailTemplate(ctx, "join-request-received", map[string]any{
+			"ChildName":        "Ada",
+			"ParentName":       "Parent",
+			"PreferredUnits":   []string{"1st Staplehurst Brownies"},
+			"EligibleSections": eligible,
+		}).Return(rest.EmailContent{Subject: "subject", Body: "body"}, nil)
+		m.email.EXPECT().Send(ctx, "parent@example.com", "subject", "body").Return(nil)
+
+		resp, err := s.CreateJoinRequest(ctx, rest.CreateJoinRequestRequestObject{Body: &joinRequest})
+		require.NoError(t, err)
+		assert.Equal(t, rest.CreateJoinRequest201JSONResponse{Id: id, EligibleSections: eligible}, resp)
+	})
+
+	t.Run("too many requests from one address are refused", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.captcha.EXPECT().Verify(ctx, "token", "").Return(nil)
+		m.db.EXPECT().CountJoinRequestsFromIP(ctx, "", gomock.Any()).Return(5, nil)
+
+		resp, err := s.CreateJoinRequest(ctx, rest.CreateJoinRequestRequestObject{Body: &joinRequest})
+		require.NoError(t, err)
+		assert.Equal(t, rest.CreateJoinRequest429JSONResponse{ErrorMessage: "too many requests, please try again later"}, resp)
+	})
+
+	t.Run("updated limits apply straight away", func(t *testing.T) {
+		s, m := newTestServer(t)
+		s.UpdateLimits(rest.Limits{JoinRequests: rest.RateLimit{Limit: 2, Window: time.Hour}})
+
+		m.captcha.EXPECT().Verify(ctx, "token", "").Return(nil)
+		m.db.EXPECT().CountJoinRequestsFromIP(ctx, "", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, since time.Time) (int, error) {
+				assert.WithinDuration(t, time.Now().Add(-time.Hour), since, time.Minute)
+				return 2, nil
+			})
+
+		resp, err := s.CreateJoinRequest(ctx, rest.CreateJoinRequestRequestObject{Body: &joinRequest})
+		require.NoError(t, err)
+		assert.IsType(t, rest.CreateJoinRequest429JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name    string
+		update  func(rest.JoinRequestInput) rest.JoinRequestInput
+		errResp string
+	}{
+		{
+			name: "children under 4 are not eligible",
+			update: func(j rest.JoinRequestInput) rest.JoinRequestInput {
+				j.DateOfBirth = openapi_types.Date{Time: today.AddDate(-4, 0, 1)}
+				return j
+			},
+			errResp: "children can join from age 4 until they turn 18",
+		},
+		{
+			name: "adults are not eligible",
+			update: func(j rest.JoinRequestInput) rest.JoinRequestInput {
+				j.DateOfBirth = openapi_types.Date{Time: today.AddDate(-18, 0, 0)}
+				return j
+			},
+			errResp: "children can join from age 4 until they turn 18",
+		},
+		{
+			name: "units must be in the district",
+			update: func(j rest.JoinRequestInput) rest.JoinRequestInput {
+				j.PreferredUnits = []string{"9th-brownies"}
+				return j
+			},
+			errResp: "preferred units must be units in the district",
+		},
+		{
+			name: "units must be for the child's section",
+			update: func(j rest.JoinRequestInput) rest.JoinRequestInput {
+				j.PreferredUnits = []string{"1st-brownies", "1st-guides"}
+				return j
+			},
+			errResp: "the child is not the right age for one of the preferred units",
+		},
+		{
+			name: "a girl moves up on her birthday",
+			update: func(j rest.JoinRequestInput) rest.JoinRequestInput {
+				j.DateOfBirth = openapi_types.Date{Time: today.AddDate(-10, 0, 0)}
+				return j
+			},
+			errResp: "the child is not the right age for one of the preferred units",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, m := newTestServer(t)
+			body := tt.update(joinRequest)
+
+			m.captcha.EXPECT().Verify(ctx, "token", "").Return(nil)
+			m.db.EXPECT().CountJoinRequestsFromIP(ctx, "", gomock.Any()).Return(0, nil)
+			m.db.EXPECT().ListUnits(ctx).Return(districtUnits(), nil)
+
+			resp, err := s.CreateJoinRequest(ctx, rest.CreateJoinRequestRequestObject{Body: &body})
+			require.NoError(t, err)
+			assert.Equal(t, rest.CreateJoinRequest422JSONResponse{ErrorMessage: tt.errResp}, resp)
+		})
+	}
+}
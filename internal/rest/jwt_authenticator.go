@@ -2,66 +2,292 @@ package rest
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
 	"log/slog"
 	"slices"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/girlguidingstaplehurst/district/internal/consts"
 	"github.com/gofiber/fiber/v2"
-	"google.golang.org/api/idtoken"
+	"github.com/google/uuid"
 )
 
 type JWTAuthenticator struct {
-	clientID      string
+	verifier TokenVerifier
+	admins   AdminDirectory
+	denylist *Denylist
+	sessions SessionConfig
+
+	mu            sync.RWMutex
 	hostedDomains []string
+	allowList     []string
 }
 
-func NewJWTAuthenticator(clientID string, hostedDomains ...string) *JWTAuthenticator {
+// NewJWTAuthenticator creates a JWTAuthenticator that lets in accounts from the hosted domains, the individual
+// accounts on the allow-list, and anyone with an invitation. Requests without an Authorization header can use a
+// session cookie instead. Admins and sessions on the denylist are turned away.
+func NewJWTAuthenticator(verifier TokenVerifier, admins AdminDirectory, denylist *Denylist, sessions SessionConfig, hostedDomains, allowList []string) *JWTAuthenticator {
 	return &JWTAuthenticator{
-		clientID:      clientID,
+		verifier:      verifier,
+		admins:        admins,
+		denylist:      denylist,
+		sessions:      sessions,
 		hostedDomains: hostedDomains,
+		allowList:     allowList,
 	}
 }
 
-type UserEmailKey struct{}
+// UpdateAdmission changes the hosted domains and individual accounts that can sign in. Sessions that have already
+// started aren't ended, as their admins still need a role to do anything.
+func (a *JWTAuthenticator) UpdateAdmission(hostedDomains, allowList []string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.hostedDomains = hostedDomains
+	a.allowList = allowList
+}
+
+// authRealm is the realm given in WWW-Authenticate challenges.
+const authRealm = "district-admin"
+
+// sessionTouchInterval is how long a session or API key is used before its use is recorded again, so every request
+// doesn't need a write.
+const sessionTouchInterval = time.Minute
+
+var errMissingToken = errors.New("no Authorization header")
 
 func (a *JWTAuthenticator) Validate(ctx *fiber.Ctx) error {
-	authHeaders, ok := ctx.GetReqHeaders()["Authorization"]
-	if !ok {
-		slog.Error("Could not find auth token header")
-		return unauthorized()
+	if _, ok := ctx.GetReqHeaders()[fiber.HeaderAuthorization]; !ok {
+		if token := ctx.Cookies(SessionCookie); token != "" {
+			return a.validateSession(ctx, token)
+		}
+	}
+
+	tokenString, err := bearerToken(ctx)
+	switch {
+	case errors.Is(err, errMissingToken):
+		return authError(ctx, fiber.StatusUnauthorized, consts.AuthReasonMissingToken, "you need to sign in")
+	case err != nil:
+		slog.Error("invalid auth header", "err", err)
+		return authError(ctx, fiber.StatusBadRequest, consts.AuthReasonInvalidRequest, err.Error())
+	}
+
+	if strings.HasPrefix(tokenString, APIKeyPrefix) {
+		return a.validateAPIKey(ctx, tokenString)
+	}
+
+	userCtx := ctx.UserContext()
+
+	identity, err := a.verifier.Verify(userCtx, tokenString)
+	switch {
+	case errors.Is(err, consts.ErrTokenExpired):
+		return authError(ctx, fiber.StatusUnauthorized, consts.AuthReasonExpiredToken, "your sign in has expired")
+	case errors.Is(err, consts.ErrEmailNotVerified):
+		slog.Error("email not verified", "err", err)
+		return authError(ctx, fiber.StatusUnauthorized, consts.AuthReasonEmailNotVerified, "your account's email hasn't been verified")
+	case errors.Is(err, consts.ErrInvalidToken):
+		slog.Error("validation failed", "err", err)
+		return authError(ctx, fiber.StatusUnauthorized, consts.AuthReasonInvalidToken, "your sign in couldn't be verified")
+	case err != nil:
+		slog.Error("failed to verify token", "err", err)
+		return authError(ctx, fiber.StatusServiceUnavailable, consts.AuthReasonUnavailable, "your sign in couldn't be checked")
 	}
 
+	email := identity.Email
+
+	admitted, err := a.admitted(userCtx, identity)
+	switch {
+	case err != nil:
+		slog.Error("failed to check invitation", "err", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to check invitation")
+	case !admitted:
+		slog.Error("account not allowed", "hd", identity.HostedDomain, "email", email, "issuer", identity.Issuer)
+		return authError(ctx, fiber.StatusUnauthorized, consts.AuthReasonNotAllowed, "your account can't sign in here")
+	}
+
+	return a.authorise(ctx, Principal{
+		Email:        email,
+		Name:         identity.Name,
+		HostedDomain: identity.HostedDomain,
+		Expiry:       identity.Expiry,
+	})
+}
+
+// validateSession authenticates the request with its session cookie. Requests that could change anything must also
+// send the session's CSRF token, as the browser sends the cookie whichever page made the request.
+func (a *JWTAuthenticator) validateSession(ctx *fiber.Ctx, token string) error {
 	userCtx := ctx.UserContext()
+	now := time.Now()
+
+	session, err := a.admins.GetSession(userCtx, hashToken(token), now)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		ctx.Set(fiber.HeaderSetCookie, clearedSessionCookie(SessionCookie))
+		return authError(ctx, fiber.StatusUnauthorized, consts.AuthReasonSessionExpired, "your session has ended")
+	case err != nil:
+		slog.Error("failed to get session", "err", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to get session")
+	}
 
-	_, tokenString, found := strings.Cut(authHeaders[0], "Bearer ")
-	if !found {
-		slog.Error("Could not find Bearer token in header 0")
-		return unauthorized()
+	if !safeMethod(ctx.Method()) && subtle.ConstantTimeCompare([]byte(ctx.Get(CSRFHeader)), []byte(session.CSRFToken)) != 1 {
+		slog.Error("CSRF token missing or wrong", "email", session.Email, "method", ctx.Method(), "path", ctx.Path())
+		return authError(ctx, fiber.StatusForbidden, consts.AuthReasonInvalidCSRFToken, "the request's CSRF token doesn't match your session")
 	}
 
-	payload, err := idtoken.Validate(userCtx, tokenString, a.clientID)
+	if now.Sub(session.LastSeenAt) >= sessionTouchInterval {
+		session.ExpiresAt = sessionExpiry(session.CreatedAt, now, a.sessions)
+
+		// The session can still be used until it expires, so failing to extend it shouldn't fail the request.
+		if err := a.admins.TouchSession(userCtx, session.ID, now, session.ExpiresAt); err != nil {
+			slog.Error("failed to touch session", "err", err, "session", session.ID)
+		}
+	}
+
+	return a.authorise(ctx, Principal{
+		Email:        session.Email,
+		Name:         session.Name,
+		HostedDomain: session.HostedDomain,
+		Expiry:       session.ExpiresAt,
+		SessionID:    session.ID,
+		CSRFToken:    session.CSRFToken,
+	})
+}
+
+// validateAPIKey authenticates the request as the API key's owner, limited to the key's scopes.
+func (a *JWTAuthenticator) validateAPIKey(ctx *fiber.Ctx, key string) error {
+	userCtx := ctx.UserContext()
+	now := time.Now()
+
+	apiKey, err := a.admins.FindAPIKey(userCtx, hashToken(key), now)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		slog.Error("unknown or expired API key")
+		return authError(ctx, fiber.StatusUnauthorized, consts.AuthReasonInvalidToken, "the API key isn't valid")
+	case err != nil:
+		slog.Error("failed to find API key", "err", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to find API key")
+	}
+
+	ip, _ := UserIPFromContext(userCtx)
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= sessionTouchInterval || deref(apiKey.LastUsedIP) != ip {
+		// The key still works if its use can't be recorded.
+		if err := a.admins.TouchAPIKey(userCtx, apiKey.Id, now, ip); err != nil {
+			slog.Error("failed to touch API key", "err", err, "key", apiKey.Id)
+		}
+	}
+
+	return a.authorise(ctx, Principal{
+		Email:    string(apiKey.Owner),
+		Expiry:   apiKey.ExpiresAt,
+		APIKeyID: apiKey.Id,
+		Scopes:   scopedPermissions(apiKey.Scopes),
+	})
+}
+
+// authorise checks the admin's access hasn't been revoked, and loads their roles, which they need at least one of,
+// before passing the request on as them.
+func (a *JWTAuthenticator) authorise(ctx *fiber.Ctx, p Principal) error {
+	userCtx := ctx.UserContext()
+
+	if a.denylist.Revoked(p.Email, p.SessionID) {
+		slog.Error("access revoked", "email", p.Email, "session", p.SessionID)
+		if p.SessionID != uuid.Nil {
+			ctx.Set(fiber.HeaderSetCookie, clearedSessionCookie(SessionCookie))
+		}
+		return authError(ctx, fiber.StatusUnauthorized, consts.AuthReasonRevoked, "your access has been revoked")
+	}
+
+	roles, err := a.admins.ListUserRoles(userCtx, p.Email)
 	if err != nil {
-		slog.Error("validation failed", "err", err)
-		return unauthorized()
+		slog.Error("failed to load roles", "err", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to load roles")
 	}
 
-	hd := payload.Claims["hd"].(string)
-	if !slices.Contains(a.hostedDomains, hd) {
-		slog.Error("invalid hosted domain", "hd", payload.Claims["hd"])
-		return unauthorized()
+	if len(roles) == 0 {
+		slog.Error("no roles assigned", "email", p.Email)
+		return authError(ctx, fiber.StatusForbidden, consts.AuthReasonNoRoles, "no roles have been assigned to you")
 	}
 
-	userCtx = context.WithValue(userCtx, UserEmailKey{}, payload.Claims["email"])
-	ctx.SetUserContext(userCtx)
+	p.Roles = roles
+	ctx.SetUserContext(context.WithValue(userCtx, PrincipalKey{}, p))
 
 	return ctx.Next()
 }
 
-func UserEmailFromContext(ctx context.Context) (string, bool) {
-	e, ok := ctx.Value(UserEmailKey{}).(string)
-	return e, ok
+func safeMethod(method string) bool {
+	return method == fiber.MethodGet || method == fiber.MethodHead || method == fiber.MethodOptions
 }
 
-func unauthorized() *fiber.Error {
-	return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+// bearerToken reads the token from the request's only Authorization header, which must use the Bearer scheme.
+func bearerToken(ctx *fiber.Ctx) (string, error) {
+	authHeaders := ctx.GetReqHeaders()[fiber.HeaderAuthorization]
+	switch {
+	case len(authHeaders) == 0:
+		return "", errMissingToken
+	case len(authHeaders) > 1:
+		return "", errors.New("only one Authorization header can be given")
+	}
+
+	scheme, token, found := strings.Cut(authHeaders[0], " ")
+	token = strings.TrimSpace(token)
+	if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", errors.New("the Authorization header must be a Bearer token")
+	}
+
+	return token, nil
+}
+
+// authError responds with why the request couldn't be authenticated, and the challenge RFC 6750 asks for.
+func authError(ctx *fiber.Ctx, status int, reason, message string) error {
+	challenge := fmt.Sprintf("Bearer realm=%q", authRealm)
+	switch reason {
+	case consts.AuthReasonUnavailable, consts.AuthReasonInvalidCSRFToken:
+		// The credentials may be fine, so there is nothing to challenge.
+		challenge = ""
+	case consts.AuthReasonMissingToken:
+		// RFC 6750 says no error code should be given when the request had no credentials.
+	case consts.AuthReasonInvalidRequest:
+		challenge += fmt.Sprintf(", error=%q, error_description=%q", "invalid_request", message)
+	case consts.AuthReasonNoRoles:
+		challenge += fmt.Sprintf(", error=%q, error_description=%q", "insufficient_scope", message)
+	default:
+		challenge += fmt.Sprintf(", error=%q, error_description=%q", "invalid_token", message)
+	}
+
+	if challenge != "" {
+		ctx.Set(fiber.HeaderWWWAuthenticate, challenge)
+	}
+
+	return ctx.Status(status).JSON(ErrorResponse{ErrorMessage: message, Reason: &reason})
+}
+
+// admitted reports whether the account can sign in, because it belongs to one of the hosted domains, is on the
+// allow-list, or has been invited. Signing in with an invitation accepts it.
+func (a *JWTAuthenticator) admitted(ctx context.Context, identity Identity) (bool, error) {
+	a.mu.RLock()
+	hostedDomains, allowList := a.hostedDomains, a.allowList
+	a.mu.RUnlock()
+
+	// Personal accounts, such as Gmail, have no hosted domain.
+	if identity.HostedDomain != "" && slices.Contains(hostedDomains, identity.HostedDomain) {
+		return true, nil
+	}
+
+	if slices.ContainsFunc(allowList, func(allowed string) bool { return strings.EqualFold(allowed, identity.Email) }) {
+		return true, nil
+	}
+
+	err := a.admins.AcceptInvitation(ctx, identity.Email, time.Now())
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
 }
@@ -0,0 +1,461 @@
+package rest_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/oidc"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	mock_rest "github.com/girlguidingstaplehurst/district/internal/rest/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newAuthenticatedApp returns an app that replies with the signed in admin's email.
+func newAuthenticatedApp(t *testing.T) (*fiber.App, *mock_rest.MockTokenVerifier, *mock_rest.MockAdminDirectory) {
+	return newAuthenticatedAppWithDenylist(t, rest.NewDenylist(nil))
+}
+
+func newAuthenticatedAppWithDenylist(t *testing.T, denylist *rest.Denylist) (*fiber.App, *mock_rest.MockTokenVerifier, *mock_rest.MockAdminDirectory) {
+	ctrl := gomock.NewController(t)
+	verifier := mock_rest.NewMockTokenVerifier(ctrl)
+	admins := mock_rest.NewMockAdminDirectory(ctrl)
+
+	auth := rest.NewJWTAuthenticator(verifier, admins, denylist, testSessions, []string{"staplehurstguiding.org.uk"}, []string{"Allowed@Gmail.com"})
+
+	app := fiber.New()
+	app.Use(auth.Validate)
+	reply := func(c *fiber.Ctx) error {
+		email, _ := rest.UserEmailFromContext(c.UserContext())
+		return c.SendString(email)
+	}
+	app.Get("/", reply)
+	app.Post("/", reply)
+
+	return app, verifier, admins
+}
+
+func authRequest(token string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+// assertAuthError checks the response says why the request couldn't be authenticated.
+func assertAuthError(t *testing.T, resp *http.Response, status int, reason string) {
+	t.Helper()
+
+	assert.Equal(t, status, resp.StatusCode)
+
+	var body rest.ErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.NotNil(t, body.Reason)
+	assert.Equal(t, reason, *body.Reason)
+}
+
+func TestJWTAuthenticator_Validate(t *testing.T) {
+	leader := []rest.RoleAssignment{role(rest.UnitLeader, "1st-brownies")}
+
+	t.Run("hosted domain accounts are let in", func(t *testing.T) {
+		app, verifier, admins := newAuthenticatedApp(t)
+
+		verifier.EXPECT().Verify(gomock.Any(), "token").
+			Return(rest.Identity{Email: "leader@staplehurstguiding.org.uk", HostedDomain: "staplehurstguiding.org.uk"}, nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "leader@staplehurstguiding.org.uk").Return(leader, nil)
+
+		resp, err := app.Test(authRequest("token"))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("allow-listed personal accounts are let in", func(t *testing.T) {
+		app, verifier, admins := newAuthenticatedApp(t)
+
+		verifier.EXPECT().Verify(gomock.Any(), "token").Return(rest.Identity{Email: "allowed@gmail.com"}, nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "allowed@gmail.com").Return(leader, nil)
+
+		resp, err := app.Test(authRequest("token"))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("invited accounts are let in", func(t *testing.T) {
+		app, verifier, admins := newAuthenticatedApp(t)
+
+		verifier.EXPECT().Verify(gomock.Any(), "token").Return(rest.Identity{Email: "invited@outlook.com"}, nil)
+		admins.EXPECT().AcceptInvitation(gomock.Any(), "invited@outlook.com", gomock.Any()).Return(nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "invited@outlook.com").Return(leader, nil)
+
+		resp, err := app.Test(authRequest("token"))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("other accounts are not", func(t *testing.T) {
+		app, verifier, admins := newAuthenticatedApp(t)
+
+		verifier.EXPECT().Verify(gomock.Any(), "token").
+			Return(rest.Identity{Email: "someone@example.com", HostedDomain: "example.com"}, nil)
+		admins.EXPECT().AcceptInvitation(gomock.Any(), "someone@example.com", gomock.Any()).Return(consts.ErrNotFound)
+
+		resp, err := app.Test(authRequest("token"))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusUnauthorized, consts.AuthReasonNotAllowed)
+		assert.Contains(t, resp.Header.Get(fiber.HeaderWWWAuthenticate), `error="invalid_token"`)
+	})
+
+	verifyErrors := []struct {
+		name   string
+		err    error
+		status int
+		reason string
+	}{
+		{"expired tokens", oidc.ErrExpired, fiber.StatusUnauthorized, consts.AuthReasonExpiredToken},
+		{"unverified emails", oidc.ErrEmailNotVerified, fiber.StatusUnauthorized, consts.AuthReasonEmailNotVerified},
+		{"invalid tokens", oidc.ErrInvalidSignature, fiber.StatusUnauthorized, consts.AuthReasonInvalidToken},
+		{"unreachable issuers", errors.New("discovering issuer: connection refused"), fiber.StatusServiceUnavailable, consts.AuthReasonUnavailable},
+	}
+
+	for _, tt := range verifyErrors {
+		t.Run(tt.name+" are rejected", func(t *testing.T) {
+			app, verifier, _ := newAuthenticatedApp(t)
+
+			verifier.EXPECT().Verify(gomock.Any(), "token").Return(rest.Identity{}, tt.err)
+
+			resp, err := app.Test(authRequest("token"))
+			require.NoError(t, err)
+			assertAuthError(t, resp, tt.status, tt.reason)
+		})
+	}
+
+	t.Run("requests without a token are challenged", func(t *testing.T) {
+		app, _, _ := newAuthenticatedApp(t)
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusUnauthorized, consts.AuthReasonMissingToken)
+		assert.Equal(t, `Bearer realm="district-admin"`, resp.Header.Get(fiber.HeaderWWWAuthenticate))
+	})
+
+	t.Run("only one Authorization header can be given", func(t *testing.T) {
+		app, _, _ := newAuthenticatedApp(t)
+
+		req := authRequest("token")
+		req.Header.Add("Authorization", "Bearer other")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusBadRequest, consts.AuthReasonInvalidRequest)
+	})
+
+	t.Run("other schemes are rejected", func(t *testing.T) {
+		app, _, _ := newAuthenticatedApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusBadRequest, consts.AuthReasonInvalidRequest)
+	})
+
+	t.Run("the scheme isn't case sensitive", func(t *testing.T) {
+		app, verifier, admins := newAuthenticatedApp(t)
+
+		verifier.EXPECT().Verify(gomock.Any(), "token").
+			Return(rest.Identity{Email: "leader@staplehurstguiding.org.uk", HostedDomain: "staplehurstguiding.org.uk"}, nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "leader@staplehurstguiding.org.uk").Return(leader, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "bearer token")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("accounts need a role", func(t *testing.T) {
+		app, verifier, admins := newAuthenticatedApp(t)
+
+		verifier.EXPECT().Verify(gomock.Any(), "token").
+			Return(rest.Identity{Email: "new@staplehurstguiding.org.uk", HostedDomain: "staplehurstguiding.org.uk"}, nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "new@staplehurstguiding.org.uk").Return(nil, nil)
+
+		resp, err := app.Test(authRequest("token"))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusForbidden, consts.AuthReasonNoRoles)
+		assert.Contains(t, resp.Header.Get(fiber.HeaderWWWAuthenticate), `error="insufficient_scope"`)
+	})
+
+	t.Run("the principal is available to handlers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		verifier := mock_rest.NewMockTokenVerifier(ctrl)
+		admins := mock_rest.NewMockAdminDirectory(ctrl)
+		expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+
+		verifier.EXPECT().Verify(gomock.Any(), "token").Return(rest.Identity{
+			Email:        "leader@staplehurstguiding.org.uk",
+			Name:         "Lucy Leader",
+			HostedDomain: "staplehurstguiding.org.uk",
+			Expiry:       expiry,
+		}, nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "leader@staplehurstguiding.org.uk").Return(leader, nil)
+
+		var got rest.Principal
+		app := fiber.New()
+		app.Use(rest.NewJWTAuthenticator(verifier, admins, rest.NewDenylist(nil), testSessions, []string{"staplehurstguiding.org.uk"}, nil).Validate)
+		app.Get("/", func(c *fiber.Ctx) error {
+			got, _ = rest.PrincipalFromContext(c.UserContext())
+			return nil
+		})
+
+		_, err := app.Test(authRequest("token"))
+		require.NoError(t, err)
+		assert.Equal(t, rest.Principal{
+			Email:        "leader@staplehurstguiding.org.uk",
+			Name:         "Lucy Leader",
+			HostedDomain: "staplehurstguiding.org.uk",
+			Roles:        leader,
+			Expiry:       expiry,
+		}, got)
+	})
+}
+
+func sessionRequest(method, token, csrfToken string) *http.Request {
+	req := httptest.NewRequest(method, "/", nil)
+	req.AddCookie(&http.Cookie{Name: rest.SessionCookie, Value: token})
+	if csrfToken != "" {
+		req.Header.Set(rest.CSRFHeader, csrfToken)
+	}
+	return req
+}
+
+func TestJWTAuthenticator_Sessions(t *testing.T) {
+	leader := []rest.RoleAssignment{role(rest.UnitLeader, "1st-brownies")}
+	tokenHash := sha256.Sum256([]byte("session-token"))
+
+	session := func(lastSeen time.Duration) rest.Session {
+		now := time.Now()
+		return rest.Session{
+			ID:         uuid.New(),
+			TokenHash:  tokenHash[:],
+			Email:      "leader@staplehurstguiding.org.uk",
+			CSRFToken:  "csrf-token",
+			CreatedAt:  now.Add(-time.Hour),
+			LastSeenAt: now.Add(-lastSeen),
+			ExpiresAt:  now.Add(testSessions.Idle - lastSeen),
+		}
+	}
+
+	t.Run("sessions are let in", func(t *testing.T) {
+		app, _, admins := newAuthenticatedApp(t)
+
+		admins.EXPECT().GetSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(session(time.Second), nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "leader@staplehurstguiding.org.uk").Return(leader, nil)
+
+		resp, err := app.Test(sessionRequest(http.MethodGet, "session-token", ""))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("sessions are extended as they're used", func(t *testing.T) {
+		app, _, admins := newAuthenticatedApp(t)
+		s := session(10 * time.Minute)
+
+		admins.EXPECT().GetSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(s, nil)
+		admins.EXPECT().TouchSession(gomock.Any(), s.ID, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, now, expiresAt time.Time) error {
+				assert.Equal(t, now.Add(testSessions.Idle), expiresAt)
+				return nil
+			})
+		admins.EXPECT().ListUserRoles(gomock.Any(), "leader@staplehurstguiding.org.uk").Return(leader, nil)
+
+		resp, err := app.Test(sessionRequest(http.MethodGet, "session-token", ""))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("sessions aren't extended past their lifetime", func(t *testing.T) {
+		app, _, admins := newAuthenticatedApp(t)
+		s := session(10 * time.Minute)
+		s.CreatedAt = time.Now().Add(-23 * time.Hour)
+
+		admins.EXPECT().GetSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(s, nil)
+		admins.EXPECT().TouchSession(gomock.Any(), s.ID, gomock.Any(), s.CreatedAt.Add(testSessions.Lifetime)).Return(nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "leader@staplehurstguiding.org.uk").Return(leader, nil)
+
+		resp, err := app.Test(sessionRequest(http.MethodGet, "session-token", ""))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("ended sessions are rejected", func(t *testing.T) {
+		app, _, admins := newAuthenticatedApp(t)
+
+		admins.EXPECT().GetSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(rest.Session{}, consts.ErrNotFound)
+
+		resp, err := app.Test(sessionRequest(http.MethodGet, "session-token", ""))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusUnauthorized, consts.AuthReasonSessionExpired)
+		assert.Contains(t, resp.Header.Get(fiber.HeaderSetCookie), rest.SessionCookie+"=;")
+	})
+
+	t.Run("changes need the CSRF token", func(t *testing.T) {
+		app, _, admins := newAuthenticatedApp(t)
+
+		admins.EXPECT().GetSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(session(time.Second), nil).Times(2)
+
+		resp, err := app.Test(sessionRequest(http.MethodPost, "session-token", ""))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusForbidden, consts.AuthReasonInvalidCSRFToken)
+
+		resp, err = app.Test(sessionRequest(http.MethodPost, "session-token", "wrong-token"))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusForbidden, consts.AuthReasonInvalidCSRFToken)
+	})
+
+	t.Run("changes with the CSRF token are let in", func(t *testing.T) {
+		app, _, admins := newAuthenticatedApp(t)
+
+		admins.EXPECT().GetSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(session(time.Second), nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "leader@staplehurstguiding.org.uk").Return(leader, nil)
+
+		resp, err := app.Test(sessionRequest(http.MethodPost, "session-token", "csrf-token"))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("bearer tokens take precedence over the cookie", func(t *testing.T) {
+		app, verifier, admins := newAuthenticatedApp(t)
+
+		verifier.EXPECT().Verify(gomock.Any(), "token").
+			Return(rest.Identity{Email: "leader@staplehurstguiding.org.uk", HostedDomain: "staplehurstguiding.org.uk"}, nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "leader@staplehurstguiding.org.uk").Return(leader, nil)
+
+		req := authRequest("token")
+		req.Method = http.MethodPost
+		req.AddCookie(&http.Cookie{Name: rest.SessionCookie, Value: "session-token"})
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestJWTAuthenticator_Revocations(t *testing.T) {
+	revokedSession := uuid.New()
+	tokenHash := sha256.Sum256([]byte("session-token"))
+
+	ctrl := gomock.NewController(t)
+	loader := mock_rest.NewMockRevocationLoader(ctrl)
+	loader.EXPECT().ListRevocations(gomock.Any()).Return([]rest.Revocation{{Email: "Leaver@staplehurstguiding.org.uk"}}, nil)
+	loader.EXPECT().ListRevokedSessions(gomock.Any(), gomock.Any()).Return([]uuid.UUID{revokedSession}, nil)
+
+	denylist := rest.NewDenylist(loader)
+	require.NoError(t, denylist.Refresh(context.Background()))
+
+	t.Run("revoked admins are turned away", func(t *testing.T) {
+		app, verifier, _ := newAuthenticatedAppWithDenylist(t, denylist)
+
+		verifier.EXPECT().Verify(gomock.Any(), "token").
+			Return(rest.Identity{Email: "leaver@staplehurstguiding.org.uk", HostedDomain: "staplehurstguiding.org.uk"}, nil)
+
+		resp, err := app.Test(authRequest("token"))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusUnauthorized, consts.AuthReasonRevoked)
+	})
+
+	t.Run("revoked sessions are turned away", func(t *testing.T) {
+		app, _, admins := newAuthenticatedAppWithDenylist(t, denylist)
+
+		admins.EXPECT().GetSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(rest.Session{
+			ID:         revokedSession,
+			Email:      "leader@staplehurstguiding.org.uk",
+			CreatedAt:  time.Now(),
+			LastSeenAt: time.Now(),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}, nil)
+
+		resp, err := app.Test(sessionRequest(http.MethodGet, "session-token", ""))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusUnauthorized, consts.AuthReasonRevoked)
+		assert.Contains(t, resp.Header.Get(fiber.HeaderSetCookie), rest.SessionCookie+"=;")
+	})
+}
+
+func TestJWTAuthenticator_APIKeys(t *testing.T) {
+	treasurer := []rest.RoleAssignment{role(rest.Treasurer, "")}
+	keyHash := sha256.Sum256([]byte("dk_key"))
+
+	apiKey := rest.APIKey{
+		Id:        uuid.New(),
+		Name:      "Reports",
+		Owner:     "treasurer@staplehurstguiding.org.uk",
+		Scopes:    []rest.APIKeyScope{rest.Read},
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	t.Run("keys act as their owner, limited to their scopes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		admins := mock_rest.NewMockAdminDirectory(ctrl)
+
+		admins.EXPECT().FindAPIKey(gomock.Any(), keyHash[:], gomock.Any()).Return(apiKey, nil)
+		admins.EXPECT().TouchAPIKey(gomock.Any(), apiKey.Id, gomock.Any(), "10.0.0.1").Return(nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "treasurer@staplehurstguiding.org.uk").Return(treasurer, nil)
+
+		var got rest.Principal
+		app := fiber.New()
+		app.Use(func(c *fiber.Ctx) error {
+			c.SetUserContext(context.WithValue(c.UserContext(), rest.UserIPKey{}, "10.0.0.1"))
+			return c.Next()
+		})
+		app.Use(rest.NewJWTAuthenticator(nil, admins, rest.NewDenylist(nil), testSessions, nil, nil).Validate)
+		app.Get("/", func(c *fiber.Ctx) error {
+			got, _ = rest.PrincipalFromContext(c.UserContext())
+			return nil
+		})
+
+		resp, err := app.Test(authRequest("dk_key"))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, "treasurer@staplehurstguiding.org.uk", got.Email)
+		assert.Equal(t, apiKey.Id, got.APIKeyID)
+		assert.Equal(t, []rest.Permission{rest.PermissionRead}, got.Scopes)
+	})
+
+	t.Run("recent use from the same IP isn't recorded again", func(t *testing.T) {
+		app, _, admins := newAuthenticatedApp(t)
+
+		used := apiKey
+		// newAuthenticatedApp has no IPExtractor, so the IP is empty.
+		lastUsed, ip := time.Now().Add(-time.Second), ""
+		used.LastUsedAt, used.LastUsedIP = &lastUsed, &ip
+
+		admins.EXPECT().FindAPIKey(gomock.Any(), keyHash[:], gomock.Any()).Return(used, nil)
+		admins.EXPECT().ListUserRoles(gomock.Any(), "treasurer@staplehurstguiding.org.uk").Return(treasurer, nil)
+
+		resp, err := app.Test(authRequest("dk_key"))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("unknown or expired keys are rejected", func(t *testing.T) {
+		app, _, admins := newAuthenticatedApp(t)
+
+		admins.EXPECT().FindAPIKey(gomock.Any(), keyHash[:], gomock.Any()).Return(rest.APIKey{}, consts.ErrNotFound)
+
+		resp, err := app.Test(authRequest("dk_key"))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusUnauthorized, consts.AuthReasonInvalidToken)
+	})
+}
@@ -0,0 +1,436 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+var errNotUnitLeader = errors.New("only leaders for the member's unit can see their sensitive details")
+
+func (s *Server) AdminListUnitMembers(ctx context.Context, request AdminListUnitMembersRequestObject) (AdminListUnitMembersResponseObject, error) {
+	if !allowed(ctx, PermissionRead, &request.UnitID) {
+		return AdminListUnitMembers403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	_, err := s.db.GetUnit(ctx, request.UnitID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminListUnitMembers404JSONResponse{ErrorMessage: "unit not found"}, nil
+	case err != nil:
+		slog.Error("failed to get unit", "err", err)
+		return AdminListUnitMembers500JSONResponse{ErrorMessage: "failed to list members"}, nil
+	}
+
+	members, err := s.db.ListMembers(ctx, &request.UnitID)
+	if err != nil {
+		slog.Error("failed to list members", "err", err)
+		return AdminListUnitMembers500JSONResponse{ErrorMessage: "failed to list members"}, nil
+	}
+
+	if members == nil {
+		members = []Member{}
+	}
+
+	return AdminListUnitMembers200JSONResponse{Members: members}, nil
+}
+
+func (s *Server) AdminCreateMember(ctx context.Context, request AdminCreateMemberRequestObject) (AdminCreateMemberResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, &request.UnitID) {
+		return AdminCreateMember403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	if err := validateMember(request.Body); err != nil {
+		return AdminCreateMember422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	_, err := s.db.GetUnit(ctx, request.UnitID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminCreateMember404JSONResponse{ErrorMessage: "unit not found"}, nil
+	case err != nil:
+		slog.Error("failed to get unit", "err", err)
+		return AdminCreateMember500JSONResponse{ErrorMessage: "failed to add member"}, nil
+	}
+
+	member, err := s.db.CreateMember(ctx, request.UnitID, *request.Body)
+	if err != nil {
+		slog.Error("failed to create member", "err", err)
+		return AdminCreateMember500JSONResponse{ErrorMessage: "failed to add member"}, nil
+	}
+
+	audit(ctx, "member.create", "member", member.Id.String(), nil, member)
+
+	return AdminCreateMember201JSONResponse(member), nil
+}
+
+func (s *Server) AdminGetMember(ctx context.Context, request AdminGetMemberRequestObject) (AdminGetMemberResponseObject, error) {
+	member, err := s.db.GetMember(ctx, request.MemberID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminGetMember404JSONResponse{ErrorMessage: "member not found"}, nil
+	case err != nil:
+		slog.Error("failed to get member", "err", err)
+		return AdminGetMember500JSONResponse{ErrorMessage: "failed to get member"}, nil
+	}
+
+	if !allowed(ctx, PermissionRead, &member.Unit) {
+		return AdminGetMember403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	return AdminGetMember200JSONResponse(member), nil
+}
+
+func (s *Server) AdminUpdateMember(ctx context.Context, request AdminUpdateMemberRequestObject) (AdminUpdateMemberResponseObject, error) {
+	if err := validateMember(request.Body); err != nil {
+		return AdminUpdateMember422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	member, err := s.db.GetMember(ctx, request.MemberID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminUpdateMember404JSONResponse{ErrorMessage: "member not found"}, nil
+	case err != nil:
+		slog.Error("failed to get member", "err", err)
+		return AdminUpdateMember500JSONResponse{ErrorMessage: "failed to update member"}, nil
+	}
+
+	if !allowed(ctx, PermissionWrite, &member.Unit) {
+		return AdminUpdateMember403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	before := member
+
+	member, err = s.db.UpdateMember(ctx, request.MemberID, *request.Body)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminUpdateMember404JSONResponse{ErrorMessage: "member not found"}, nil
+	case err != nil:
+		slog.Error("failed to update member", "err", err)
+		return AdminUpdateMember500JSONResponse{ErrorMessage: "failed to update member"}, nil
+	}
+
+	audit(ctx, "member.update", "member", member.Id.String(), before, member)
+
+	return AdminUpdateMember200JSONResponse(member), nil
+}
+
+func (s *Server) AdminGetMemberSensitive(ctx context.Context, request AdminGetMemberSensitiveRequestObject) (AdminGetMemberSensitiveResponseObject, error) {
+	member, err := s.db.GetMember(ctx, request.MemberID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminGetMemberSensitive404JSONResponse{ErrorMessage: "member not found"}, nil
+	case err != nil:
+		slog.Error("failed to get member", "err", err)
+		return AdminGetMemberSensitive500JSONResponse{ErrorMessage: "failed to get member"}, nil
+	}
+
+	if !allowed(ctx, PermissionSensitive, &member.Unit) {
+		return AdminGetMemberSensitive403JSONResponse{ErrorMessage: errNotUnitLeader.Error()}, nil
+	}
+
+	data, err := s.db.GetMemberSensitive(ctx, member.Id)
+	if err != nil {
+		slog.Error("failed to get member sensitive details", "err", err)
+		return AdminGetMemberSensitive500JSONResponse{ErrorMessage: "failed to get member"}, nil
+	}
+
+	// The read is recorded before anything is decrypted, so that nothing is shown without a record of it.
+	email, _ := UserEmailFromContext(ctx)
+	ip, _ := UserIPFromContext(ctx)
+	if err := s.db.AddMemberAccess(ctx, member.Id, email, ip); err != nil {
+		slog.Error("failed to record member access", "err", err)
+		return AdminGetMemberSensitive500JSONResponse{ErrorMessage: "failed to get member"}, nil
+	}
+
+	sensitive := MemberSensitive{EmergencyContacts: []EmergencyContact{}}
+	if len(data.Ciphertext) > 0 {
+		plaintext, err := s.encrypter.Decrypt(data.KeyID, data.Ciphertext, []byte(member.Id.String()))
+		if err != nil {
+			slog.Error("failed to decrypt member sensitive details", "err", err, "member", member.Id, "keyID", data.KeyID)
+			return AdminGetMemberSensitive500JSONResponse{ErrorMessage: "failed to get member"}, nil
+		}
+
+		if err := json.Unmarshal(plaintext, &sensitive); err != nil {
+			slog.Error("failed to unmarshal member sensitive details", "err", err, "member", member.Id)
+			return AdminGetMemberSensitive500JSONResponse{ErrorMessage: "failed to get member"}, nil
+		}
+	}
+
+	// The details themselves are kept out of the audit log, which only says who saw them.
+	audit(ctx, "member.sensitive.read", "member", member.Id.String(), nil, nil)
+
+	return AdminGetMemberSensitive200JSONResponse(sensitive), nil
+}
+
+func (s *Server) AdminSetMemberSensitive(ctx context.Context, request AdminSetMemberSensitiveRequestObject) (AdminSetMemberSensitiveResponseObject, error) {
+	if err := validateMemberSensitive(request.Body); err != nil {
+		return AdminSetMemberSensitive422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	member, err := s.db.GetMember(ctx, request.MemberID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminSetMemberSensitive404JSONResponse{ErrorMessage: "member not found"}, nil
+	case err != nil:
+		slog.Error("failed to get member", "err", err)
+		return AdminSetMemberSensitive500JSONResponse{ErrorMessage: "failed to update member"}, nil
+	}
+
+	if !allowed(ctx, PermissionSensitive, &member.Unit) {
+		return AdminSetMemberSensitive403JSONResponse{ErrorMessage: errNotUnitLeader.Error()}, nil
+	}
+
+	plaintext, err := json.Marshal(request.Body)
+	if err != nil {
+		slog.Error("failed to marshal member sensitive details", "err", err)
+		return AdminSetMemberSensitive500JSONResponse{ErrorMessage: "failed to update member"}, nil
+	}
+
+	// The member ID is bound to the ciphertext, so it can't be copied to another member's record.
+	keyID, ciphertext, err := s.encrypter.Encrypt(plaintext, []byte(member.Id.String()))
+	if err != nil {
+		slog.Error("failed to encrypt member sensitive details", "err", err)
+		return AdminSetMemberSensitive500JSONResponse{ErrorMessage: "failed to update member"}, nil
+	}
+
+	err = s.db.SetMemberSensitive(ctx, member.Id, EncryptedData{KeyID: keyID, Ciphertext: ciphertext})
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminSetMemberSensitive404JSONResponse{ErrorMessage: "member not found"}, nil
+	case err != nil:
+		slog.Error("failed to set member sensitive details", "err", err)
+		return AdminSetMemberSensitive500JSONResponse{ErrorMessage: "failed to update member"}, nil
+	}
+
+	audit(ctx, "member.sensitive.update", "member", member.Id.String(), nil, nil)
+
+	return AdminSetMemberSensitive204Response{}, nil
+}
+
+func (s *Server) AdminGetMemberAccessLog(ctx context.Context, request AdminGetMemberAccessLogRequestObject) (AdminGetMemberAccessLogResponseObject, error) {
+	member, err := s.db.GetMember(ctx, request.MemberID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminGetMemberAccessLog404JSONResponse{ErrorMessage: "member not found"}, nil
+	case err != nil:
+		slog.Error("failed to get member", "err", err)
+		return AdminGetMemberAccessLog500JSONResponse{ErrorMessage: "failed to get access log"}, nil
+	}
+
+	if !allowed(ctx, PermissionSensitive, &member.Unit) {
+		return AdminGetMemberAccessLog403JSONResponse{ErrorMessage: errNotUnitLeader.Error()}, nil
+	}
+
+	accesses, err := s.db.ListMemberAccess(ctx, member.Id)
+	if err != nil {
+		slog.Error("failed to list member access", "err", err)
+		return AdminGetMemberAccessLog500JSONResponse{ErrorMessage: "failed to get access log"}, nil
+	}
+
+	if accesses == nil {
+		accesses = []MemberAccess{}
+	}
+
+	return AdminGetMemberAccessLog200JSONResponse{Accesses: accesses}, nil
+}
+
+func (s *Server) AdminTransferMember(ctx context.Context, request AdminTransferMemberRequestObject) (AdminTransferMemberResponseObject, error) {
+	member, err := s.db.GetMember(ctx, request.MemberID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminTransferMember404JSONResponse{ErrorMessage: "member not found"}, nil
+	case err != nil:
+		slog.Error("failed to get member", "err", err)
+		return AdminTransferMember500JSONResponse{ErrorMessage: "failed to transfer member"}, nil
+	}
+
+	// Only the member's own unit starts a transfer, such as a Rainbow leader moving a girl up to Brownies. The new unit
+	// doesn't need to agree: a full unit is turned away, and its leaders are told about the girl joining.
+	if !allowed(ctx, PermissionWrite, &member.Unit) {
+		return AdminTransferMember403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	if member.Unit == request.Body.ToUnit {
+		return AdminTransferMember422JSONResponse{ErrorMessage: "the member is already in this unit"}, nil
+	}
+
+	units, err := s.db.ListAdminUnits(ctx)
+	if err != nil {
+		slog.Error("failed to list units", "err", err)
+		return AdminTransferMember500JSONResponse{ErrorMessage: "failed to transfer member"}, nil
+	}
+
+	from, _ := findUnit(units, member.Unit)
+	to, ok := findUnit(units, request.Body.ToUnit)
+	if !ok {
+		return AdminTransferMember404JSONResponse{ErrorMessage: "unit not found"}, nil
+	}
+
+	// Girls can move up at the start of the term in which they become old enough, rather than on their birthday.
+	now := time.Now()
+	dob := member.DateOfBirth.Time
+	if !slices.Contains(eligibleSections(dob, now), to.Section) &&
+		!slices.Contains(eligibleSections(dob, termOf(now).End.AddDate(0, 0, -1)), to.Section) {
+		return AdminTransferMember422JSONResponse{ErrorMessage: "the member is not the right age for this unit"}, nil
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+	before := member
+
+	member, err = s.db.TransferMember(ctx, member.Id, to.Id, email)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminTransferMember404JSONResponse{ErrorMessage: "member not found"}, nil
+	case errors.Is(err, consts.ErrConflict):
+		return AdminTransferMember409JSONResponse{ErrorMessage: "the unit is full"}, nil
+	case err != nil:
+		slog.Error("failed to transfer member", "err", err)
+		return AdminTransferMember500JSONResponse{ErrorMessage: "failed to transfer member"}, nil
+	}
+
+	audit(ctx, "member.transfer", "member", member.Id.String(), before, member)
+
+	vars := map[string]any{
+		"MemberName":    member.Name,
+		"FromUnit":      from.Name,
+		"ToUnit":        to.Name,
+		"TransferredBy": email,
+	}
+
+	for _, unit := range []AdminUnit{from, to} {
+		if unit.LeaderEmail == nil {
+			continue
+		}
+
+		if err := s.sendEmail(ctx, string(*unit.LeaderEmail), "member-transferred", vars); err != nil {
+			slog.Error("failed to send member transfer email", "err", err, "member", member.Id, "unit", unit.Id)
+		}
+	}
+
+	return AdminTransferMember200JSONResponse(member), nil
+}
+
+func (s *Server) AdminGetMoveUpReport(ctx context.Context, request AdminGetMoveUpReportRequestObject) (AdminGetMoveUpReportResponseObject, error) {
+	t := termOf(time.Now())
+	if request.Params.Term != nil {
+		var err error
+		if t, err = parseTerm(*request.Params.Term); err != nil {
+			return AdminGetMoveUpReport422JSONResponse{ErrorMessage: err.Error()}, nil
+		}
+	}
+
+	members, err := s.db.ListMembers(ctx, nil)
+	if err != nil {
+		slog.Error("failed to list members", "err", err)
+		return AdminGetMoveUpReport500JSONResponse{ErrorMessage: "failed to generate move-up report"}, nil
+	}
+
+	units, err := s.db.ListAdminUnits(ctx)
+	if err != nil {
+		slog.Error("failed to list units", "err", err)
+		return AdminGetMoveUpReport500JSONResponse{ErrorMessage: "failed to generate move-up report"}, nil
+	}
+
+	// Admins only see the girls in the units they can see, but the report still lists every unit they might move to.
+	p, _ := PrincipalFromContext(ctx)
+	members = slices.DeleteFunc(members, func(m Member) bool {
+		return !p.Allows(PermissionRead, &m.Unit)
+	})
+
+	moveUps := moveUpsDuring(members, units, t)
+
+	report := AdminGetMoveUpReport200JSONResponse{
+		Term:    t.Name,
+		Start:   openapi_types.Date{Time: t.Start},
+		End:     openapi_types.Date{Time: t.End.AddDate(0, 0, -1)},
+		MoveUps: moveUps,
+		Units:   []AdminUnit{},
+	}
+
+	for _, unit := range units {
+		if slices.ContainsFunc(moveUps, func(m MoveUp) bool { return m.ToSection == unit.Section }) {
+			report.Units = append(report.Units, unit)
+		}
+	}
+
+	return report, nil
+}
+
+// moveUpsDuring returns the members who are old enough for the next section by the end of the term, including any
+// who became old enough earlier but haven't moved up yet, soonest first.
+func moveUpsDuring(members []Member, units []AdminUnit, t term) []MoveUp {
+	moveUps := []MoveUp{}
+	for _, member := range members {
+		unit, ok := findUnit(units, member.Unit)
+		if !ok {
+			continue
+		}
+
+		i := slices.Index(sections, string(unit.Section))
+		if i < 0 || i == len(sections)-1 {
+			continue
+		}
+
+		next := sections[i+1]
+		eligibleFrom := member.DateOfBirth.AddDate(sectionAges[next].MinAge, 0, 0)
+		if !eligibleFrom.Before(t.End) {
+			continue
+		}
+
+		moveUps = append(moveUps, MoveUp{
+			Member:       member,
+			ToSection:    Section(next),
+			EligibleFrom: openapi_types.Date{Time: eligibleFrom},
+			Overdue:      eligibleFrom.Before(t.Start),
+		})
+	}
+
+	slices.SortStableFunc(moveUps, func(a, b MoveUp) int {
+		return a.EligibleFrom.Compare(b.EligibleFrom.Time)
+	})
+
+	return moveUps
+}
+
+func validateMember(member *MemberInput) error {
+	if strings.TrimSpace(member.Name) == "" {
+		return errors.New("name must not be empty")
+	}
+
+	if member.DateOfBirth.After(time.Now()) {
+		return errors.New("date of birth must not be in the future")
+	}
+
+	if strings.TrimSpace(member.ParentName) == "" {
+		return errors.New("parent name must not be empty")
+	}
+
+	return nil
+}
+
+func validateMemberSensitive(sensitive *MemberSensitive) error {
+	for _, contact := range sensitive.EmergencyContacts {
+		if strings.TrimSpace(contact.Name) == "" || strings.TrimSpace(contact.Phone) == "" {
+			return errors.New("emergency contacts must have a name and phone number")
+		}
+	}
+
+	return nil
+}
+
+func findUnit(units []AdminUnit, id string) (AdminUnit, bool) {
+	i := slices.IndexFunc(units, func(u AdminUnit) bool { return u.Id == id })
+	if i < 0 {
+		return AdminUnit{}, false
+	}
+
+	return units[i], true
+}
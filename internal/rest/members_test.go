@@ -0,0 +1,222 @@
+package rest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func adminUnits() []rest.AdminUnit {
+	capacity, free := 24, 3
+	leader := openapi_types.Email("brownies@example.com")
+
+	return []rest.AdminUnit{
+		{Id: "2nd-rainbows", Name: "2nd Staplehurst Rainbows", Section: consts.SectionRainbows, Members: 12},
+		{Id: "1st-brownies", Name: "1st Staplehurst Brownies", Section: consts.SectionBrownies, Members: 21,
+			Capacity: &capacity, FreePlaces: &free, LeaderEmail: &leader},
+		{Id: "1st-guides", Name: "1st Staplehurst Guides", Section: consts.SectionGuides, Members: 18},
+	}
+}
+
+func member(name, unit string, dob time.Time) rest.Member {
+	return rest.Member{
+		Id:          uuid.New(),
+		Name:        name,
+		Unit:        unit,
+		DateOfBirth: openapi_types.Date{Time: dob},
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestServer_AdminGetMoveUpReport(t *testing.T) {
+	ctx := commissionerContext()
+	s, m := newTestServer(t)
+
+	m.db.EXPECT().ListMembers(ctx, nil).Return([]rest.Member{
+		member("in term", "2nd-rainbows", date(2019, time.October, 3)),
+		member("next term", "2nd-rainbows", date(2020, time.January, 1)),
+		member("overdue", "2nd-rainbows", date(2019, time.August, 31)),
+		member("ranger", "1st-guides", date(2012, time.November, 1)),
+	}, nil)
+	m.db.EXPECT().ListAdminUnits(ctx).Return(adminUnits(), nil)
+
+	resp, err := s.AdminGetMoveUpReport(ctx, rest.AdminGetMoveUpReportRequestObject{
+		Params: rest.AdminGetMoveUpReportParams{Term: ptr("2026-autumn")},
+	})
+	require.NoError(t, err)
+	require.IsType(t, rest.AdminGetMoveUpReport200JSONResponse{}, resp)
+
+	report := resp.(rest.AdminGetMoveUpReport200JSONResponse)
+	assert.Equal(t, date(2026, time.September, 1), report.Start.Time)
+	assert.Equal(t, date(2026, time.December, 31), report.End.Time)
+
+	var names []string
+	for _, moveUp := range report.MoveUps {
+		names = append(names, moveUp.Member.Name)
+	}
+	assert.Equal(t, []string{"overdue", "in term", "ranger"}, names)
+	assert.True(t, report.MoveUps[0].Overdue)
+	assert.Equal(t, rest.Section(consts.SectionRangers), report.MoveUps[2].ToSection)
+
+	require.Len(t, report.Units, 1)
+	assert.Equal(t, "1st-brownies", report.Units[0].Id)
+}
+
+func TestServer_AdminTransferMember(t *testing.T) {
+	ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "2nd-rainbows"))
+	rainbow := member("Ada", "2nd-rainbows", time.Now().AddDate(-7, 0, -1))
+
+	t.Run("the leaders of both units are told", func(t *testing.T) {
+		s, m := newTestServer(t)
+		moved := rainbow
+		moved.Unit = "1st-brownies"
+
+		m.db.EXPECT().GetMember(ctx, rainbow.Id).Return(rainbow, nil)
+		m.db.EXPECT().ListAdminUnits(ctx).Return(adminUnits(), nil)
+		m.db.EXPECT().TransferMember(ctx, rainbow.Id, "1st-brownies", "leader@staplehurstguiding.org.uk").
+			Return(moved, nil)
+		m.content.EXPECT().EmailTemplate(ctx, "member-transferred", map[string]any{
+			"MemberName":    "Ada",
+			"FromUnit":      "2nd Staplehurst Rainbows",
+			"ToUnit":        "1st Staplehurst Brownies",
+			"TransferredBy": "leader@staplehurstguiding.org.uk",
+		}).Return(rest.EmailContent{Subject: "subject", Body: "body"}, nil)
+		m.email.EXPECT().Send(ctx, "brownies@example.com", "subject", "body").Return(nil)
+
+		resp, err := s.AdminTransferMember(ctx, rest.AdminTransferMemberRequestObject{
+			MemberID: rainbow.Id,
+			Body:     &rest.MemberTransferRequest{ToUnit: "1st-brownies"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminTransferMember200JSONResponse(moved), resp)
+	})
+
+	t.Run("members can't skip a section", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().GetMember(ctx, rainbow.Id).Return(rainbow, nil)
+		m.db.EXPECT().ListAdminUnits(ctx).Return(adminUnits(), nil)
+
+		resp, err := s.AdminTransferMember(ctx, rest.AdminTransferMemberRequestObject{
+			MemberID: rainbow.Id,
+			Body:     &rest.MemberTransferRequest{ToUnit: "1st-guides"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminTransferMember422JSONResponse{ErrorMessage: "the member is not the right age for this unit"}, resp)
+	})
+
+	t.Run("full units can't take transfers", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().GetMember(ctx, rainbow.Id).Return(rainbow, nil)
+		m.db.EXPECT().ListAdminUnits(ctx).Return(adminUnits(), nil)
+		m.db.EXPECT().TransferMember(ctx, rainbow.Id, "1st-brownies", gomock.Any()).
+			Return(rest.Member{}, consts.ErrConflict)
+
+		resp, err := s.AdminTransferMember(ctx, rest.AdminTransferMemberRequestObject{
+			MemberID: rainbow.Id,
+			Body:     &rest.MemberTransferRequest{ToUnit: "1st-brownies"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminTransferMember409JSONResponse{ErrorMessage: "the unit is full"}, resp)
+	})
+
+	t.Run("leaders can't take members from other units", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := adminContext("brownies@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+
+		m.db.EXPECT().GetMember(ctx, rainbow.Id).Return(rainbow, nil)
+
+		resp, err := s.AdminTransferMember(ctx, rest.AdminTransferMemberRequestObject{
+			MemberID: rainbow.Id,
+			Body:     &rest.MemberTransferRequest{ToUnit: "1st-brownies"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminTransferMember403JSONResponse{}, resp)
+	})
+}
+
+func TestServer_AdminGetMemberSensitive(t *testing.T) {
+	ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+	ctx = context.WithValue(ctx, rest.UserIPKey{}, "192.0.2.1")
+	brownie := member("Ada", "1st-brownies", time.Now().AddDate(-8, 0, 0))
+	encrypted := rest.EncryptedData{KeyID: "2026", Ciphertext: []byte("ciphertext")}
+
+	t.Run("unit leaders can read them and the read is logged", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().GetMember(ctx, brownie.Id).Return(brownie, nil)
+		m.db.EXPECT().GetMemberSensitive(ctx, brownie.Id).Return(encrypted, nil)
+		gomock.InOrder(
+			m.db.EXPECT().AddMemberAccess(ctx, brownie.Id, "leader@staplehurstguiding.org.uk", "192.0.2.1").Return(nil),
+			m.crypt.EXPECT().Decrypt("2026", []byte("ciphertext"), []byte(brownie.Id.String())).
+				Return([]byte(`{"emergencyContacts":[{"name":"Gran","phone":"01580 000000"}],"allergies":"nuts"}`), nil),
+		)
+
+		resp, err := s.AdminGetMemberSensitive(ctx, rest.AdminGetMemberSensitiveRequestObject{MemberID: brownie.Id})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminGetMemberSensitive200JSONResponse{
+			EmergencyContacts: []rest.EmergencyContact{{Name: "Gran", Phone: "01580 000000"}},
+			Allergies:         ptr("nuts"),
+		}, resp)
+	})
+
+	t.Run("leaders of other units and treasurers can't read them", func(t *testing.T) {
+		for _, ctx := range []context.Context{
+			adminContext("guides@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-guides")),
+			adminContext("treasurer@staplehurstguiding.org.uk", role(rest.Treasurer, "")),
+		} {
+			s, m := newTestServer(t)
+
+			m.db.EXPECT().GetMember(ctx, brownie.Id).Return(brownie, nil)
+
+			resp, err := s.AdminGetMemberSensitive(ctx, rest.AdminGetMemberSensitiveRequestObject{MemberID: brownie.Id})
+			require.NoError(t, err)
+			assert.Equal(t, rest.AdminGetMemberSensitive403JSONResponse{
+				ErrorMessage: "only leaders for the member's unit can see their sensitive details",
+			}, resp)
+		}
+	})
+
+	t.Run("nothing is decrypted if the read can't be logged", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().GetMember(ctx, brownie.Id).Return(brownie, nil)
+		m.db.EXPECT().GetMemberSensitive(ctx, brownie.Id).Return(encrypted, nil)
+		m.db.EXPECT().AddMemberAccess(ctx, brownie.Id, gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		resp, err := s.AdminGetMemberSensitive(ctx, rest.AdminGetMemberSensitiveRequestObject{MemberID: brownie.Id})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminGetMemberSensitive500JSONResponse{ErrorMessage: "failed to get member"}, resp)
+	})
+}
+
+func TestServer_AdminSetMemberSensitive(t *testing.T) {
+	ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+	brownie := member("Ada", "1st-brownies", time.Now().AddDate(-8, 0, 0))
+	body := rest.MemberSensitive{
+		EmergencyContacts: []rest.EmergencyContact{{Name: "Gran", Phone: "01580 000000"}},
+		MedicalNotes:      ptr("inhaler"),
+	}
+
+	s, m := newTestServer(t)
+	m.db.EXPECT().GetMember(ctx, brownie.Id).Return(brownie, nil)
+	m.crypt.EXPECT().Encrypt(gomock.Any(), []byte(brownie.Id.String())).Return("2026", []byte("ciphertext"), nil)
+	m.db.EXPECT().SetMemberSensitive(ctx, brownie.Id, rest.EncryptedData{KeyID: "2026", Ciphertext: []byte("ciphertext")}).
+		Return(nil)
+
+	resp, err := s.AdminSetMemberSensitive(ctx, rest.AdminSetMemberSensitiveRequestObject{MemberID: brownie.Id, Body: &body})
+	require.NoError(t, err)
+	assert.Equal(t, rest.AdminSetMemberSensitive204Response{}, resp)
+}
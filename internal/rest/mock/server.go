@@ -8,3 +8,3033 @@
 
 // Package mock_rest is a generated GoMock package.
 package mock_rest
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	rest "github.com/girlguidingstaplehurst/district/internal/rest"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDatabase is a mock of Database interface.
+type MockDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDatabaseMockRecorder is the mock recorder for MockDatabase.
+type MockDatabaseMockRecorder struct {
+	mock *MockDatabase
+}
+
+// NewMockDatabase creates a new mock instance.
+func NewMockDatabase(ctrl *gomock.Controller) *MockDatabase {
+	mock := &MockDatabase{ctrl: ctrl}
+	mock.recorder = &MockDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabase) EXPECT() *MockDatabaseMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvitation mocks base method.
+func (m *MockDatabase) AcceptInvitation(ctx context.Context, email string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, email, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockDatabaseMockRecorder) AcceptInvitation(ctx, email, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockDatabase)(nil).AcceptInvitation), ctx, email, now)
+}
+
+// AddAuditEntry mocks base method.
+func (m *MockDatabase) AddAuditEntry(ctx context.Context, entry rest.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAuditEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAuditEntry indicates an expected call of AddAuditEntry.
+func (mr *MockDatabaseMockRecorder) AddAuditEntry(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuditEntry", reflect.TypeOf((*MockDatabase)(nil).AddAuditEntry), ctx, entry)
+}
+
+// AddEventSignup mocks base method.
+func (m *MockDatabase) AddEventSignup(ctx context.Context, eventID uuid.UUID, signup rest.EventSignupInput, tokenHash []byte) (rest.EventSignup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEventSignup", ctx, eventID, signup, tokenHash)
+	ret0, _ := ret[0].(rest.EventSignup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEventSignup indicates an expected call of AddEventSignup.
+func (mr *MockDatabaseMockRecorder) AddEventSignup(ctx, eventID, signup, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventSignup", reflect.TypeOf((*MockDatabase)(nil).AddEventSignup), ctx, eventID, signup, tokenHash)
+}
+
+// AddJoinRequest mocks base method.
+func (m *MockDatabase) AddJoinRequest(ctx context.Context, joinRequest rest.JoinRequestInput, eligible []rest.Section, ip string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddJoinRequest", ctx, joinRequest, eligible, ip)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddJoinRequest indicates an expected call of AddJoinRequest.
+func (mr *MockDatabaseMockRecorder) AddJoinRequest(ctx, joinRequest, eligible, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJoinRequest", reflect.TypeOf((*MockDatabase)(nil).AddJoinRequest), ctx, joinRequest, eligible, ip)
+}
+
+// AddMemberAccess mocks base method.
+func (m *MockDatabase) AddMemberAccess(ctx context.Context, memberID uuid.UUID, accessedBy, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMemberAccess", ctx, memberID, accessedBy, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMemberAccess indicates an expected call of AddMemberAccess.
+func (mr *MockDatabaseMockRecorder) AddMemberAccess(ctx, memberID, accessedBy, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMemberAccess", reflect.TypeOf((*MockDatabase)(nil).AddMemberAccess), ctx, memberID, accessedBy, ip)
+}
+
+// AddRoleAssignment mocks base method.
+func (m *MockDatabase) AddRoleAssignment(ctx context.Context, role rest.RoleAssignmentInput, createdBy string) (rest.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoleAssignment", ctx, role, createdBy)
+	ret0, _ := ret[0].(rest.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRoleAssignment indicates an expected call of AddRoleAssignment.
+func (mr *MockDatabaseMockRecorder) AddRoleAssignment(ctx, role, createdBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoleAssignment", reflect.TypeOf((*MockDatabase)(nil).AddRoleAssignment), ctx, role, createdBy)
+}
+
+// CancelEventSignup mocks base method.
+func (m *MockDatabase) CancelEventSignup(ctx context.Context, eventID, signupID uuid.UUID, tokenHash []byte) (rest.EventSignup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEventSignup", ctx, eventID, signupID, tokenHash)
+	ret0, _ := ret[0].(rest.EventSignup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelEventSignup indicates an expected call of CancelEventSignup.
+func (mr *MockDatabaseMockRecorder) CancelEventSignup(ctx, eventID, signupID, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEventSignup", reflect.TypeOf((*MockDatabase)(nil).CancelEventSignup), ctx, eventID, signupID, tokenHash)
+}
+
+// CountJoinRequestsFromIP mocks base method.
+func (m *MockDatabase) CountJoinRequestsFromIP(ctx context.Context, ip string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJoinRequestsFromIP", ctx, ip, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJoinRequestsFromIP indicates an expected call of CountJoinRequestsFromIP.
+func (mr *MockDatabaseMockRecorder) CountJoinRequestsFromIP(ctx, ip, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJoinRequestsFromIP", reflect.TypeOf((*MockDatabase)(nil).CountJoinRequestsFromIP), ctx, ip, since)
+}
+
+// CountParentLoginsFromIP mocks base method.
+func (m *MockDatabase) CountParentLoginsFromIP(ctx context.Context, ip string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountParentLoginsFromIP", ctx, ip, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountParentLoginsFromIP indicates an expected call of CountParentLoginsFromIP.
+func (mr *MockDatabaseMockRecorder) CountParentLoginsFromIP(ctx, ip, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountParentLoginsFromIP", reflect.TypeOf((*MockDatabase)(nil).CountParentLoginsFromIP), ctx, ip, since)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockDatabase) CreateAPIKey(ctx context.Context, key rest.APIKeyInput, owner string, keyHash []byte) (rest.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, key, owner, keyHash)
+	ret0, _ := ret[0].(rest.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockDatabaseMockRecorder) CreateAPIKey(ctx, key, owner, keyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockDatabase)(nil).CreateAPIKey), ctx, key, owner, keyHash)
+}
+
+// CreateEvent mocks base method.
+func (m *MockDatabase) CreateEvent(ctx context.Context, event rest.EventInput, createdBy string) (rest.AdminEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, event, createdBy)
+	ret0, _ := ret[0].(rest.AdminEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockDatabaseMockRecorder) CreateEvent(ctx, event, createdBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockDatabase)(nil).CreateEvent), ctx, event, createdBy)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockDatabase) CreateInvitation(ctx context.Context, invitation rest.InvitationInput, invitedBy string, expiresAt time.Time) (rest.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, invitation, invitedBy, expiresAt)
+	ret0, _ := ret[0].(rest.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockDatabaseMockRecorder) CreateInvitation(ctx, invitation, invitedBy, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockDatabase)(nil).CreateInvitation), ctx, invitation, invitedBy, expiresAt)
+}
+
+// CreateMember mocks base method.
+func (m *MockDatabase) CreateMember(ctx context.Context, unitID string, member rest.MemberInput) (rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMember", ctx, unitID, member)
+	ret0, _ := ret[0].(rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMember indicates an expected call of CreateMember.
+func (mr *MockDatabaseMockRecorder) CreateMember(ctx, unitID, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMember", reflect.TypeOf((*MockDatabase)(nil).CreateMember), ctx, unitID, member)
+}
+
+// CreateParentLogin mocks base method.
+func (m *MockDatabase) CreateParentLogin(ctx context.Context, email string, tokenHash []byte, ip string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateParentLogin", ctx, email, tokenHash, ip, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateParentLogin indicates an expected call of CreateParentLogin.
+func (mr *MockDatabaseMockRecorder) CreateParentLogin(ctx, email, tokenHash, ip, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateParentLogin", reflect.TypeOf((*MockDatabase)(nil).CreateParentLogin), ctx, email, tokenHash, ip, expiresAt)
+}
+
+// CreateParentSession mocks base method.
+func (m *MockDatabase) CreateParentSession(ctx context.Context, session rest.ParentSession) (rest.ParentSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateParentSession", ctx, session)
+	ret0, _ := ret[0].(rest.ParentSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateParentSession indicates an expected call of CreateParentSession.
+func (mr *MockDatabaseMockRecorder) CreateParentSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateParentSession", reflect.TypeOf((*MockDatabase)(nil).CreateParentSession), ctx, session)
+}
+
+// CreateSession mocks base method.
+func (m *MockDatabase) CreateSession(ctx context.Context, session rest.Session) (rest.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, session)
+	ret0, _ := ret[0].(rest.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockDatabaseMockRecorder) CreateSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockDatabase)(nil).CreateSession), ctx, session)
+}
+
+// DeleteAPIKey mocks base method.
+func (m *MockDatabase) DeleteAPIKey(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockDatabaseMockRecorder) DeleteAPIKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockDatabase)(nil).DeleteAPIKey), ctx, id)
+}
+
+// DeleteEvent mocks base method.
+func (m *MockDatabase) DeleteEvent(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEvent indicates an expected call of DeleteEvent.
+func (mr *MockDatabaseMockRecorder) DeleteEvent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockDatabase)(nil).DeleteEvent), ctx, id)
+}
+
+// DeleteInvitation mocks base method.
+func (m *MockDatabase) DeleteInvitation(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInvitation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInvitation indicates an expected call of DeleteInvitation.
+func (mr *MockDatabaseMockRecorder) DeleteInvitation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInvitation", reflect.TypeOf((*MockDatabase)(nil).DeleteInvitation), ctx, id)
+}
+
+// DeleteParentSession mocks base method.
+func (m *MockDatabase) DeleteParentSession(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParentSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteParentSession indicates an expected call of DeleteParentSession.
+func (mr *MockDatabaseMockRecorder) DeleteParentSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParentSession", reflect.TypeOf((*MockDatabase)(nil).DeleteParentSession), ctx, id)
+}
+
+// DeleteRevocation mocks base method.
+func (m *MockDatabase) DeleteRevocation(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRevocation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRevocation indicates an expected call of DeleteRevocation.
+func (mr *MockDatabaseMockRecorder) DeleteRevocation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRevocation", reflect.TypeOf((*MockDatabase)(nil).DeleteRevocation), ctx, id)
+}
+
+// DeleteRoleAssignment mocks base method.
+func (m *MockDatabase) DeleteRoleAssignment(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoleAssignment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoleAssignment indicates an expected call of DeleteRoleAssignment.
+func (mr *MockDatabaseMockRecorder) DeleteRoleAssignment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoleAssignment", reflect.TypeOf((*MockDatabase)(nil).DeleteRoleAssignment), ctx, id)
+}
+
+// DeleteSession mocks base method.
+func (m *MockDatabase) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockDatabaseMockRecorder) DeleteSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockDatabase)(nil).DeleteSession), ctx, id)
+}
+
+// ExpirePlaceOffers mocks base method.
+func (m *MockDatabase) ExpirePlaceOffers(ctx context.Context, now time.Time) ([]rest.PlaceOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePlaceOffers", ctx, now)
+	ret0, _ := ret[0].([]rest.PlaceOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePlaceOffers indicates an expected call of ExpirePlaceOffers.
+func (mr *MockDatabaseMockRecorder) ExpirePlaceOffers(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePlaceOffers", reflect.TypeOf((*MockDatabase)(nil).ExpirePlaceOffers), ctx, now)
+}
+
+// FindAPIKey mocks base method.
+func (m *MockDatabase) FindAPIKey(ctx context.Context, keyHash []byte, now time.Time) (rest.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAPIKey", ctx, keyHash, now)
+	ret0, _ := ret[0].(rest.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPIKey indicates an expected call of FindAPIKey.
+func (mr *MockDatabaseMockRecorder) FindAPIKey(ctx, keyHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPIKey", reflect.TypeOf((*MockDatabase)(nil).FindAPIKey), ctx, keyHash, now)
+}
+
+// GetAPIKey mocks base method.
+func (m *MockDatabase) GetAPIKey(ctx context.Context, id uuid.UUID) (rest.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", ctx, id)
+	ret0, _ := ret[0].(rest.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockDatabaseMockRecorder) GetAPIKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockDatabase)(nil).GetAPIKey), ctx, id)
+}
+
+// GetEvent mocks base method.
+func (m *MockDatabase) GetEvent(ctx context.Context, id uuid.UUID) (rest.AdminEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, id)
+	ret0, _ := ret[0].(rest.AdminEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockDatabaseMockRecorder) GetEvent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockDatabase)(nil).GetEvent), ctx, id)
+}
+
+// GetJoinRequest mocks base method.
+func (m *MockDatabase) GetJoinRequest(ctx context.Context, id uuid.UUID) (rest.JoinRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinRequest", ctx, id)
+	ret0, _ := ret[0].(rest.JoinRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinRequest indicates an expected call of GetJoinRequest.
+func (mr *MockDatabaseMockRecorder) GetJoinRequest(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinRequest", reflect.TypeOf((*MockDatabase)(nil).GetJoinRequest), ctx, id)
+}
+
+// GetMember mocks base method.
+func (m *MockDatabase) GetMember(ctx context.Context, id uuid.UUID) (rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, id)
+	ret0, _ := ret[0].(rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockDatabaseMockRecorder) GetMember(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockDatabase)(nil).GetMember), ctx, id)
+}
+
+// GetMemberSensitive mocks base method.
+func (m *MockDatabase) GetMemberSensitive(ctx context.Context, id uuid.UUID) (rest.EncryptedData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberSensitive", ctx, id)
+	ret0, _ := ret[0].(rest.EncryptedData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberSensitive indicates an expected call of GetMemberSensitive.
+func (mr *MockDatabaseMockRecorder) GetMemberSensitive(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberSensitive", reflect.TypeOf((*MockDatabase)(nil).GetMemberSensitive), ctx, id)
+}
+
+// GetParentSession mocks base method.
+func (m *MockDatabase) GetParentSession(ctx context.Context, tokenHash []byte, now time.Time) (rest.ParentSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParentSession", ctx, tokenHash, now)
+	ret0, _ := ret[0].(rest.ParentSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParentSession indicates an expected call of GetParentSession.
+func (mr *MockDatabaseMockRecorder) GetParentSession(ctx, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParentSession", reflect.TypeOf((*MockDatabase)(nil).GetParentSession), ctx, tokenHash, now)
+}
+
+// GetSession mocks base method.
+func (m *MockDatabase) GetSession(ctx context.Context, tokenHash []byte, now time.Time) (rest.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, tokenHash, now)
+	ret0, _ := ret[0].(rest.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockDatabaseMockRecorder) GetSession(ctx, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockDatabase)(nil).GetSession), ctx, tokenHash, now)
+}
+
+// GetUnit mocks base method.
+func (m *MockDatabase) GetUnit(ctx context.Context, id string) (rest.Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnit", ctx, id)
+	ret0, _ := ret[0].(rest.Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnit indicates an expected call of GetUnit.
+func (mr *MockDatabaseMockRecorder) GetUnit(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnit", reflect.TypeOf((*MockDatabase)(nil).GetUnit), ctx, id)
+}
+
+// HasFamily mocks base method.
+func (m *MockDatabase) HasFamily(ctx context.Context, email string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasFamily", ctx, email)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasFamily indicates an expected call of HasFamily.
+func (mr *MockDatabaseMockRecorder) HasFamily(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFamily", reflect.TypeOf((*MockDatabase)(nil).HasFamily), ctx, email)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockDatabase) ListAPIKeys(ctx context.Context, owner *string) ([]rest.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, owner)
+	ret0, _ := ret[0].([]rest.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockDatabaseMockRecorder) ListAPIKeys(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockDatabase)(nil).ListAPIKeys), ctx, owner)
+}
+
+// ListAdminUnits mocks base method.
+func (m *MockDatabase) ListAdminUnits(ctx context.Context) ([]rest.AdminUnit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminUnits", ctx)
+	ret0, _ := ret[0].([]rest.AdminUnit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdminUnits indicates an expected call of ListAdminUnits.
+func (mr *MockDatabaseMockRecorder) ListAdminUnits(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminUnits", reflect.TypeOf((*MockDatabase)(nil).ListAdminUnits), ctx)
+}
+
+// ListAuditEntries mocks base method.
+func (m *MockDatabase) ListAuditEntries(ctx context.Context, filter rest.AuditFilter) ([]rest.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEntries", ctx, filter)
+	ret0, _ := ret[0].([]rest.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEntries indicates an expected call of ListAuditEntries.
+func (mr *MockDatabaseMockRecorder) ListAuditEntries(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEntries", reflect.TypeOf((*MockDatabase)(nil).ListAuditEntries), ctx, filter)
+}
+
+// ListEventSignups mocks base method.
+func (m *MockDatabase) ListEventSignups(ctx context.Context, eventID uuid.UUID, statuses ...string) ([]rest.EventSignup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, eventID}
+	for _, a := range statuses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventSignups", varargs...)
+	ret0, _ := ret[0].([]rest.EventSignup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventSignups indicates an expected call of ListEventSignups.
+func (mr *MockDatabaseMockRecorder) ListEventSignups(ctx, eventID any, statuses ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, eventID}, statuses...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSignups", reflect.TypeOf((*MockDatabase)(nil).ListEventSignups), varargs...)
+}
+
+// ListEvents mocks base method.
+func (m *MockDatabase) ListEvents(ctx context.Context, filter rest.EventFilter) ([]rest.AdminEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, filter)
+	ret0, _ := ret[0].([]rest.AdminEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockDatabaseMockRecorder) ListEvents(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockDatabase)(nil).ListEvents), ctx, filter)
+}
+
+// ListFamilyMembers mocks base method.
+func (m *MockDatabase) ListFamilyMembers(ctx context.Context, email string) ([]rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFamilyMembers", ctx, email)
+	ret0, _ := ret[0].([]rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFamilyMembers indicates an expected call of ListFamilyMembers.
+func (mr *MockDatabaseMockRecorder) ListFamilyMembers(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFamilyMembers", reflect.TypeOf((*MockDatabase)(nil).ListFamilyMembers), ctx, email)
+}
+
+// ListFamilySignups mocks base method.
+func (m *MockDatabase) ListFamilySignups(ctx context.Context, email string) ([]rest.EventSignup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFamilySignups", ctx, email)
+	ret0, _ := ret[0].([]rest.EventSignup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFamilySignups indicates an expected call of ListFamilySignups.
+func (mr *MockDatabaseMockRecorder) ListFamilySignups(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFamilySignups", reflect.TypeOf((*MockDatabase)(nil).ListFamilySignups), ctx, email)
+}
+
+// ListInvitations mocks base method.
+func (m *MockDatabase) ListInvitations(ctx context.Context) ([]rest.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitations", ctx)
+	ret0, _ := ret[0].([]rest.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitations indicates an expected call of ListInvitations.
+func (mr *MockDatabaseMockRecorder) ListInvitations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockDatabase)(nil).ListInvitations), ctx)
+}
+
+// ListJoinRequestEvents mocks base method.
+func (m *MockDatabase) ListJoinRequestEvents(ctx context.Context, joinRequestID uuid.UUID) ([]rest.JoinRequestEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJoinRequestEvents", ctx, joinRequestID)
+	ret0, _ := ret[0].([]rest.JoinRequestEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJoinRequestEvents indicates an expected call of ListJoinRequestEvents.
+func (mr *MockDatabaseMockRecorder) ListJoinRequestEvents(ctx, joinRequestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJoinRequestEvents", reflect.TypeOf((*MockDatabase)(nil).ListJoinRequestEvents), ctx, joinRequestID)
+}
+
+// ListMemberAccess mocks base method.
+func (m *MockDatabase) ListMemberAccess(ctx context.Context, memberID uuid.UUID) ([]rest.MemberAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberAccess", ctx, memberID)
+	ret0, _ := ret[0].([]rest.MemberAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberAccess indicates an expected call of ListMemberAccess.
+func (mr *MockDatabaseMockRecorder) ListMemberAccess(ctx, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberAccess", reflect.TypeOf((*MockDatabase)(nil).ListMemberAccess), ctx, memberID)
+}
+
+// ListMembers mocks base method.
+func (m *MockDatabase) ListMembers(ctx context.Context, unitID *string) ([]rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, unitID)
+	ret0, _ := ret[0].([]rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockDatabaseMockRecorder) ListMembers(ctx, unitID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockDatabase)(nil).ListMembers), ctx, unitID)
+}
+
+// ListRatioRules mocks base method.
+func (m *MockDatabase) ListRatioRules(ctx context.Context) ([]rest.RatioRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRatioRules", ctx)
+	ret0, _ := ret[0].([]rest.RatioRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRatioRules indicates an expected call of ListRatioRules.
+func (mr *MockDatabaseMockRecorder) ListRatioRules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRatioRules", reflect.TypeOf((*MockDatabase)(nil).ListRatioRules), ctx)
+}
+
+// ListRevocations mocks base method.
+func (m *MockDatabase) ListRevocations(ctx context.Context) ([]rest.Revocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevocations", ctx)
+	ret0, _ := ret[0].([]rest.Revocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevocations indicates an expected call of ListRevocations.
+func (mr *MockDatabaseMockRecorder) ListRevocations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevocations", reflect.TypeOf((*MockDatabase)(nil).ListRevocations), ctx)
+}
+
+// ListRevokedSessions mocks base method.
+func (m *MockDatabase) ListRevokedSessions(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevokedSessions", ctx, now)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevokedSessions indicates an expected call of ListRevokedSessions.
+func (mr *MockDatabaseMockRecorder) ListRevokedSessions(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevokedSessions", reflect.TypeOf((*MockDatabase)(nil).ListRevokedSessions), ctx, now)
+}
+
+// ListRoleAssignments mocks base method.
+func (m *MockDatabase) ListRoleAssignments(ctx context.Context) ([]rest.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleAssignments", ctx)
+	ret0, _ := ret[0].([]rest.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoleAssignments indicates an expected call of ListRoleAssignments.
+func (mr *MockDatabaseMockRecorder) ListRoleAssignments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleAssignments", reflect.TypeOf((*MockDatabase)(nil).ListRoleAssignments), ctx)
+}
+
+// ListSessions mocks base method.
+func (m *MockDatabase) ListSessions(ctx context.Context, email *string, now time.Time) ([]rest.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, email, now)
+	ret0, _ := ret[0].([]rest.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockDatabaseMockRecorder) ListSessions(ctx, email, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockDatabase)(nil).ListSessions), ctx, email, now)
+}
+
+// ListUnits mocks base method.
+func (m *MockDatabase) ListUnits(ctx context.Context) ([]rest.Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnits", ctx)
+	ret0, _ := ret[0].([]rest.Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnits indicates an expected call of ListUnits.
+func (mr *MockDatabaseMockRecorder) ListUnits(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnits", reflect.TypeOf((*MockDatabase)(nil).ListUnits), ctx)
+}
+
+// ListUserRoles mocks base method.
+func (m *MockDatabase) ListUserRoles(ctx context.Context, email string) ([]rest.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserRoles", ctx, email)
+	ret0, _ := ret[0].([]rest.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserRoles indicates an expected call of ListUserRoles.
+func (mr *MockDatabaseMockRecorder) ListUserRoles(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRoles", reflect.TypeOf((*MockDatabase)(nil).ListUserRoles), ctx, email)
+}
+
+// ListWaitingList mocks base method.
+func (m *MockDatabase) ListWaitingList(ctx context.Context, unitID string) ([]rest.JoinRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWaitingList", ctx, unitID)
+	ret0, _ := ret[0].([]rest.JoinRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWaitingList indicates an expected call of ListWaitingList.
+func (mr *MockDatabaseMockRecorder) ListWaitingList(ctx, unitID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWaitingList", reflect.TypeOf((*MockDatabase)(nil).ListWaitingList), ctx, unitID)
+}
+
+// OfferPlace mocks base method.
+func (m *MockDatabase) OfferPlace(ctx context.Context, joinRequestID uuid.UUID, unitID string, tokenHash []byte, expiresAt time.Time, offeredBy string) (rest.PlaceOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferPlace", ctx, joinRequestID, unitID, tokenHash, expiresAt, offeredBy)
+	ret0, _ := ret[0].(rest.PlaceOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OfferPlace indicates an expected call of OfferPlace.
+func (mr *MockDatabaseMockRecorder) OfferPlace(ctx, joinRequestID, unitID, tokenHash, expiresAt, offeredBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferPlace", reflect.TypeOf((*MockDatabase)(nil).OfferPlace), ctx, joinRequestID, unitID, tokenHash, expiresAt, offeredBy)
+}
+
+// PromoteEventSignups mocks base method.
+func (m *MockDatabase) PromoteEventSignups(ctx context.Context, eventID uuid.UUID) ([]rest.EventSignup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteEventSignups", ctx, eventID)
+	ret0, _ := ret[0].([]rest.EventSignup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteEventSignups indicates an expected call of PromoteEventSignups.
+func (mr *MockDatabaseMockRecorder) PromoteEventSignups(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteEventSignups", reflect.TypeOf((*MockDatabase)(nil).PromoteEventSignups), ctx, eventID)
+}
+
+// RedeemParentLogin mocks base method.
+func (m *MockDatabase) RedeemParentLogin(ctx context.Context, tokenHash []byte, now time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemParentLogin", ctx, tokenHash, now)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemParentLogin indicates an expected call of RedeemParentLogin.
+func (mr *MockDatabaseMockRecorder) RedeemParentLogin(ctx, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemParentLogin", reflect.TypeOf((*MockDatabase)(nil).RedeemParentLogin), ctx, tokenHash, now)
+}
+
+// RespondToPlaceOffer mocks base method.
+func (m *MockDatabase) RespondToPlaceOffer(ctx context.Context, offerID uuid.UUID, tokenHash []byte, accept bool, now time.Time) (rest.PlaceOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondToPlaceOffer", ctx, offerID, tokenHash, accept, now)
+	ret0, _ := ret[0].(rest.PlaceOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RespondToPlaceOffer indicates an expected call of RespondToPlaceOffer.
+func (mr *MockDatabaseMockRecorder) RespondToPlaceOffer(ctx, offerID, tokenHash, accept, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondToPlaceOffer", reflect.TypeOf((*MockDatabase)(nil).RespondToPlaceOffer), ctx, offerID, tokenHash, accept, now)
+}
+
+// RevokeSession mocks base method.
+func (m *MockDatabase) RevokeSession(ctx context.Context, id uuid.UUID, revokedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, id, revokedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockDatabaseMockRecorder) RevokeSession(ctx, id, revokedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockDatabase)(nil).RevokeSession), ctx, id, revokedBy)
+}
+
+// RevokeUser mocks base method.
+func (m *MockDatabase) RevokeUser(ctx context.Context, revocation rest.RevocationInput, revokedBy string) (rest.Revocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUser", ctx, revocation, revokedBy)
+	ret0, _ := ret[0].(rest.Revocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeUser indicates an expected call of RevokeUser.
+func (mr *MockDatabaseMockRecorder) RevokeUser(ctx, revocation, revokedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUser", reflect.TypeOf((*MockDatabase)(nil).RevokeUser), ctx, revocation, revokedBy)
+}
+
+// SaveRatioRules mocks base method.
+func (m *MockDatabase) SaveRatioRules(ctx context.Context, rules []rest.RatioRule, updatedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRatioRules", ctx, rules, updatedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRatioRules indicates an expected call of SaveRatioRules.
+func (mr *MockDatabaseMockRecorder) SaveRatioRules(ctx, rules, updatedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRatioRules", reflect.TypeOf((*MockDatabase)(nil).SaveRatioRules), ctx, rules, updatedBy)
+}
+
+// SetMemberSensitive mocks base method.
+func (m *MockDatabase) SetMemberSensitive(ctx context.Context, id uuid.UUID, data rest.EncryptedData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemberSensitive", ctx, id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemberSensitive indicates an expected call of SetMemberSensitive.
+func (mr *MockDatabaseMockRecorder) SetMemberSensitive(ctx, id, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberSensitive", reflect.TypeOf((*MockDatabase)(nil).SetMemberSensitive), ctx, id, data)
+}
+
+// TouchAPIKey mocks base method.
+func (m *MockDatabase) TouchAPIKey(ctx context.Context, id uuid.UUID, now time.Time, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIKey", ctx, id, now, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockDatabaseMockRecorder) TouchAPIKey(ctx, id, now, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockDatabase)(nil).TouchAPIKey), ctx, id, now, ip)
+}
+
+// TouchParentSession mocks base method.
+func (m *MockDatabase) TouchParentSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchParentSession", ctx, id, now, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchParentSession indicates an expected call of TouchParentSession.
+func (mr *MockDatabaseMockRecorder) TouchParentSession(ctx, id, now, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchParentSession", reflect.TypeOf((*MockDatabase)(nil).TouchParentSession), ctx, id, now, expiresAt)
+}
+
+// TouchSession mocks base method.
+func (m *MockDatabase) TouchSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, id, now, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockDatabaseMockRecorder) TouchSession(ctx, id, now, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockDatabase)(nil).TouchSession), ctx, id, now, expiresAt)
+}
+
+// TransferMember mocks base method.
+func (m *MockDatabase) TransferMember(ctx context.Context, memberID uuid.UUID, toUnit, transferredBy string) (rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferMember", ctx, memberID, toUnit, transferredBy)
+	ret0, _ := ret[0].(rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferMember indicates an expected call of TransferMember.
+func (mr *MockDatabaseMockRecorder) TransferMember(ctx, memberID, toUnit, transferredBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferMember", reflect.TypeOf((*MockDatabase)(nil).TransferMember), ctx, memberID, toUnit, transferredBy)
+}
+
+// UpdateEvent mocks base method.
+func (m *MockDatabase) UpdateEvent(ctx context.Context, id uuid.UUID, event rest.EventInput) (rest.AdminEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvent", ctx, id, event)
+	ret0, _ := ret[0].(rest.AdminEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEvent indicates an expected call of UpdateEvent.
+func (mr *MockDatabaseMockRecorder) UpdateEvent(ctx, id, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockDatabase)(nil).UpdateEvent), ctx, id, event)
+}
+
+// UpdateMember mocks base method.
+func (m *MockDatabase) UpdateMember(ctx context.Context, id uuid.UUID, member rest.MemberInput) (rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMember", ctx, id, member)
+	ret0, _ := ret[0].(rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMember indicates an expected call of UpdateMember.
+func (mr *MockDatabaseMockRecorder) UpdateMember(ctx, id, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMember", reflect.TypeOf((*MockDatabase)(nil).UpdateMember), ctx, id, member)
+}
+
+// UpdateUnit mocks base method.
+func (m *MockDatabase) UpdateUnit(ctx context.Context, id string, settings rest.UnitSettings) (rest.AdminUnit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUnit", ctx, id, settings)
+	ret0, _ := ret[0].(rest.AdminUnit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUnit indicates an expected call of UpdateUnit.
+func (mr *MockDatabaseMockRecorder) UpdateUnit(ctx, id, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUnit", reflect.TypeOf((*MockDatabase)(nil).UpdateUnit), ctx, id, settings)
+}
+
+// MockEventRepository is a mock of EventRepository interface.
+type MockEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEventRepositoryMockRecorder is the mock recorder for MockEventRepository.
+type MockEventRepositoryMockRecorder struct {
+	mock *MockEventRepository
+}
+
+// NewMockEventRepository creates a new mock instance.
+func NewMockEventRepository(ctrl *gomock.Controller) *MockEventRepository {
+	mock := &MockEventRepository{ctrl: ctrl}
+	mock.recorder = &MockEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventRepository) EXPECT() *MockEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateEvent mocks base method.
+func (m *MockEventRepository) CreateEvent(ctx context.Context, event rest.EventInput, createdBy string) (rest.AdminEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, event, createdBy)
+	ret0, _ := ret[0].(rest.AdminEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockEventRepositoryMockRecorder) CreateEvent(ctx, event, createdBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockEventRepository)(nil).CreateEvent), ctx, event, createdBy)
+}
+
+// DeleteEvent mocks base method.
+func (m *MockEventRepository) DeleteEvent(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEvent indicates an expected call of DeleteEvent.
+func (mr *MockEventRepositoryMockRecorder) DeleteEvent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockEventRepository)(nil).DeleteEvent), ctx, id)
+}
+
+// GetEvent mocks base method.
+func (m *MockEventRepository) GetEvent(ctx context.Context, id uuid.UUID) (rest.AdminEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, id)
+	ret0, _ := ret[0].(rest.AdminEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockEventRepositoryMockRecorder) GetEvent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockEventRepository)(nil).GetEvent), ctx, id)
+}
+
+// ListEvents mocks base method.
+func (m *MockEventRepository) ListEvents(ctx context.Context, filter rest.EventFilter) ([]rest.AdminEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, filter)
+	ret0, _ := ret[0].([]rest.AdminEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockEventRepositoryMockRecorder) ListEvents(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEventRepository)(nil).ListEvents), ctx, filter)
+}
+
+// UpdateEvent mocks base method.
+func (m *MockEventRepository) UpdateEvent(ctx context.Context, id uuid.UUID, event rest.EventInput) (rest.AdminEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvent", ctx, id, event)
+	ret0, _ := ret[0].(rest.AdminEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEvent indicates an expected call of UpdateEvent.
+func (mr *MockEventRepositoryMockRecorder) UpdateEvent(ctx, id, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventRepository)(nil).UpdateEvent), ctx, id, event)
+}
+
+// MockSignupRepository is a mock of SignupRepository interface.
+type MockSignupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignupRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSignupRepositoryMockRecorder is the mock recorder for MockSignupRepository.
+type MockSignupRepositoryMockRecorder struct {
+	mock *MockSignupRepository
+}
+
+// NewMockSignupRepository creates a new mock instance.
+func NewMockSignupRepository(ctrl *gomock.Controller) *MockSignupRepository {
+	mock := &MockSignupRepository{ctrl: ctrl}
+	mock.recorder = &MockSignupRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignupRepository) EXPECT() *MockSignupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddEventSignup mocks base method.
+func (m *MockSignupRepository) AddEventSignup(ctx context.Context, eventID uuid.UUID, signup rest.EventSignupInput, tokenHash []byte) (rest.EventSignup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEventSignup", ctx, eventID, signup, tokenHash)
+	ret0, _ := ret[0].(rest.EventSignup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEventSignup indicates an expected call of AddEventSignup.
+func (mr *MockSignupRepositoryMockRecorder) AddEventSignup(ctx, eventID, signup, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventSignup", reflect.TypeOf((*MockSignupRepository)(nil).AddEventSignup), ctx, eventID, signup, tokenHash)
+}
+
+// CancelEventSignup mocks base method.
+func (m *MockSignupRepository) CancelEventSignup(ctx context.Context, eventID, signupID uuid.UUID, tokenHash []byte) (rest.EventSignup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEventSignup", ctx, eventID, signupID, tokenHash)
+	ret0, _ := ret[0].(rest.EventSignup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelEventSignup indicates an expected call of CancelEventSignup.
+func (mr *MockSignupRepositoryMockRecorder) CancelEventSignup(ctx, eventID, signupID, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEventSignup", reflect.TypeOf((*MockSignupRepository)(nil).CancelEventSignup), ctx, eventID, signupID, tokenHash)
+}
+
+// ListEventSignups mocks base method.
+func (m *MockSignupRepository) ListEventSignups(ctx context.Context, eventID uuid.UUID, statuses ...string) ([]rest.EventSignup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, eventID}
+	for _, a := range statuses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventSignups", varargs...)
+	ret0, _ := ret[0].([]rest.EventSignup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventSignups indicates an expected call of ListEventSignups.
+func (mr *MockSignupRepositoryMockRecorder) ListEventSignups(ctx, eventID any, statuses ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, eventID}, statuses...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSignups", reflect.TypeOf((*MockSignupRepository)(nil).ListEventSignups), varargs...)
+}
+
+// PromoteEventSignups mocks base method.
+func (m *MockSignupRepository) PromoteEventSignups(ctx context.Context, eventID uuid.UUID) ([]rest.EventSignup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteEventSignups", ctx, eventID)
+	ret0, _ := ret[0].([]rest.EventSignup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteEventSignups indicates an expected call of PromoteEventSignups.
+func (mr *MockSignupRepositoryMockRecorder) PromoteEventSignups(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteEventSignups", reflect.TypeOf((*MockSignupRepository)(nil).PromoteEventSignups), ctx, eventID)
+}
+
+// MockRatioRuleRepository is a mock of RatioRuleRepository interface.
+type MockRatioRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRatioRuleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRatioRuleRepositoryMockRecorder is the mock recorder for MockRatioRuleRepository.
+type MockRatioRuleRepositoryMockRecorder struct {
+	mock *MockRatioRuleRepository
+}
+
+// NewMockRatioRuleRepository creates a new mock instance.
+func NewMockRatioRuleRepository(ctrl *gomock.Controller) *MockRatioRuleRepository {
+	mock := &MockRatioRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockRatioRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRatioRuleRepository) EXPECT() *MockRatioRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListRatioRules mocks base method.
+func (m *MockRatioRuleRepository) ListRatioRules(ctx context.Context) ([]rest.RatioRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRatioRules", ctx)
+	ret0, _ := ret[0].([]rest.RatioRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRatioRules indicates an expected call of ListRatioRules.
+func (mr *MockRatioRuleRepositoryMockRecorder) ListRatioRules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRatioRules", reflect.TypeOf((*MockRatioRuleRepository)(nil).ListRatioRules), ctx)
+}
+
+// SaveRatioRules mocks base method.
+func (m *MockRatioRuleRepository) SaveRatioRules(ctx context.Context, rules []rest.RatioRule, updatedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRatioRules", ctx, rules, updatedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRatioRules indicates an expected call of SaveRatioRules.
+func (mr *MockRatioRuleRepositoryMockRecorder) SaveRatioRules(ctx, rules, updatedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRatioRules", reflect.TypeOf((*MockRatioRuleRepository)(nil).SaveRatioRules), ctx, rules, updatedBy)
+}
+
+// MockUnitRepository is a mock of UnitRepository interface.
+type MockUnitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUnitRepositoryMockRecorder is the mock recorder for MockUnitRepository.
+type MockUnitRepositoryMockRecorder struct {
+	mock *MockUnitRepository
+}
+
+// NewMockUnitRepository creates a new mock instance.
+func NewMockUnitRepository(ctrl *gomock.Controller) *MockUnitRepository {
+	mock := &MockUnitRepository{ctrl: ctrl}
+	mock.recorder = &MockUnitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnitRepository) EXPECT() *MockUnitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetUnit mocks base method.
+func (m *MockUnitRepository) GetUnit(ctx context.Context, id string) (rest.Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnit", ctx, id)
+	ret0, _ := ret[0].(rest.Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnit indicates an expected call of GetUnit.
+func (mr *MockUnitRepositoryMockRecorder) GetUnit(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnit", reflect.TypeOf((*MockUnitRepository)(nil).GetUnit), ctx, id)
+}
+
+// ListAdminUnits mocks base method.
+func (m *MockUnitRepository) ListAdminUnits(ctx context.Context) ([]rest.AdminUnit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminUnits", ctx)
+	ret0, _ := ret[0].([]rest.AdminUnit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdminUnits indicates an expected call of ListAdminUnits.
+func (mr *MockUnitRepositoryMockRecorder) ListAdminUnits(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminUnits", reflect.TypeOf((*MockUnitRepository)(nil).ListAdminUnits), ctx)
+}
+
+// ListUnits mocks base method.
+func (m *MockUnitRepository) ListUnits(ctx context.Context) ([]rest.Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnits", ctx)
+	ret0, _ := ret[0].([]rest.Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnits indicates an expected call of ListUnits.
+func (mr *MockUnitRepositoryMockRecorder) ListUnits(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnits", reflect.TypeOf((*MockUnitRepository)(nil).ListUnits), ctx)
+}
+
+// UpdateUnit mocks base method.
+func (m *MockUnitRepository) UpdateUnit(ctx context.Context, id string, settings rest.UnitSettings) (rest.AdminUnit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUnit", ctx, id, settings)
+	ret0, _ := ret[0].(rest.AdminUnit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUnit indicates an expected call of UpdateUnit.
+func (mr *MockUnitRepositoryMockRecorder) UpdateUnit(ctx, id, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUnit", reflect.TypeOf((*MockUnitRepository)(nil).UpdateUnit), ctx, id, settings)
+}
+
+// MockMemberRepository is a mock of MemberRepository interface.
+type MockMemberRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMemberRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMemberRepositoryMockRecorder is the mock recorder for MockMemberRepository.
+type MockMemberRepositoryMockRecorder struct {
+	mock *MockMemberRepository
+}
+
+// NewMockMemberRepository creates a new mock instance.
+func NewMockMemberRepository(ctrl *gomock.Controller) *MockMemberRepository {
+	mock := &MockMemberRepository{ctrl: ctrl}
+	mock.recorder = &MockMemberRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMemberRepository) EXPECT() *MockMemberRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddMemberAccess mocks base method.
+func (m *MockMemberRepository) AddMemberAccess(ctx context.Context, memberID uuid.UUID, accessedBy, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMemberAccess", ctx, memberID, accessedBy, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMemberAccess indicates an expected call of AddMemberAccess.
+func (mr *MockMemberRepositoryMockRecorder) AddMemberAccess(ctx, memberID, accessedBy, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMemberAccess", reflect.TypeOf((*MockMemberRepository)(nil).AddMemberAccess), ctx, memberID, accessedBy, ip)
+}
+
+// CreateMember mocks base method.
+func (m *MockMemberRepository) CreateMember(ctx context.Context, unitID string, member rest.MemberInput) (rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMember", ctx, unitID, member)
+	ret0, _ := ret[0].(rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMember indicates an expected call of CreateMember.
+func (mr *MockMemberRepositoryMockRecorder) CreateMember(ctx, unitID, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMember", reflect.TypeOf((*MockMemberRepository)(nil).CreateMember), ctx, unitID, member)
+}
+
+// GetMember mocks base method.
+func (m *MockMemberRepository) GetMember(ctx context.Context, id uuid.UUID) (rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, id)
+	ret0, _ := ret[0].(rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockMemberRepositoryMockRecorder) GetMember(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockMemberRepository)(nil).GetMember), ctx, id)
+}
+
+// GetMemberSensitive mocks base method.
+func (m *MockMemberRepository) GetMemberSensitive(ctx context.Context, id uuid.UUID) (rest.EncryptedData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberSensitive", ctx, id)
+	ret0, _ := ret[0].(rest.EncryptedData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberSensitive indicates an expected call of GetMemberSensitive.
+func (mr *MockMemberRepositoryMockRecorder) GetMemberSensitive(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberSensitive", reflect.TypeOf((*MockMemberRepository)(nil).GetMemberSensitive), ctx, id)
+}
+
+// ListMemberAccess mocks base method.
+func (m *MockMemberRepository) ListMemberAccess(ctx context.Context, memberID uuid.UUID) ([]rest.MemberAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberAccess", ctx, memberID)
+	ret0, _ := ret[0].([]rest.MemberAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberAccess indicates an expected call of ListMemberAccess.
+func (mr *MockMemberRepositoryMockRecorder) ListMemberAccess(ctx, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberAccess", reflect.TypeOf((*MockMemberRepository)(nil).ListMemberAccess), ctx, memberID)
+}
+
+// ListMembers mocks base method.
+func (m *MockMemberRepository) ListMembers(ctx context.Context, unitID *string) ([]rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, unitID)
+	ret0, _ := ret[0].([]rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockMemberRepositoryMockRecorder) ListMembers(ctx, unitID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockMemberRepository)(nil).ListMembers), ctx, unitID)
+}
+
+// SetMemberSensitive mocks base method.
+func (m *MockMemberRepository) SetMemberSensitive(ctx context.Context, id uuid.UUID, data rest.EncryptedData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemberSensitive", ctx, id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemberSensitive indicates an expected call of SetMemberSensitive.
+func (mr *MockMemberRepositoryMockRecorder) SetMemberSensitive(ctx, id, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberSensitive", reflect.TypeOf((*MockMemberRepository)(nil).SetMemberSensitive), ctx, id, data)
+}
+
+// TransferMember mocks base method.
+func (m *MockMemberRepository) TransferMember(ctx context.Context, memberID uuid.UUID, toUnit, transferredBy string) (rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferMember", ctx, memberID, toUnit, transferredBy)
+	ret0, _ := ret[0].(rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferMember indicates an expected call of TransferMember.
+func (mr *MockMemberRepositoryMockRecorder) TransferMember(ctx, memberID, toUnit, transferredBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferMember", reflect.TypeOf((*MockMemberRepository)(nil).TransferMember), ctx, memberID, toUnit, transferredBy)
+}
+
+// UpdateMember mocks base method.
+func (m *MockMemberRepository) UpdateMember(ctx context.Context, id uuid.UUID, member rest.MemberInput) (rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMember", ctx, id, member)
+	ret0, _ := ret[0].(rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMember indicates an expected call of UpdateMember.
+func (mr *MockMemberRepositoryMockRecorder) UpdateMember(ctx, id, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMember", reflect.TypeOf((*MockMemberRepository)(nil).UpdateMember), ctx, id, member)
+}
+
+// MockRoleRepository is a mock of RoleRepository interface.
+type MockRoleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleRepositoryMockRecorder is the mock recorder for MockRoleRepository.
+type MockRoleRepositoryMockRecorder struct {
+	mock *MockRoleRepository
+}
+
+// NewMockRoleRepository creates a new mock instance.
+func NewMockRoleRepository(ctrl *gomock.Controller) *MockRoleRepository {
+	mock := &MockRoleRepository{ctrl: ctrl}
+	mock.recorder = &MockRoleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleRepository) EXPECT() *MockRoleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddRoleAssignment mocks base method.
+func (m *MockRoleRepository) AddRoleAssignment(ctx context.Context, role rest.RoleAssignmentInput, createdBy string) (rest.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoleAssignment", ctx, role, createdBy)
+	ret0, _ := ret[0].(rest.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRoleAssignment indicates an expected call of AddRoleAssignment.
+func (mr *MockRoleRepositoryMockRecorder) AddRoleAssignment(ctx, role, createdBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoleAssignment", reflect.TypeOf((*MockRoleRepository)(nil).AddRoleAssignment), ctx, role, createdBy)
+}
+
+// DeleteRoleAssignment mocks base method.
+func (m *MockRoleRepository) DeleteRoleAssignment(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoleAssignment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoleAssignment indicates an expected call of DeleteRoleAssignment.
+func (mr *MockRoleRepositoryMockRecorder) DeleteRoleAssignment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoleAssignment", reflect.TypeOf((*MockRoleRepository)(nil).DeleteRoleAssignment), ctx, id)
+}
+
+// ListRoleAssignments mocks base method.
+func (m *MockRoleRepository) ListRoleAssignments(ctx context.Context) ([]rest.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleAssignments", ctx)
+	ret0, _ := ret[0].([]rest.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoleAssignments indicates an expected call of ListRoleAssignments.
+func (mr *MockRoleRepositoryMockRecorder) ListRoleAssignments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleAssignments", reflect.TypeOf((*MockRoleRepository)(nil).ListRoleAssignments), ctx)
+}
+
+// ListUserRoles mocks base method.
+func (m *MockRoleRepository) ListUserRoles(ctx context.Context, email string) ([]rest.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserRoles", ctx, email)
+	ret0, _ := ret[0].([]rest.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserRoles indicates an expected call of ListUserRoles.
+func (mr *MockRoleRepositoryMockRecorder) ListUserRoles(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRoles", reflect.TypeOf((*MockRoleRepository)(nil).ListUserRoles), ctx, email)
+}
+
+// MockInvitationRepository is a mock of InvitationRepository interface.
+type MockInvitationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockInvitationRepositoryMockRecorder is the mock recorder for MockInvitationRepository.
+type MockInvitationRepositoryMockRecorder struct {
+	mock *MockInvitationRepository
+}
+
+// NewMockInvitationRepository creates a new mock instance.
+func NewMockInvitationRepository(ctrl *gomock.Controller) *MockInvitationRepository {
+	mock := &MockInvitationRepository{ctrl: ctrl}
+	mock.recorder = &MockInvitationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationRepository) EXPECT() *MockInvitationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvitation mocks base method.
+func (m *MockInvitationRepository) AcceptInvitation(ctx context.Context, email string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, email, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockInvitationRepositoryMockRecorder) AcceptInvitation(ctx, email, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockInvitationRepository)(nil).AcceptInvitation), ctx, email, now)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockInvitationRepository) CreateInvitation(ctx context.Context, invitation rest.InvitationInput, invitedBy string, expiresAt time.Time) (rest.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, invitation, invitedBy, expiresAt)
+	ret0, _ := ret[0].(rest.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockInvitationRepositoryMockRecorder) CreateInvitation(ctx, invitation, invitedBy, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockInvitationRepository)(nil).CreateInvitation), ctx, invitation, invitedBy, expiresAt)
+}
+
+// DeleteInvitation mocks base method.
+func (m *MockInvitationRepository) DeleteInvitation(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInvitation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInvitation indicates an expected call of DeleteInvitation.
+func (mr *MockInvitationRepositoryMockRecorder) DeleteInvitation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInvitation", reflect.TypeOf((*MockInvitationRepository)(nil).DeleteInvitation), ctx, id)
+}
+
+// ListInvitations mocks base method.
+func (m *MockInvitationRepository) ListInvitations(ctx context.Context) ([]rest.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitations", ctx)
+	ret0, _ := ret[0].([]rest.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitations indicates an expected call of ListInvitations.
+func (mr *MockInvitationRepositoryMockRecorder) ListInvitations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockInvitationRepository)(nil).ListInvitations), ctx)
+}
+
+// MockSessionRepository is a mock of SessionRepository interface.
+type MockSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionRepositoryMockRecorder is the mock recorder for MockSessionRepository.
+type MockSessionRepositoryMockRecorder struct {
+	mock *MockSessionRepository
+}
+
+// NewMockSessionRepository creates a new mock instance.
+func NewMockSessionRepository(ctrl *gomock.Controller) *MockSessionRepository {
+	mock := &MockSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRepository) EXPECT() *MockSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockSessionRepository) CreateSession(ctx context.Context, session rest.Session) (rest.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, session)
+	ret0, _ := ret[0].(rest.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockSessionRepositoryMockRecorder) CreateSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionRepository)(nil).CreateSession), ctx, session)
+}
+
+// DeleteSession mocks base method.
+func (m *MockSessionRepository) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockSessionRepositoryMockRecorder) DeleteSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionRepository)(nil).DeleteSession), ctx, id)
+}
+
+// GetSession mocks base method.
+func (m *MockSessionRepository) GetSession(ctx context.Context, tokenHash []byte, now time.Time) (rest.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, tokenHash, now)
+	ret0, _ := ret[0].(rest.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockSessionRepositoryMockRecorder) GetSession(ctx, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionRepository)(nil).GetSession), ctx, tokenHash, now)
+}
+
+// ListSessions mocks base method.
+func (m *MockSessionRepository) ListSessions(ctx context.Context, email *string, now time.Time) ([]rest.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, email, now)
+	ret0, _ := ret[0].([]rest.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockSessionRepositoryMockRecorder) ListSessions(ctx, email, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockSessionRepository)(nil).ListSessions), ctx, email, now)
+}
+
+// RevokeSession mocks base method.
+func (m *MockSessionRepository) RevokeSession(ctx context.Context, id uuid.UUID, revokedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, id, revokedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockSessionRepositoryMockRecorder) RevokeSession(ctx, id, revokedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockSessionRepository)(nil).RevokeSession), ctx, id, revokedBy)
+}
+
+// TouchSession mocks base method.
+func (m *MockSessionRepository) TouchSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, id, now, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockSessionRepositoryMockRecorder) TouchSession(ctx, id, now, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockSessionRepository)(nil).TouchSession), ctx, id, now, expiresAt)
+}
+
+// MockAPIKeyRepository is a mock of APIKeyRepository interface.
+type MockAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyRepositoryMockRecorder is the mock recorder for MockAPIKeyRepository.
+type MockAPIKeyRepositoryMockRecorder struct {
+	mock *MockAPIKeyRepository
+}
+
+// NewMockAPIKeyRepository creates a new mock instance.
+func NewMockAPIKeyRepository(ctrl *gomock.Controller) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockAPIKeyRepository) CreateAPIKey(ctx context.Context, key rest.APIKeyInput, owner string, keyHash []byte) (rest.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, key, owner, keyHash)
+	ret0, _ := ret[0].(rest.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) CreateAPIKey(ctx, key, owner, keyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).CreateAPIKey), ctx, key, owner, keyHash)
+}
+
+// DeleteAPIKey mocks base method.
+func (m *MockAPIKeyRepository) DeleteAPIKey(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) DeleteAPIKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).DeleteAPIKey), ctx, id)
+}
+
+// FindAPIKey mocks base method.
+func (m *MockAPIKeyRepository) FindAPIKey(ctx context.Context, keyHash []byte, now time.Time) (rest.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAPIKey", ctx, keyHash, now)
+	ret0, _ := ret[0].(rest.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPIKey indicates an expected call of FindAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindAPIKey(ctx, keyHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindAPIKey), ctx, keyHash, now)
+}
+
+// GetAPIKey mocks base method.
+func (m *MockAPIKeyRepository) GetAPIKey(ctx context.Context, id uuid.UUID) (rest.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", ctx, id)
+	ret0, _ := ret[0].(rest.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) GetAPIKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).GetAPIKey), ctx, id)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockAPIKeyRepository) ListAPIKeys(ctx context.Context, owner *string) ([]rest.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, owner)
+	ret0, _ := ret[0].([]rest.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockAPIKeyRepositoryMockRecorder) ListAPIKeys(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAPIKeyRepository)(nil).ListAPIKeys), ctx, owner)
+}
+
+// TouchAPIKey mocks base method.
+func (m *MockAPIKeyRepository) TouchAPIKey(ctx context.Context, id uuid.UUID, now time.Time, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIKey", ctx, id, now, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) TouchAPIKey(ctx, id, now, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).TouchAPIKey), ctx, id, now, ip)
+}
+
+// MockRevocationRepository is a mock of RevocationRepository interface.
+type MockRevocationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevocationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRevocationRepositoryMockRecorder is the mock recorder for MockRevocationRepository.
+type MockRevocationRepositoryMockRecorder struct {
+	mock *MockRevocationRepository
+}
+
+// NewMockRevocationRepository creates a new mock instance.
+func NewMockRevocationRepository(ctrl *gomock.Controller) *MockRevocationRepository {
+	mock := &MockRevocationRepository{ctrl: ctrl}
+	mock.recorder = &MockRevocationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevocationRepository) EXPECT() *MockRevocationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteRevocation mocks base method.
+func (m *MockRevocationRepository) DeleteRevocation(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRevocation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRevocation indicates an expected call of DeleteRevocation.
+func (mr *MockRevocationRepositoryMockRecorder) DeleteRevocation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRevocation", reflect.TypeOf((*MockRevocationRepository)(nil).DeleteRevocation), ctx, id)
+}
+
+// ListRevocations mocks base method.
+func (m *MockRevocationRepository) ListRevocations(ctx context.Context) ([]rest.Revocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevocations", ctx)
+	ret0, _ := ret[0].([]rest.Revocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevocations indicates an expected call of ListRevocations.
+func (mr *MockRevocationRepositoryMockRecorder) ListRevocations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevocations", reflect.TypeOf((*MockRevocationRepository)(nil).ListRevocations), ctx)
+}
+
+// ListRevokedSessions mocks base method.
+func (m *MockRevocationRepository) ListRevokedSessions(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevokedSessions", ctx, now)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevokedSessions indicates an expected call of ListRevokedSessions.
+func (mr *MockRevocationRepositoryMockRecorder) ListRevokedSessions(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevokedSessions", reflect.TypeOf((*MockRevocationRepository)(nil).ListRevokedSessions), ctx, now)
+}
+
+// RevokeUser mocks base method.
+func (m *MockRevocationRepository) RevokeUser(ctx context.Context, revocation rest.RevocationInput, revokedBy string) (rest.Revocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUser", ctx, revocation, revokedBy)
+	ret0, _ := ret[0].(rest.Revocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeUser indicates an expected call of RevokeUser.
+func (mr *MockRevocationRepositoryMockRecorder) RevokeUser(ctx, revocation, revokedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUser", reflect.TypeOf((*MockRevocationRepository)(nil).RevokeUser), ctx, revocation, revokedBy)
+}
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddAuditEntry mocks base method.
+func (m *MockAuditRepository) AddAuditEntry(ctx context.Context, entry rest.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAuditEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAuditEntry indicates an expected call of AddAuditEntry.
+func (mr *MockAuditRepositoryMockRecorder) AddAuditEntry(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuditEntry", reflect.TypeOf((*MockAuditRepository)(nil).AddAuditEntry), ctx, entry)
+}
+
+// ListAuditEntries mocks base method.
+func (m *MockAuditRepository) ListAuditEntries(ctx context.Context, filter rest.AuditFilter) ([]rest.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEntries", ctx, filter)
+	ret0, _ := ret[0].([]rest.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEntries indicates an expected call of ListAuditEntries.
+func (mr *MockAuditRepositoryMockRecorder) ListAuditEntries(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEntries", reflect.TypeOf((*MockAuditRepository)(nil).ListAuditEntries), ctx, filter)
+}
+
+// MockJoinRequestRepository is a mock of JoinRequestRepository interface.
+type MockJoinRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJoinRequestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockJoinRequestRepositoryMockRecorder is the mock recorder for MockJoinRequestRepository.
+type MockJoinRequestRepositoryMockRecorder struct {
+	mock *MockJoinRequestRepository
+}
+
+// NewMockJoinRequestRepository creates a new mock instance.
+func NewMockJoinRequestRepository(ctrl *gomock.Controller) *MockJoinRequestRepository {
+	mock := &MockJoinRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockJoinRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJoinRequestRepository) EXPECT() *MockJoinRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddJoinRequest mocks base method.
+func (m *MockJoinRequestRepository) AddJoinRequest(ctx context.Context, joinRequest rest.JoinRequestInput, eligible []rest.Section, ip string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddJoinRequest", ctx, joinRequest, eligible, ip)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddJoinRequest indicates an expected call of AddJoinRequest.
+func (mr *MockJoinRequestRepositoryMockRecorder) AddJoinRequest(ctx, joinRequest, eligible, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJoinRequest", reflect.TypeOf((*MockJoinRequestRepository)(nil).AddJoinRequest), ctx, joinRequest, eligible, ip)
+}
+
+// CountJoinRequestsFromIP mocks base method.
+func (m *MockJoinRequestRepository) CountJoinRequestsFromIP(ctx context.Context, ip string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJoinRequestsFromIP", ctx, ip, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJoinRequestsFromIP indicates an expected call of CountJoinRequestsFromIP.
+func (mr *MockJoinRequestRepositoryMockRecorder) CountJoinRequestsFromIP(ctx, ip, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJoinRequestsFromIP", reflect.TypeOf((*MockJoinRequestRepository)(nil).CountJoinRequestsFromIP), ctx, ip, since)
+}
+
+// GetJoinRequest mocks base method.
+func (m *MockJoinRequestRepository) GetJoinRequest(ctx context.Context, id uuid.UUID) (rest.JoinRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinRequest", ctx, id)
+	ret0, _ := ret[0].(rest.JoinRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinRequest indicates an expected call of GetJoinRequest.
+func (mr *MockJoinRequestRepositoryMockRecorder) GetJoinRequest(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinRequest", reflect.TypeOf((*MockJoinRequestRepository)(nil).GetJoinRequest), ctx, id)
+}
+
+// ListJoinRequestEvents mocks base method.
+func (m *MockJoinRequestRepository) ListJoinRequestEvents(ctx context.Context, joinRequestID uuid.UUID) ([]rest.JoinRequestEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJoinRequestEvents", ctx, joinRequestID)
+	ret0, _ := ret[0].([]rest.JoinRequestEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJoinRequestEvents indicates an expected call of ListJoinRequestEvents.
+func (mr *MockJoinRequestRepositoryMockRecorder) ListJoinRequestEvents(ctx, joinRequestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJoinRequestEvents", reflect.TypeOf((*MockJoinRequestRepository)(nil).ListJoinRequestEvents), ctx, joinRequestID)
+}
+
+// ListWaitingList mocks base method.
+func (m *MockJoinRequestRepository) ListWaitingList(ctx context.Context, unitID string) ([]rest.JoinRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWaitingList", ctx, unitID)
+	ret0, _ := ret[0].([]rest.JoinRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWaitingList indicates an expected call of ListWaitingList.
+func (mr *MockJoinRequestRepositoryMockRecorder) ListWaitingList(ctx, unitID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWaitingList", reflect.TypeOf((*MockJoinRequestRepository)(nil).ListWaitingList), ctx, unitID)
+}
+
+// MockPlaceOfferRepository is a mock of PlaceOfferRepository interface.
+type MockPlaceOfferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlaceOfferRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPlaceOfferRepositoryMockRecorder is the mock recorder for MockPlaceOfferRepository.
+type MockPlaceOfferRepositoryMockRecorder struct {
+	mock *MockPlaceOfferRepository
+}
+
+// NewMockPlaceOfferRepository creates a new mock instance.
+func NewMockPlaceOfferRepository(ctrl *gomock.Controller) *MockPlaceOfferRepository {
+	mock := &MockPlaceOfferRepository{ctrl: ctrl}
+	mock.recorder = &MockPlaceOfferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlaceOfferRepository) EXPECT() *MockPlaceOfferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ExpirePlaceOffers mocks base method.
+func (m *MockPlaceOfferRepository) ExpirePlaceOffers(ctx context.Context, now time.Time) ([]rest.PlaceOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePlaceOffers", ctx, now)
+	ret0, _ := ret[0].([]rest.PlaceOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePlaceOffers indicates an expected call of ExpirePlaceOffers.
+func (mr *MockPlaceOfferRepositoryMockRecorder) ExpirePlaceOffers(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePlaceOffers", reflect.TypeOf((*MockPlaceOfferRepository)(nil).ExpirePlaceOffers), ctx, now)
+}
+
+// OfferPlace mocks base method.
+func (m *MockPlaceOfferRepository) OfferPlace(ctx context.Context, joinRequestID uuid.UUID, unitID string, tokenHash []byte, expiresAt time.Time, offeredBy string) (rest.PlaceOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferPlace", ctx, joinRequestID, unitID, tokenHash, expiresAt, offeredBy)
+	ret0, _ := ret[0].(rest.PlaceOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OfferPlace indicates an expected call of OfferPlace.
+func (mr *MockPlaceOfferRepositoryMockRecorder) OfferPlace(ctx, joinRequestID, unitID, tokenHash, expiresAt, offeredBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferPlace", reflect.TypeOf((*MockPlaceOfferRepository)(nil).OfferPlace), ctx, joinRequestID, unitID, tokenHash, expiresAt, offeredBy)
+}
+
+// RespondToPlaceOffer mocks base method.
+func (m *MockPlaceOfferRepository) RespondToPlaceOffer(ctx context.Context, offerID uuid.UUID, tokenHash []byte, accept bool, now time.Time) (rest.PlaceOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondToPlaceOffer", ctx, offerID, tokenHash, accept, now)
+	ret0, _ := ret[0].(rest.PlaceOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RespondToPlaceOffer indicates an expected call of RespondToPlaceOffer.
+func (mr *MockPlaceOfferRepositoryMockRecorder) RespondToPlaceOffer(ctx, offerID, tokenHash, accept, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondToPlaceOffer", reflect.TypeOf((*MockPlaceOfferRepository)(nil).RespondToPlaceOffer), ctx, offerID, tokenHash, accept, now)
+}
+
+// MockFamilyRepository is a mock of FamilyRepository interface.
+type MockFamilyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFamilyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFamilyRepositoryMockRecorder is the mock recorder for MockFamilyRepository.
+type MockFamilyRepositoryMockRecorder struct {
+	mock *MockFamilyRepository
+}
+
+// NewMockFamilyRepository creates a new mock instance.
+func NewMockFamilyRepository(ctrl *gomock.Controller) *MockFamilyRepository {
+	mock := &MockFamilyRepository{ctrl: ctrl}
+	mock.recorder = &MockFamilyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFamilyRepository) EXPECT() *MockFamilyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountParentLoginsFromIP mocks base method.
+func (m *MockFamilyRepository) CountParentLoginsFromIP(ctx context.Context, ip string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountParentLoginsFromIP", ctx, ip, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountParentLoginsFromIP indicates an expected call of CountParentLoginsFromIP.
+func (mr *MockFamilyRepositoryMockRecorder) CountParentLoginsFromIP(ctx, ip, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountParentLoginsFromIP", reflect.TypeOf((*MockFamilyRepository)(nil).CountParentLoginsFromIP), ctx, ip, since)
+}
+
+// CreateParentLogin mocks base method.
+func (m *MockFamilyRepository) CreateParentLogin(ctx context.Context, email string, tokenHash []byte, ip string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateParentLogin", ctx, email, tokenHash, ip, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateParentLogin indicates an expected call of CreateParentLogin.
+func (mr *MockFamilyRepositoryMockRecorder) CreateParentLogin(ctx, email, tokenHash, ip, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateParentLogin", reflect.TypeOf((*MockFamilyRepository)(nil).CreateParentLogin), ctx, email, tokenHash, ip, expiresAt)
+}
+
+// CreateParentSession mocks base method.
+func (m *MockFamilyRepository) CreateParentSession(ctx context.Context, session rest.ParentSession) (rest.ParentSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateParentSession", ctx, session)
+	ret0, _ := ret[0].(rest.ParentSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateParentSession indicates an expected call of CreateParentSession.
+func (mr *MockFamilyRepositoryMockRecorder) CreateParentSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateParentSession", reflect.TypeOf((*MockFamilyRepository)(nil).CreateParentSession), ctx, session)
+}
+
+// DeleteParentSession mocks base method.
+func (m *MockFamilyRepository) DeleteParentSession(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParentSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteParentSession indicates an expected call of DeleteParentSession.
+func (mr *MockFamilyRepositoryMockRecorder) DeleteParentSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParentSession", reflect.TypeOf((*MockFamilyRepository)(nil).DeleteParentSession), ctx, id)
+}
+
+// GetParentSession mocks base method.
+func (m *MockFamilyRepository) GetParentSession(ctx context.Context, tokenHash []byte, now time.Time) (rest.ParentSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParentSession", ctx, tokenHash, now)
+	ret0, _ := ret[0].(rest.ParentSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParentSession indicates an expected call of GetParentSession.
+func (mr *MockFamilyRepositoryMockRecorder) GetParentSession(ctx, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParentSession", reflect.TypeOf((*MockFamilyRepository)(nil).GetParentSession), ctx, tokenHash, now)
+}
+
+// HasFamily mocks base method.
+func (m *MockFamilyRepository) HasFamily(ctx context.Context, email string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasFamily", ctx, email)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasFamily indicates an expected call of HasFamily.
+func (mr *MockFamilyRepositoryMockRecorder) HasFamily(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFamily", reflect.TypeOf((*MockFamilyRepository)(nil).HasFamily), ctx, email)
+}
+
+// ListFamilyMembers mocks base method.
+func (m *MockFamilyRepository) ListFamilyMembers(ctx context.Context, email string) ([]rest.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFamilyMembers", ctx, email)
+	ret0, _ := ret[0].([]rest.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFamilyMembers indicates an expected call of ListFamilyMembers.
+func (mr *MockFamilyRepositoryMockRecorder) ListFamilyMembers(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFamilyMembers", reflect.TypeOf((*MockFamilyRepository)(nil).ListFamilyMembers), ctx, email)
+}
+
+// ListFamilySignups mocks base method.
+func (m *MockFamilyRepository) ListFamilySignups(ctx context.Context, email string) ([]rest.EventSignup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFamilySignups", ctx, email)
+	ret0, _ := ret[0].([]rest.EventSignup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFamilySignups indicates an expected call of ListFamilySignups.
+func (mr *MockFamilyRepositoryMockRecorder) ListFamilySignups(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFamilySignups", reflect.TypeOf((*MockFamilyRepository)(nil).ListFamilySignups), ctx, email)
+}
+
+// RedeemParentLogin mocks base method.
+func (m *MockFamilyRepository) RedeemParentLogin(ctx context.Context, tokenHash []byte, now time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemParentLogin", ctx, tokenHash, now)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemParentLogin indicates an expected call of RedeemParentLogin.
+func (mr *MockFamilyRepositoryMockRecorder) RedeemParentLogin(ctx, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemParentLogin", reflect.TypeOf((*MockFamilyRepository)(nil).RedeemParentLogin), ctx, tokenHash, now)
+}
+
+// TouchParentSession mocks base method.
+func (m *MockFamilyRepository) TouchParentSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchParentSession", ctx, id, now, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchParentSession indicates an expected call of TouchParentSession.
+func (mr *MockFamilyRepositoryMockRecorder) TouchParentSession(ctx, id, now, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchParentSession", reflect.TypeOf((*MockFamilyRepository)(nil).TouchParentSession), ctx, id, now, expiresAt)
+}
+
+// MockRoleLoader is a mock of RoleLoader interface.
+type MockRoleLoader struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleLoaderMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleLoaderMockRecorder is the mock recorder for MockRoleLoader.
+type MockRoleLoaderMockRecorder struct {
+	mock *MockRoleLoader
+}
+
+// NewMockRoleLoader creates a new mock instance.
+func NewMockRoleLoader(ctrl *gomock.Controller) *MockRoleLoader {
+	mock := &MockRoleLoader{ctrl: ctrl}
+	mock.recorder = &MockRoleLoaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleLoader) EXPECT() *MockRoleLoaderMockRecorder {
+	return m.recorder
+}
+
+// ListUserRoles mocks base method.
+func (m *MockRoleLoader) ListUserRoles(ctx context.Context, email string) ([]rest.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserRoles", ctx, email)
+	ret0, _ := ret[0].([]rest.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserRoles indicates an expected call of ListUserRoles.
+func (mr *MockRoleLoaderMockRecorder) ListUserRoles(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRoles", reflect.TypeOf((*MockRoleLoader)(nil).ListUserRoles), ctx, email)
+}
+
+// MockAdminDirectory is a mock of AdminDirectory interface.
+type MockAdminDirectory struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminDirectoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminDirectoryMockRecorder is the mock recorder for MockAdminDirectory.
+type MockAdminDirectoryMockRecorder struct {
+	mock *MockAdminDirectory
+}
+
+// NewMockAdminDirectory creates a new mock instance.
+func NewMockAdminDirectory(ctrl *gomock.Controller) *MockAdminDirectory {
+	mock := &MockAdminDirectory{ctrl: ctrl}
+	mock.recorder = &MockAdminDirectoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminDirectory) EXPECT() *MockAdminDirectoryMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvitation mocks base method.
+func (m *MockAdminDirectory) AcceptInvitation(ctx context.Context, email string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, email, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockAdminDirectoryMockRecorder) AcceptInvitation(ctx, email, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockAdminDirectory)(nil).AcceptInvitation), ctx, email, now)
+}
+
+// CreateSession mocks base method.
+func (m *MockAdminDirectory) CreateSession(ctx context.Context, session rest.Session) (rest.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, session)
+	ret0, _ := ret[0].(rest.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockAdminDirectoryMockRecorder) CreateSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockAdminDirectory)(nil).CreateSession), ctx, session)
+}
+
+// DeleteSession mocks base method.
+func (m *MockAdminDirectory) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockAdminDirectoryMockRecorder) DeleteSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockAdminDirectory)(nil).DeleteSession), ctx, id)
+}
+
+// FindAPIKey mocks base method.
+func (m *MockAdminDirectory) FindAPIKey(ctx context.Context, keyHash []byte, now time.Time) (rest.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAPIKey", ctx, keyHash, now)
+	ret0, _ := ret[0].(rest.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPIKey indicates an expected call of FindAPIKey.
+func (mr *MockAdminDirectoryMockRecorder) FindAPIKey(ctx, keyHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPIKey", reflect.TypeOf((*MockAdminDirectory)(nil).FindAPIKey), ctx, keyHash, now)
+}
+
+// GetSession mocks base method.
+func (m *MockAdminDirectory) GetSession(ctx context.Context, tokenHash []byte, now time.Time) (rest.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, tokenHash, now)
+	ret0, _ := ret[0].(rest.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockAdminDirectoryMockRecorder) GetSession(ctx, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockAdminDirectory)(nil).GetSession), ctx, tokenHash, now)
+}
+
+// ListUserRoles mocks base method.
+func (m *MockAdminDirectory) ListUserRoles(ctx context.Context, email string) ([]rest.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserRoles", ctx, email)
+	ret0, _ := ret[0].([]rest.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserRoles indicates an expected call of ListUserRoles.
+func (mr *MockAdminDirectoryMockRecorder) ListUserRoles(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRoles", reflect.TypeOf((*MockAdminDirectory)(nil).ListUserRoles), ctx, email)
+}
+
+// TouchAPIKey mocks base method.
+func (m *MockAdminDirectory) TouchAPIKey(ctx context.Context, id uuid.UUID, now time.Time, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIKey", ctx, id, now, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockAdminDirectoryMockRecorder) TouchAPIKey(ctx, id, now, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockAdminDirectory)(nil).TouchAPIKey), ctx, id, now, ip)
+}
+
+// TouchSession mocks base method.
+func (m *MockAdminDirectory) TouchSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, id, now, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockAdminDirectoryMockRecorder) TouchSession(ctx, id, now, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockAdminDirectory)(nil).TouchSession), ctx, id, now, expiresAt)
+}
+
+// MockSessionStore is a mock of SessionStore interface.
+type MockSessionStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionStoreMockRecorder is the mock recorder for MockSessionStore.
+type MockSessionStoreMockRecorder struct {
+	mock *MockSessionStore
+}
+
+// NewMockSessionStore creates a new mock instance.
+func NewMockSessionStore(ctrl *gomock.Controller) *MockSessionStore {
+	mock := &MockSessionStore{ctrl: ctrl}
+	mock.recorder = &MockSessionStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionStore) EXPECT() *MockSessionStoreMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockSessionStore) CreateSession(ctx context.Context, session rest.Session) (rest.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, session)
+	ret0, _ := ret[0].(rest.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockSessionStoreMockRecorder) CreateSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionStore)(nil).CreateSession), ctx, session)
+}
+
+// DeleteSession mocks base method.
+func (m *MockSessionStore) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockSessionStoreMockRecorder) DeleteSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionStore)(nil).DeleteSession), ctx, id)
+}
+
+// GetSession mocks base method.
+func (m *MockSessionStore) GetSession(ctx context.Context, tokenHash []byte, now time.Time) (rest.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, tokenHash, now)
+	ret0, _ := ret[0].(rest.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockSessionStoreMockRecorder) GetSession(ctx, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionStore)(nil).GetSession), ctx, tokenHash, now)
+}
+
+// TouchSession mocks base method.
+func (m *MockSessionStore) TouchSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, id, now, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockSessionStoreMockRecorder) TouchSession(ctx, id, now, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockSessionStore)(nil).TouchSession), ctx, id, now, expiresAt)
+}
+
+// MockParentSessionStore is a mock of ParentSessionStore interface.
+type MockParentSessionStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockParentSessionStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockParentSessionStoreMockRecorder is the mock recorder for MockParentSessionStore.
+type MockParentSessionStoreMockRecorder struct {
+	mock *MockParentSessionStore
+}
+
+// NewMockParentSessionStore creates a new mock instance.
+func NewMockParentSessionStore(ctrl *gomock.Controller) *MockParentSessionStore {
+	mock := &MockParentSessionStore{ctrl: ctrl}
+	mock.recorder = &MockParentSessionStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockParentSessionStore) EXPECT() *MockParentSessionStoreMockRecorder {
+	return m.recorder
+}
+
+// CreateParentSession mocks base method.
+func (m *MockParentSessionStore) CreateParentSession(ctx context.Context, session rest.ParentSession) (rest.ParentSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateParentSession", ctx, session)
+	ret0, _ := ret[0].(rest.ParentSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateParentSession indicates an expected call of CreateParentSession.
+func (mr *MockParentSessionStoreMockRecorder) CreateParentSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateParentSession", reflect.TypeOf((*MockParentSessionStore)(nil).CreateParentSession), ctx, session)
+}
+
+// DeleteParentSession mocks base method.
+func (m *MockParentSessionStore) DeleteParentSession(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParentSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteParentSession indicates an expected call of DeleteParentSession.
+func (mr *MockParentSessionStoreMockRecorder) DeleteParentSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParentSession", reflect.TypeOf((*MockParentSessionStore)(nil).DeleteParentSession), ctx, id)
+}
+
+// GetParentSession mocks base method.
+func (m *MockParentSessionStore) GetParentSession(ctx context.Context, tokenHash []byte, now time.Time) (rest.ParentSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParentSession", ctx, tokenHash, now)
+	ret0, _ := ret[0].(rest.ParentSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParentSession indicates an expected call of GetParentSession.
+func (mr *MockParentSessionStoreMockRecorder) GetParentSession(ctx, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParentSession", reflect.TypeOf((*MockParentSessionStore)(nil).GetParentSession), ctx, tokenHash, now)
+}
+
+// TouchParentSession mocks base method.
+func (m *MockParentSessionStore) TouchParentSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchParentSession", ctx, id, now, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchParentSession indicates an expected call of TouchParentSession.
+func (mr *MockParentSessionStoreMockRecorder) TouchParentSession(ctx, id, now, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchParentSession", reflect.TypeOf((*MockParentSessionStore)(nil).TouchParentSession), ctx, id, now, expiresAt)
+}
+
+// MockAuditStore is a mock of AuditStore interface.
+type MockAuditStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditStoreMockRecorder is the mock recorder for MockAuditStore.
+type MockAuditStoreMockRecorder struct {
+	mock *MockAuditStore
+}
+
+// NewMockAuditStore creates a new mock instance.
+func NewMockAuditStore(ctrl *gomock.Controller) *MockAuditStore {
+	mock := &MockAuditStore{ctrl: ctrl}
+	mock.recorder = &MockAuditStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditStore) EXPECT() *MockAuditStoreMockRecorder {
+	return m.recorder
+}
+
+// AddAuditEntry mocks base method.
+func (m *MockAuditStore) AddAuditEntry(ctx context.Context, entry rest.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAuditEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAuditEntry indicates an expected call of AddAuditEntry.
+func (mr *MockAuditStoreMockRecorder) AddAuditEntry(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuditEntry", reflect.TypeOf((*MockAuditStore)(nil).AddAuditEntry), ctx, entry)
+}
+
+// MockRevocationLoader is a mock of RevocationLoader interface.
+type MockRevocationLoader struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevocationLoaderMockRecorder
+	isgomock struct{}
+}
+
+// MockRevocationLoaderMockRecorder is the mock recorder for MockRevocationLoader.
+type MockRevocationLoaderMockRecorder struct {
+	mock *MockRevocationLoader
+}
+
+// NewMockRevocationLoader creates a new mock instance.
+func NewMockRevocationLoader(ctrl *gomock.Controller) *MockRevocationLoader {
+	mock := &MockRevocationLoader{ctrl: ctrl}
+	mock.recorder = &MockRevocationLoaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevocationLoader) EXPECT() *MockRevocationLoaderMockRecorder {
+	return m.recorder
+}
+
+// ListRevocations mocks base method.
+func (m *MockRevocationLoader) ListRevocations(ctx context.Context) ([]rest.Revocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevocations", ctx)
+	ret0, _ := ret[0].([]rest.Revocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevocations indicates an expected call of ListRevocations.
+func (mr *MockRevocationLoaderMockRecorder) ListRevocations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevocations", reflect.TypeOf((*MockRevocationLoader)(nil).ListRevocations), ctx)
+}
+
+// ListRevokedSessions mocks base method.
+func (m *MockRevocationLoader) ListRevokedSessions(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevokedSessions", ctx, now)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevokedSessions indicates an expected call of ListRevokedSessions.
+func (mr *MockRevocationLoaderMockRecorder) ListRevokedSessions(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevokedSessions", reflect.TypeOf((*MockRevocationLoader)(nil).ListRevokedSessions), ctx, now)
+}
+
+// MockTokenVerifier is a mock of TokenVerifier interface.
+type MockTokenVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenVerifierMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenVerifierMockRecorder is the mock recorder for MockTokenVerifier.
+type MockTokenVerifierMockRecorder struct {
+	mock *MockTokenVerifier
+}
+
+// NewMockTokenVerifier creates a new mock instance.
+func NewMockTokenVerifier(ctrl *gomock.Controller) *MockTokenVerifier {
+	mock := &MockTokenVerifier{ctrl: ctrl}
+	mock.recorder = &MockTokenVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenVerifier) EXPECT() *MockTokenVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockTokenVerifier) Verify(ctx context.Context, token string) (rest.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, token)
+	ret0, _ := ret[0].(rest.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockTokenVerifierMockRecorder) Verify(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockTokenVerifier)(nil).Verify), ctx, token)
+}
+
+// MockEncrypter is a mock of Encrypter interface.
+type MockEncrypter struct {
+	ctrl     *gomock.Controller
+	recorder *MockEncrypterMockRecorder
+	isgomock struct{}
+}
+
+// MockEncrypterMockRecorder is the mock recorder for MockEncrypter.
+type MockEncrypterMockRecorder struct {
+	mock *MockEncrypter
+}
+
+// NewMockEncrypter creates a new mock instance.
+func NewMockEncrypter(ctrl *gomock.Controller) *MockEncrypter {
+	mock := &MockEncrypter{ctrl: ctrl}
+	mock.recorder = &MockEncrypterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEncrypter) EXPECT() *MockEncrypterMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockEncrypter) Decrypt(keyID string, ciphertext, additionalData []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", keyID, ciphertext, additionalData)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockEncrypterMockRecorder) Decrypt(keyID, ciphertext, additionalData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockEncrypter)(nil).Decrypt), keyID, ciphertext, additionalData)
+}
+
+// Encrypt mocks base method.
+func (m *MockEncrypter) Encrypt(plaintext, additionalData []byte) (string, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", plaintext, additionalData)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockEncrypterMockRecorder) Encrypt(plaintext, additionalData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockEncrypter)(nil).Encrypt), plaintext, additionalData)
+}
+
+// MockCaptchaVerifier is a mock of CaptchaVerifier interface.
+type MockCaptchaVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockCaptchaVerifierMockRecorder
+	isgomock struct{}
+}
+
+// MockCaptchaVerifierMockRecorder is the mock recorder for MockCaptchaVerifier.
+type MockCaptchaVerifierMockRecorder struct {
+	mock *MockCaptchaVerifier
+}
+
+// NewMockCaptchaVerifier creates a new mock instance.
+func NewMockCaptchaVerifier(ctrl *gomock.Controller) *MockCaptchaVerifier {
+	mock := &MockCaptchaVerifier{ctrl: ctrl}
+	mock.recorder = &MockCaptchaVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCaptchaVerifier) EXPECT() *MockCaptchaVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockCaptchaVerifier) Verify(ctx context.Context, token, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, token, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockCaptchaVerifierMockRecorder) Verify(ctx, token, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockCaptchaVerifier)(nil).Verify), ctx, token, ip)
+}
+
+// MockContentManager is a mock of ContentManager interface.
+type MockContentManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockContentManagerMockRecorder is the mock recorder for MockContentManager.
+type MockContentManagerMockRecorder struct {
+	mock *MockContentManager
+}
+
+// NewMockContentManager creates a new mock instance.
+func NewMockContentManager(ctrl *gomock.Controller) *MockContentManager {
+	mock := &MockContentManager{ctrl: ctrl}
+	mock.recorder = &MockContentManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentManager) EXPECT() *MockContentManagerMockRecorder {
+	return m.recorder
+}
+
+// EmailTemplate mocks base method.
+func (m *MockContentManager) EmailTemplate(ctx context.Context, key string, vars map[string]any) (rest.EmailContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmailTemplate", ctx, key, vars)
+	ret0, _ := ret[0].(rest.EmailContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmailTemplate indicates an expected call of EmailTemplate.
+func (mr *MockContentManagerMockRecorder) EmailTemplate(ctx, key, vars any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmailTemplate", reflect.TypeOf((*MockContentManager)(nil).EmailTemplate), ctx, key, vars)
+}
+
+// MockEmailSender is a mock of EmailSender interface.
+type MockEmailSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailSenderMockRecorder is the mock recorder for MockEmailSender.
+type MockEmailSenderMockRecorder struct {
+	mock *MockEmailSender
+}
+
+// NewMockEmailSender creates a new mock instance.
+func NewMockEmailSender(ctrl *gomock.Controller) *MockEmailSender {
+	mock := &MockEmailSender{ctrl: ctrl}
+	mock.recorder = &MockEmailSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailSender) EXPECT() *MockEmailSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockEmailSender) Send(ctx context.Context, to, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, to, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockEmailSenderMockRecorder) Send(ctx, to, subject, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockEmailSender)(nil).Send), ctx, to, subject, body)
+}
@@ -4,9 +4,293 @@
 package rest
 
 import (
+	"time"
+
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	Admin_authScopes     = "admin_auth.Scopes"
+	Admin_sessionScopes  = "admin_session.Scopes"
+	Parent_sessionScopes = "parent_session.Scopes"
+)
+
+// Defines values for APIKeyScope.
+const (
+	Read      APIKeyScope = "read"
+	Sensitive APIKeyScope = "sensitive"
+	Write     APIKeyScope = "write"
+)
+
+// Defines values for Activity.
+const (
+	Meeting     Activity = "meeting"
+	Outing      Activity = "outing"
+	Residential Activity = "residential"
+)
+
+// Defines values for AuditEntryCredential.
+const (
+	CredentialAPIKey  AuditEntryCredential = "api_key"
+	CredentialSession AuditEntryCredential = "session"
+	CredentialToken   AuditEntryCredential = "token"
+)
+
+// Defines values for EventStatus.
+const (
+	EventStatusApproved          EventStatus = "approved"
+	EventStatusAwaitingDocuments EventStatus = "awaiting documents"
+	EventStatusCancelled         EventStatus = "cancelled"
+	EventStatusProvisional       EventStatus = "provisional"
+)
+
+// Defines values for JoinRequestStatus.
+const (
+	JoinRequestStatusAccepted JoinRequestStatus = "accepted"
+	JoinRequestStatusDeclined JoinRequestStatus = "declined"
+	JoinRequestStatusExpired  JoinRequestStatus = "expired"
+	JoinRequestStatusOffered  JoinRequestStatus = "offered"
+	JoinRequestStatusWaiting  JoinRequestStatus = "waiting"
+)
+
+// Defines values for PlaceOfferStatus.
+const (
+	PlaceOfferStatusAccepted PlaceOfferStatus = "accepted"
+	PlaceOfferStatusDeclined PlaceOfferStatus = "declined"
+	PlaceOfferStatusExpired  PlaceOfferStatus = "expired"
+	PlaceOfferStatusOffered  PlaceOfferStatus = "offered"
+)
+
+// Defines values for RatioEnforcement.
+const (
+	Block RatioEnforcement = "block"
+	Flag  RatioEnforcement = "flag"
+)
+
+// Defines values for RoleName.
+const (
+	DistrictCommissioner RoleName = "district_commissioner"
+	Helper               RoleName = "helper"
+	Treasurer            RoleName = "treasurer"
+	UnitLeader           RoleName = "unit_leader"
+)
+
+// Defines values for Section.
+const (
+	Brownies Section = "brownies"
+	Guides   Section = "guides"
+	Rainbows Section = "rainbows"
+	Rangers  Section = "rangers"
+)
+
+// Defines values for SignupStatus.
+const (
+	SignupStatusCancelled  SignupStatus = "cancelled"
+	SignupStatusConfirmed  SignupStatus = "confirmed"
+	SignupStatusWaitlisted SignupStatus = "waitlisted"
+)
+
+// Defines values for AdminGetWaitingListParamsOrder.
+const (
+	AgeOut     AdminGetWaitingListParamsOrder = "ageOut"
+	Registered AdminGetWaitingListParamsOrder = "registered"
+)
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt When the key stops working, which must be within a year.
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// LastUsedAt When the key was last used, to within a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	LastUsedIP *string    `json:"lastUsedIP,omitempty"`
+
+	// Name What the key is for.
+	Name string `json:"name"`
+
+	// Owner The admin whose roles the key uses.
+	Owner  openapi_types.Email `json:"owner"`
+	Scopes []APIKeyScope       `json:"scopes"`
+}
+
+// APIKeyInput defines model for APIKeyInput.
+type APIKeyInput struct {
+	// ExpiresAt When the key stops working, which must be within a year.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Name What the key is for.
+	Name   string        `json:"name"`
+	Scopes []APIKeyScope `json:"scopes"`
+}
+
+// APIKeyScope - read: see records, other than members' sensitive details.
+// - write: change records.
+// - sensitive: see and change members' sensitive details.
+type APIKeyScope string
+
+// APIKeys defines model for APIKeys.
+type APIKeys struct {
+	ApiKeys []APIKey `json:"apiKeys"`
+}
+
+// Activity The kind of activity, which decides the ratio rules that apply. Defaults to meeting.
+type Activity string
+
+// AdminEvent defines model for AdminEvent.
+type AdminEvent struct {
+	// Activity The kind of activity, which decides the ratio rules that apply. Defaults to meeting.
+	Activity     Activity `json:"activity"`
+	AdultHelpers int      `json:"adultHelpers"`
+
+	// ConfirmedBySection The number of confirmed sign-ups in each section.
+	ConfirmedBySection *map[string]int `json:"confirmedBySection,omitempty"`
+
+	// ConfirmedSignups The number of confirmed sign-ups. Only present when the event takes sign-ups.
+	ConfirmedSignups *int               `json:"confirmedSignups,omitempty"`
+	CreatedAt        time.Time          `json:"createdAt"`
+	CreatedBy        string             `json:"createdBy"`
+	Description      *string            `json:"description,omitempty"`
+	End              time.Time          `json:"end"`
+	Id               openapi_types.UUID `json:"id"`
+	Location         *string            `json:"location,omitempty"`
+	Ratio            *RatioCheck        `json:"ratio,omitempty"`
+
+	// RatioEnforcement What happens when the event does not meet the adult to child ratio. Defaults to flag.
+	// - flag: the event is marked as not meeting the ratio, but sign-ups are still confirmed.
+	// - block: sign-ups that would break the ratio join the waiting list, and the adult helpers cannot be reduced below the ratio.
+	RatioEnforcement RatioEnforcement `json:"ratioEnforcement"`
+
+	// Signups Present when the event takes sign-ups.
+	Signups *EventSignupSettings `json:"signups,omitempty"`
+	Start   time.Time            `json:"start"`
+	Status  EventStatus          `json:"status"`
+	Title   string               `json:"title"`
+
+	// Unit The unit running the event. District-wide events have no unit.
+	Unit      *string   `json:"unit,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// WaitlistedSignups The number of sign-ups on the waiting list. Only present when the event takes sign-ups.
+	WaitlistedSignups *int `json:"waitlistedSignups,omitempty"`
+}
+
+// AdminListEventSignupsResponse defines model for AdminListEventSignupsResponse.
+type AdminListEventSignupsResponse struct {
+	Signups []EventSignup `json:"signups"`
+}
+
+// AdminListEventsResponse defines model for AdminListEventsResponse.
+type AdminListEventsResponse struct {
+	Events []AdminEvent `json:"events"`
+}
+
+// AdminListUnitsResponse defines model for AdminListUnitsResponse.
+type AdminListUnitsResponse struct {
+	Units []AdminUnit `json:"units"`
+}
+
+// AdminSession defines model for AdminSession.
+type AdminSession struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current Whether this is the session the request was made with.
+	Current   bool                `json:"current"`
+	Email     openapi_types.Email `json:"email"`
+	ExpiresAt time.Time           `json:"expiresAt"`
+	Id        openapi_types.UUID  `json:"id"`
+
+	// LastSeenAt When the session was last used, to within a minute.
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	Name       *string   `json:"name,omitempty"`
+}
+
+// AdminSessions defines model for AdminSessions.
+type AdminSessions struct {
+	Sessions []AdminSession `json:"sessions"`
+}
+
+// AdminUnit defines model for AdminUnit.
+type AdminUnit struct {
+	// Capacity The most members the unit can take. Absent if it has not been set.
+	Capacity *int `json:"capacity,omitempty"`
+
+	// FreePlaces The number of places left. Absent if the capacity has not been set.
+	FreePlaces *int `json:"freePlaces,omitempty"`
+
+	// Id Short name used in URLs, such as 1st-brownies.
+	Id          string               `json:"id"`
+	LeaderEmail *openapi_types.Email `json:"leaderEmail,omitempty"`
+
+	// Members The number of current members.
+	Members int     `json:"members"`
+	Name    string  `json:"name"`
+	Section Section `json:"section"`
+}
+
+// AdminUser defines model for AdminUser.
+type AdminUser struct {
+	// CsrfToken The token to send in the X-CSRF-Token header with requests that change anything, when signed in with a
+	// session.
+	CsrfToken *string             `json:"csrfToken,omitempty"`
+	Email     openapi_types.Email `json:"email"`
+
+	// ExpiresAt When the admin's sign in expires.
+	ExpiresAt *time.Time       `json:"expiresAt,omitempty"`
+	Name      *string          `json:"name,omitempty"`
+	Roles     []RoleAssignment `json:"roles"`
+}
+
+// AuditChange A field's value before and after the action. A field that was added has no before, and one that was removed has no after.
+type AuditChange struct {
+	After  interface{} `json:"after,omitempty"`
+	Before interface{} `json:"before,omitempty"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Action What the admin did, such as member.update, or the method and route for actions without a name.
+	Action string `json:"action"`
+
+	// Actor The admin who took the action.
+	Actor string `json:"actor"`
+
+	// Changes The fields the action changed, by name.
+	Changes *map[string]AuditChange `json:"changes,omitempty"`
+
+	// Credential How the admin signed in.
+	Credential *AuditEntryCredential `json:"credential,omitempty"`
+	Id         int64                 `json:"id"`
+	Ip         *string               `json:"ip,omitempty"`
+	Method     string                `json:"method"`
+	OccurredAt time.Time             `json:"occurredAt"`
+	Path       string                `json:"path"`
+
+	// Status The HTTP status the action responded with.
+	Status     int     `json:"status"`
+	TargetId   *string `json:"targetId,omitempty"`
+	TargetType *string `json:"targetType,omitempty"`
+	TraceId    *string `json:"traceId,omitempty"`
+}
+
+// AuditEntryCredential How the admin signed in.
+type AuditEntryCredential string
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	Entries []AuditEntry `json:"entries"`
+
+	// NextBefore Pass as `before` to get the next page, if there is one.
+	NextBefore *int64 `json:"nextBefore,omitempty"`
+}
+
+// CancelEventSignupRequest defines model for CancelEventSignupRequest.
+type CancelEventSignupRequest struct {
+	Token string `json:"token"`
+}
+
 // ContactUsMessage defines model for ContactUsMessage.
 type ContactUsMessage struct {
 	Email   openapi_types.Email `json:"email"`
@@ -14,10 +298,737 @@ type ContactUsMessage struct {
 	Name    string              `json:"name"`
 }
 
+// CreatedAPIKey defines model for CreatedAPIKey.
+type CreatedAPIKey struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt When the key stops working, which must be within a year.
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Key The key itself, which can't be retrieved again.
+	Key string `json:"key"`
+
+	// LastUsedAt When the key was last used, to within a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	LastUsedIP *string    `json:"lastUsedIP,omitempty"`
+
+	// Name What the key is for.
+	Name string `json:"name"`
+
+	// Owner The admin whose roles the key uses.
+	Owner  openapi_types.Email `json:"owner"`
+	Scopes []APIKeyScope       `json:"scopes"`
+}
+
+// EmergencyContact defines model for EmergencyContact.
+type EmergencyContact struct {
+	Name         string  `json:"name"`
+	Phone        string  `json:"phone"`
+	Relationship *string `json:"relationship,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	ErrorMessage string `json:"error_message"`
+
+	// Reason Why an admin request couldn't be authenticated, so clients can tell what to do next:
+	// - missing_token: sign in.
+	// - invalid_request: the Authorization header isn't a single bearer token.
+	// - invalid_token: the token can't be trusted; sign in again.
+	// - expired_token: get a new token.
+	// - email_not_verified: the account's email must be verified with its provider.
+	// - account_not_allowed: the account can't sign in here; sign in with another.
+	// - no_roles: the account has signed in, but needs a role before it can do anything.
+	// - session_expired: the session has ended; sign in again.
+	// - invalid_csrf_token: the X-CSRF-Token header is missing or doesn't match the session.
+	// - access_revoked: the account's access has been revoked.
+	// - auth_unavailable: the sign in couldn't be checked; try again shortly.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	// ConfirmedSignups The number of confirmed sign-ups. Only present when the event takes sign-ups.
+	ConfirmedSignups *int               `json:"confirmedSignups,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	End              time.Time          `json:"end"`
+	Id               openapi_types.UUID `json:"id"`
+	Location         *string            `json:"location,omitempty"`
+
+	// Signups Present when the event takes sign-ups.
+	Signups *EventSignupSettings `json:"signups,omitempty"`
+	Start   time.Time            `json:"start"`
+	Status  EventStatus          `json:"status"`
+	Title   string               `json:"title"`
+
+	// Unit The unit running the event. District-wide events have no unit.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// EventInput defines model for EventInput.
+type EventInput struct {
+	// Activity The kind of activity, which decides the ratio rules that apply. Defaults to meeting.
+	Activity *Activity `json:"activity,omitempty"`
+
+	// AdultHelpers The number of adult helpers planned for the event. Defaults to none.
+	AdultHelpers *int      `json:"adultHelpers,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	End          time.Time `json:"end"`
+	Location     *string   `json:"location,omitempty"`
+
+	// RatioEnforcement What happens when the event does not meet the adult to child ratio. Defaults to flag.
+	// - flag: the event is marked as not meeting the ratio, but sign-ups are still confirmed.
+	// - block: sign-ups that would break the ratio join the waiting list, and the adult helpers cannot be reduced below the ratio.
+	RatioEnforcement *RatioEnforcement `json:"ratioEnforcement,omitempty"`
+
+	// Signups Present when the event takes sign-ups.
+	Signups *EventSignupSettings `json:"signups,omitempty"`
+	Start   time.Time            `json:"start"`
+	Status  EventStatus          `json:"status"`
+	Title   string               `json:"title"`
+	Unit    *string              `json:"unit,omitempty"`
+}
+
+// EventSignup defines model for EventSignup.
+type EventSignup struct {
+	ContactEmail    openapi_types.Email `json:"contactEmail"`
+	ContactName     string              `json:"contactName"`
+	ContactPhone    *string             `json:"contactPhone,omitempty"`
+	CreatedAt       time.Time           `json:"createdAt"`
+	DateOfBirth     openapi_types.Date  `json:"dateOfBirth"`
+	EventId         openapi_types.UUID  `json:"eventId"`
+	Id              openapi_types.UUID  `json:"id"`
+	Notes           *string             `json:"notes,omitempty"`
+	ParticipantName string              `json:"participantName"`
+	Section         Section             `json:"section"`
+	Status          SignupStatus        `json:"status"`
+	Unit            *string             `json:"unit,omitempty"`
+}
+
+// EventSignupInput defines model for EventSignupInput.
+type EventSignupInput struct {
+	CaptchaToken string              `json:"captchaToken"`
+	ContactEmail openapi_types.Email `json:"contactEmail"`
+	ContactName  string              `json:"contactName"`
+	ContactPhone *string             `json:"contactPhone,omitempty"`
+	DateOfBirth  openapi_types.Date  `json:"dateOfBirth"`
+
+	// Notes Anything the organisers should know, such as dietary requirements.
+	Notes           *string `json:"notes,omitempty"`
+	ParticipantName string  `json:"participantName"`
+	Section         Section `json:"section"`
+	Unit            *string `json:"unit,omitempty"`
+}
+
+// EventSignupResult defines model for EventSignupResult.
+type EventSignupResult struct {
+	Id     openapi_types.UUID `json:"id"`
+	Status SignupStatus       `json:"status"`
+}
+
+// EventSignupSettings Present when the event takes sign-ups.
+type EventSignupSettings struct {
+	// Capacity The maximum number of confirmed sign-ups. Further sign-ups join the waiting list.
+	Capacity *int      `json:"capacity,omitempty"`
+	Deadline time.Time `json:"deadline"`
+
+	// MaxAge The maximum age, in years, on the day the event starts.
+	MaxAge *int `json:"maxAge,omitempty"`
+
+	// MinAge The minimum age, in years, on the day the event starts.
+	MinAge *int `json:"minAge,omitempty"`
+
+	// Sections The sections that may sign up. Any section may sign up when empty.
+	Sections *[]Section `json:"sections,omitempty"`
+}
+
+// EventStatus defines model for EventStatus.
+type EventStatus string
+
+// Family defines model for Family.
+type Family struct {
+	// Members The children the parent is the contact for.
+	Members []Member `json:"members"`
+	Parent  Parent   `json:"parent"`
+
+	// Signups The event sign-ups the parent made.
+	Signups []EventSignup `json:"signups"`
+}
+
+// Invitation defines model for Invitation.
+type Invitation struct {
+	// AcceptedAt When the invited admin first signed in.
+	AcceptedAt *time.Time          `json:"acceptedAt,omitempty"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	Email      openapi_types.Email `json:"email"`
+
+	// ExpiresAt When the invitation expires, if it hasn't been accepted.
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+	InvitedBy string             `json:"invitedBy"`
+}
+
+// InvitationInput defines model for InvitationInput.
+type InvitationInput struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// Invitations defines model for Invitations.
+type Invitations struct {
+	Invitations []Invitation `json:"invitations"`
+}
+
+// JoinRequest defines model for JoinRequest.
+type JoinRequest struct {
+	ChildName   string             `json:"childName"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	DateOfBirth openapi_types.Date `json:"dateOfBirth"`
+
+	// EligibleSections The sections the child was old enough to join when she registered.
+	EligibleSections []Section           `json:"eligibleSections"`
+	Id               openapi_types.UUID  `json:"id"`
+	Notes            *string             `json:"notes,omitempty"`
+	ParentEmail      openapi_types.Email `json:"parentEmail"`
+	ParentName       string              `json:"parentName"`
+	ParentPhone      *string             `json:"parentPhone,omitempty"`
+	Postcode         string              `json:"postcode"`
+	PreferredUnits   []string            `json:"preferredUnits"`
+	Status           JoinRequestStatus   `json:"status"`
+}
+
+// JoinRequestEvent defines model for JoinRequestEvent.
+type JoinRequestEvent struct {
+	// Action What happened, such as registered, offered, accepted, declined or expired.
+	Action string `json:"action"`
+
+	// Actor The admin who made the change, the parent's email address, or system.
+	Actor     string              `json:"actor"`
+	CreatedAt time.Time           `json:"createdAt"`
+	OfferId   *openapi_types.UUID `json:"offerId,omitempty"`
+	Status    JoinRequestStatus   `json:"status"`
+}
+
+// JoinRequestHistory defines model for JoinRequestHistory.
+type JoinRequestHistory struct {
+	Events []JoinRequestEvent `json:"events"`
+}
+
+// JoinRequestInput defines model for JoinRequestInput.
+type JoinRequestInput struct {
+	CaptchaToken string              `json:"captchaToken"`
+	ChildName    string              `json:"childName"`
+	DateOfBirth  openapi_types.Date  `json:"dateOfBirth"`
+	Notes        *string             `json:"notes,omitempty"`
+	ParentEmail  openapi_types.Email `json:"parentEmail"`
+	ParentName   string              `json:"parentName"`
+	ParentPhone  *string             `json:"parentPhone,omitempty"`
+	Postcode     string              `json:"postcode"`
+
+	// PreferredUnits The units the child would like to join, most preferred first.
+	PreferredUnits []string `json:"preferredUnits"`
+}
+
+// JoinRequestResult defines model for JoinRequestResult.
+type JoinRequestResult struct {
+	// EligibleSections The sections the child is old enough to join today.
+	EligibleSections []Section          `json:"eligibleSections"`
+	Id               openapi_types.UUID `json:"id"`
+}
+
+// JoinRequestStatus defines model for JoinRequestStatus.
+type JoinRequestStatus string
+
+// ListEventsResponse defines model for ListEventsResponse.
+type ListEventsResponse struct {
+	Events []Event `json:"events"`
+}
+
+// ListMembersResponse defines model for ListMembersResponse.
+type ListMembersResponse struct {
+	Members []Member `json:"members"`
+}
+
+// ListUnitsResponse defines model for ListUnitsResponse.
+type ListUnitsResponse struct {
+	Units []Unit `json:"units"`
 }
 
+// Member defines model for Member.
+type Member struct {
+	DateOfBirth openapi_types.Date `json:"dateOfBirth"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// JoinedAt When the member joined the district.
+	JoinedAt    time.Time           `json:"joinedAt"`
+	Name        string              `json:"name"`
+	ParentEmail openapi_types.Email `json:"parentEmail"`
+	ParentName  string              `json:"parentName"`
+	ParentPhone *string             `json:"parentPhone,omitempty"`
+	Unit        string              `json:"unit"`
+}
+
+// MemberAccess defines model for MemberAccess.
+type MemberAccess struct {
+	AccessedAt time.Time `json:"accessedAt"`
+	AccessedBy string    `json:"accessedBy"`
+	Ip         *string   `json:"ip,omitempty"`
+}
+
+// MemberAccessLog defines model for MemberAccessLog.
+type MemberAccessLog struct {
+	Accesses []MemberAccess `json:"accesses"`
+}
+
+// MemberInput defines model for MemberInput.
+type MemberInput struct {
+	DateOfBirth openapi_types.Date  `json:"dateOfBirth"`
+	Name        string              `json:"name"`
+	ParentEmail openapi_types.Email `json:"parentEmail"`
+	ParentName  string              `json:"parentName"`
+	ParentPhone *string             `json:"parentPhone,omitempty"`
+}
+
+// MemberSensitive Details that are encrypted when stored, and only shown to admins for the member's unit.
+type MemberSensitive struct {
+	Allergies         *string            `json:"allergies,omitempty"`
+	EmergencyContacts []EmergencyContact `json:"emergencyContacts"`
+	MedicalNotes      *string            `json:"medicalNotes,omitempty"`
+}
+
+// MemberTransferRequest defines model for MemberTransferRequest.
+type MemberTransferRequest struct {
+	ToUnit string `json:"toUnit"`
+}
+
+// MoveUp defines model for MoveUp.
+type MoveUp struct {
+	// EligibleFrom The member's birthday on which she becomes old enough for the next section.
+	EligibleFrom openapi_types.Date `json:"eligibleFrom"`
+	Member       Member             `json:"member"`
+
+	// Overdue True if she was already old enough before the term started.
+	Overdue   bool    `json:"overdue"`
+	ToSection Section `json:"toSection"`
+}
+
+// MoveUpReport defines model for MoveUpReport.
+type MoveUpReport struct {
+	// End The last day of the term.
+	End     openapi_types.Date `json:"end"`
+	MoveUps []MoveUp           `json:"moveUps"`
+	Start   openapi_types.Date `json:"start"`
+	Term    string             `json:"term"`
+
+	// Units The units in the sections the members are moving up to, with their free places.
+	Units []AdminUnit `json:"units"`
+}
+
+// Parent defines model for Parent.
+type Parent struct {
+	// CsrfToken The token to send in the X-CSRF-Token header with requests that change anything.
+	CsrfToken string              `json:"csrfToken"`
+	Email     openapi_types.Email `json:"email"`
+
+	// ExpiresAt When the parent's session expires, if it isn't used before then.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ParentLoginInput defines model for ParentLoginInput.
+type ParentLoginInput struct {
+	CaptchaToken string              `json:"captchaToken"`
+	Email        openapi_types.Email `json:"email"`
+}
+
+// ParentSessionInput defines model for ParentSessionInput.
+type ParentSessionInput struct {
+	// Token The token from the sign in link.
+	Token string `json:"token"`
+}
+
+// PlaceOffer defines model for PlaceOffer.
+type PlaceOffer struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy The admin who made the offer, or system if it was offered automatically.
+	CreatedBy     string             `json:"createdBy"`
+	ExpiresAt     time.Time          `json:"expiresAt"`
+	Id            openapi_types.UUID `json:"id"`
+	JoinRequestId openapi_types.UUID `json:"joinRequestId"`
+	RespondedAt   *time.Time         `json:"respondedAt,omitempty"`
+	Status        PlaceOfferStatus   `json:"status"`
+	Unit          string             `json:"unit"`
+}
+
+// PlaceOfferResponse defines model for PlaceOfferResponse.
+type PlaceOfferResponse struct {
+	Accept bool   `json:"accept"`
+	Token  string `json:"token"`
+}
+
+// PlaceOfferStatus defines model for PlaceOfferStatus.
+type PlaceOfferStatus string
+
+// RatioCheck defines model for RatioCheck.
+type RatioCheck struct {
+	AdultHelpers   int  `json:"adultHelpers"`
+	Met            bool `json:"met"`
+	RequiredAdults int  `json:"requiredAdults"`
+}
+
+// RatioCheckRequest defines model for RatioCheckRequest.
+type RatioCheckRequest struct {
+	// Activity The kind of activity, which decides the ratio rules that apply. Defaults to meeting.
+	Activity     Activity `json:"activity"`
+	AdultHelpers *int     `json:"adultHelpers,omitempty"`
+
+	// Girls The number of girls attending from each section.
+	Girls map[string]int `json:"girls"`
+}
+
+// RatioEnforcement What happens when the event does not meet the adult to child ratio. Defaults to flag.
+// - flag: the event is marked as not meeting the ratio, but sign-ups are still confirmed.
+// - block: sign-ups that would break the ratio join the waiting list, and the adult helpers cannot be reduced below the ratio.
+type RatioEnforcement string
+
+// RatioRule defines model for RatioRule.
+type RatioRule struct {
+	// Activity The kind of activity, which decides the ratio rules that apply. Defaults to meeting.
+	Activity      Activity `json:"activity"`
+	GirlsPerAdult int      `json:"girlsPerAdult"`
+
+	// MinAdults The minimum number of adults whenever any girls from the section attend.
+	MinAdults int     `json:"minAdults"`
+	Section   Section `json:"section"`
+}
+
+// RatioRules defines model for RatioRules.
+type RatioRules struct {
+	Rules []RatioRule `json:"rules"`
+}
+
+// Revocation defines model for Revocation.
+type Revocation struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	Email     openapi_types.Email `json:"email"`
+	Id        openapi_types.UUID  `json:"id"`
+	Reason    *string             `json:"reason,omitempty"`
+	RevokedBy string              `json:"revokedBy"`
+}
+
+// RevocationInput defines model for RevocationInput.
+type RevocationInput struct {
+	Email  openapi_types.Email `json:"email"`
+	Reason *string             `json:"reason,omitempty"`
+}
+
+// Revocations defines model for Revocations.
+type Revocations struct {
+	Revocations []Revocation `json:"revocations"`
+}
+
+// RoleAssignment defines model for RoleAssignment.
+type RoleAssignment struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	CreatedBy string              `json:"createdBy"`
+	Email     openapi_types.Email `json:"email"`
+	Id        openapi_types.UUID  `json:"id"`
+
+	// Role - district_commissioner: can do everything, across the district.
+	// - unit_leader: can manage their unit, including its members' sensitive details.
+	// - treasurer: can see records, but not sensitive details, across the district or for their unit.
+	// - helper: can see their unit's records, but not sensitive details, and can't change anything.
+	Role RoleName `json:"role"`
+
+	// Unit The unit the role is for. Absent for roles across the whole district.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// RoleAssignmentInput defines model for RoleAssignmentInput.
+type RoleAssignmentInput struct {
+	Email openapi_types.Email `json:"email"`
+
+	// Role - district_commissioner: can do everything, across the district.
+	// - unit_leader: can manage their unit, including its members' sensitive details.
+	// - treasurer: can see records, but not sensitive details, across the district or for their unit.
+	// - helper: can see their unit's records, but not sensitive details, and can't change anything.
+	Role RoleName `json:"role"`
+
+	// Unit The unit the role is for. Absent for roles across the whole district.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// RoleAssignments defines model for RoleAssignments.
+type RoleAssignments struct {
+	Roles []RoleAssignment `json:"roles"`
+}
+
+// RoleName - district_commissioner: can do everything, across the district.
+// - unit_leader: can manage their unit, including its members' sensitive details.
+// - treasurer: can see records, but not sensitive details, across the district or for their unit.
+// - helper: can see their unit's records, but not sensitive details, and can't change anything.
+type RoleName string
+
+// Section defines model for Section.
+type Section string
+
+// SignupStatus defines model for SignupStatus.
+type SignupStatus string
+
+// Unit defines model for Unit.
+type Unit struct {
+	// Id Short name used in URLs, such as 1st-brownies.
+	Id      string  `json:"id"`
+	Name    string  `json:"name"`
+	Section Section `json:"section"`
+}
+
+// UnitSettings defines model for UnitSettings.
+type UnitSettings struct {
+	Capacity    *int                 `json:"capacity,omitempty"`
+	LeaderEmail *openapi_types.Email `json:"leaderEmail,omitempty"`
+}
+
+// WaitingList defines model for WaitingList.
+type WaitingList struct {
+	Entries []WaitingListEntry `json:"entries"`
+	Unit    Unit               `json:"unit"`
+}
+
+// WaitingListEntry defines model for WaitingListEntry.
+type WaitingListEntry struct {
+	// AgeOutDate The date the child becomes too old for the unit's section.
+	AgeOutDate  openapi_types.Date `json:"ageOutDate"`
+	ChildName   string             `json:"childName"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	DateOfBirth openapi_types.Date `json:"dateOfBirth"`
+
+	// EligibleSections The sections the child was old enough to join when she registered.
+	EligibleSections []Section           `json:"eligibleSections"`
+	Id               openapi_types.UUID  `json:"id"`
+	Notes            *string             `json:"notes,omitempty"`
+	ParentEmail      openapi_types.Email `json:"parentEmail"`
+	ParentName       string              `json:"parentName"`
+	ParentPhone      *string             `json:"parentPhone,omitempty"`
+	Postcode         string              `json:"postcode"`
+	PreferredUnits   []string            `json:"preferredUnits"`
+	Status           JoinRequestStatus   `json:"status"`
+}
+
+// APIKeyID defines model for APIKeyID.
+type APIKeyID = openapi_types.UUID
+
+// AuditActionQuery defines model for AuditActionQuery.
+type AuditActionQuery = string
+
+// AuditActorQuery defines model for AuditActorQuery.
+type AuditActorQuery = string
+
+// AuditSinceQuery defines model for AuditSinceQuery.
+type AuditSinceQuery = time.Time
+
+// AuditTargetIDQuery defines model for AuditTargetIDQuery.
+type AuditTargetIDQuery = string
+
+// AuditTargetTypeQuery defines model for AuditTargetTypeQuery.
+type AuditTargetTypeQuery = string
+
+// AuditUntilQuery defines model for AuditUntilQuery.
+type AuditUntilQuery = time.Time
+
+// EventID defines model for EventID.
+type EventID = openapi_types.UUID
+
+// FromQuery defines model for FromQuery.
+type FromQuery = time.Time
+
+// InvitationID defines model for InvitationID.
+type InvitationID = openapi_types.UUID
+
+// JoinRequestID defines model for JoinRequestID.
+type JoinRequestID = openapi_types.UUID
+
+// MemberID defines model for MemberID.
+type MemberID = openapi_types.UUID
+
+// OfferID defines model for OfferID.
+type OfferID = openapi_types.UUID
+
+// RevocationID defines model for RevocationID.
+type RevocationID = openapi_types.UUID
+
+// RoleID defines model for RoleID.
+type RoleID = openapi_types.UUID
+
+// SessionID defines model for SessionID.
+type SessionID = openapi_types.UUID
+
+// SignupID defines model for SignupID.
+type SignupID = openapi_types.UUID
+
+// ToQuery defines model for ToQuery.
+type ToQuery = time.Time
+
+// UnitID defines model for UnitID.
+type UnitID = string
+
+// UnitQuery defines model for UnitQuery.
+type UnitQuery = string
+
+// AdminListAuditLogParams defines parameters for AdminListAuditLog.
+type AdminListAuditLogParams struct {
+	// Actor Only include actions by this admin.
+	Actor *AuditActorQuery `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action Only include this kind of action, such as member.update.
+	Action *AuditActionQuery `form:"action,omitempty" json:"action,omitempty"`
+
+	// TargetType Only include actions on this kind of record, such as member.
+	TargetType *AuditTargetTypeQuery `form:"targetType,omitempty" json:"targetType,omitempty"`
+
+	// TargetId Only include actions on this record.
+	TargetId *AuditTargetIDQuery `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// Since Only include actions at or after this time.
+	Since *AuditSinceQuery `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only include actions before this time.
+	Until *AuditUntilQuery `form:"until,omitempty" json:"until,omitempty"`
+
+	// Before Only include entries before this one, to get the next page.
+	Before *int64 `form:"before,omitempty" json:"before,omitempty"`
+	Limit  *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// AdminExportAuditLogParams defines parameters for AdminExportAuditLog.
+type AdminExportAuditLogParams struct {
+	// Actor Only include actions by this admin.
+	Actor *AuditActorQuery `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action Only include this kind of action, such as member.update.
+	Action *AuditActionQuery `form:"action,omitempty" json:"action,omitempty"`
+
+	// TargetType Only include actions on this kind of record, such as member.
+	TargetType *AuditTargetTypeQuery `form:"targetType,omitempty" json:"targetType,omitempty"`
+
+	// TargetId Only include actions on this record.
+	TargetId *AuditTargetIDQuery `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// Since Only include actions at or after this time.
+	Since *AuditSinceQuery `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only include actions before this time.
+	Until *AuditUntilQuery `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// AdminListEventsParams defines parameters for AdminListEvents.
+type AdminListEventsParams struct {
+	// From Only include events ending at or after this time. Defaults to now.
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include events starting before this time. Defaults to a year after `from`.
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// Unit Only include district-wide events and events for this unit.
+	Unit *UnitQuery `form:"unit,omitempty" json:"unit,omitempty"`
+}
+
+// AdminGetMoveUpReportParams defines parameters for AdminGetMoveUpReport.
+type AdminGetMoveUpReportParams struct {
+	// Term The term, such as 2026-autumn. Defaults to the current term. Spring runs from January to March, summer
+	// from April to August and autumn from September to December.
+	Term *string `form:"term,omitempty" json:"term,omitempty"`
+}
+
+// AdminListSessionsParams defines parameters for AdminListSessions.
+type AdminListSessionsParams struct {
+	// Email Only list this admin's sessions.
+	Email *openapi_types.Email `form:"email,omitempty" json:"email,omitempty"`
+}
+
+// AdminGetWaitingListParams defines parameters for AdminGetWaitingList.
+type AdminGetWaitingListParams struct {
+	// Order Order by registration date, oldest first, or by age-out date, soonest first. Defaults to registered.
+	Order *AdminGetWaitingListParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// AdminGetWaitingListParamsOrder defines parameters for AdminGetWaitingList.
+type AdminGetWaitingListParamsOrder string
+
+// GetCalendarParams defines parameters for GetCalendar.
+type GetCalendarParams struct {
+	// Unit Only include district-wide events and events for this unit.
+	Unit *UnitQuery `form:"unit,omitempty" json:"unit,omitempty"`
+}
+
+// ListEventsParams defines parameters for ListEvents.
+type ListEventsParams struct {
+	// From Only include events ending at or after this time. Defaults to now.
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include events starting before this time. Defaults to a year after `from`.
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// Unit Only include district-wide events and events for this unit.
+	Unit *UnitQuery `form:"unit,omitempty" json:"unit,omitempty"`
+}
+
+// AdminCreateAPIKeyJSONRequestBody defines body for AdminCreateAPIKey for application/json ContentType.
+type AdminCreateAPIKeyJSONRequestBody = APIKeyInput
+
+// AdminCreateEventJSONRequestBody defines body for AdminCreateEvent for application/json ContentType.
+type AdminCreateEventJSONRequestBody = EventInput
+
+// AdminUpdateEventJSONRequestBody defines body for AdminUpdateEvent for application/json ContentType.
+type AdminUpdateEventJSONRequestBody = EventInput
+
+// AdminCreateInvitationJSONRequestBody defines body for AdminCreateInvitation for application/json ContentType.
+type AdminCreateInvitationJSONRequestBody = InvitationInput
+
+// AdminUpdateMemberJSONRequestBody defines body for AdminUpdateMember for application/json ContentType.
+type AdminUpdateMemberJSONRequestBody = MemberInput
+
+// AdminSetMemberSensitiveJSONRequestBody defines body for AdminSetMemberSensitive for application/json ContentType.
+type AdminSetMemberSensitiveJSONRequestBody = MemberSensitive
+
+// AdminTransferMemberJSONRequestBody defines body for AdminTransferMember for application/json ContentType.
+type AdminTransferMemberJSONRequestBody = MemberTransferRequest
+
+// AdminCheckRatioJSONRequestBody defines body for AdminCheckRatio for application/json ContentType.
+type AdminCheckRatioJSONRequestBody = RatioCheckRequest
+
+// AdminSaveRatioRulesJSONRequestBody defines body for AdminSaveRatioRules for application/json ContentType.
+type AdminSaveRatioRulesJSONRequestBody = RatioRules
+
+// AdminRevokeUserJSONRequestBody defines body for AdminRevokeUser for application/json ContentType.
+type AdminRevokeUserJSONRequestBody = RevocationInput
+
+// AdminAssignRoleJSONRequestBody defines body for AdminAssignRole for application/json ContentType.
+type AdminAssignRoleJSONRequestBody = RoleAssignmentInput
+
+// AdminUpdateUnitJSONRequestBody defines body for AdminUpdateUnit for application/json ContentType.
+type AdminUpdateUnitJSONRequestBody = UnitSettings
+
+// AdminCreateMemberJSONRequestBody defines body for AdminCreateMember for application/json ContentType.
+type AdminCreateMemberJSONRequestBody = MemberInput
+
 // ContactUsJSONRequestBody defines body for ContactUs for application/json ContentType.
 type ContactUsJSONRequestBody = ContactUsMessage
+
+// SignUpForEventJSONRequestBody defines body for SignUpForEvent for application/json ContentType.
+type SignUpForEventJSONRequestBody = EventSignupInput
+
+// CancelEventSignupJSONRequestBody defines body for CancelEventSignup for application/json ContentType.
+type CancelEventSignupJSONRequestBody = CancelEventSignupRequest
+
+// CreateJoinRequestJSONRequestBody defines body for CreateJoinRequest for application/json ContentType.
+type CreateJoinRequestJSONRequestBody = JoinRequestInput
+
+// CreateParentLoginJSONRequestBody defines body for CreateParentLogin for application/json ContentType.
+type CreateParentLoginJSONRequestBody = ParentLoginInput
+
+// CreateParentSessionJSONRequestBody defines body for CreateParentSession for application/json ContentType.
+type CreateParentSessionJSONRequestBody = ParentSessionInput
+
+// RespondToPlaceOfferJSONRequestBody defines body for RespondToPlaceOffer for application/json ContentType.
+type RespondToPlaceOfferJSONRequestBody = PlaceOfferResponse
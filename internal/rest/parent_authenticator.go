@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ParentCookie holds the parent's session token. It is separate from the admin's session cookie, so signing in as a
+// parent never gives admin access, or the other way round.
+const ParentCookie = "__Host-district-family"
+
+// ParentAuthenticator lets in parents signed in with a session from a sign in link. It has nothing to do with the
+// JWTAuthenticator, as parents don't have accounts with any of the identity providers.
+type ParentAuthenticator struct {
+	sessions ParentSessionStore
+	config   SessionConfig
+}
+
+func NewParentAuthenticator(sessions ParentSessionStore, config SessionConfig) *ParentAuthenticator {
+	return &ParentAuthenticator{
+		sessions: sessions,
+		config:   config,
+	}
+}
+
+// Validate authenticates the request with its parent session cookie. Requests that could change anything must also
+// send the session's CSRF token.
+func (a *ParentAuthenticator) Validate(ctx *fiber.Ctx) error {
+	token := ctx.Cookies(ParentCookie)
+	if token == "" {
+		return parentAuthError(ctx, fiber.StatusUnauthorized, consts.AuthReasonMissingToken, "you need to sign in")
+	}
+
+	userCtx := ctx.UserContext()
+	now := time.Now()
+
+	session, err := a.sessions.GetParentSession(userCtx, hashToken(token), now)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		ctx.Set(fiber.HeaderSetCookie, clearedSessionCookie(ParentCookie))
+		return parentAuthError(ctx, fiber.StatusUnauthorized, consts.AuthReasonSessionExpired, "your session has ended")
+	case err != nil:
+		slog.Error("failed to get parent session", "err", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to get session")
+	}
+
+	if !safeMethod(ctx.Method()) && subtle.ConstantTimeCompare([]byte(ctx.Get(CSRFHeader)), []byte(session.CSRFToken)) != 1 {
+		slog.Error("parent CSRF token missing or wrong", "session", session.ID, "method", ctx.Method(), "path", ctx.Path())
+		return parentAuthError(ctx, fiber.StatusForbidden, consts.AuthReasonInvalidCSRFToken, "the request's CSRF token doesn't match your session")
+	}
+
+	if now.Sub(session.LastSeenAt) >= sessionTouchInterval {
+		session.ExpiresAt = sessionExpiry(session.CreatedAt, now, a.config)
+
+		// The session can still be used until it expires, so failing to extend it shouldn't fail the request.
+		if err := a.sessions.TouchParentSession(userCtx, session.ID, now, session.ExpiresAt); err != nil {
+			slog.Error("failed to touch parent session", "err", err, "session", session.ID)
+		}
+	}
+
+	ctx.SetUserContext(context.WithValue(userCtx, ParentKey{}, session))
+
+	return ctx.Next()
+}
+
+// parentAuthError responds with why the request couldn't be authenticated. There's no WWW-Authenticate challenge, as
+// parents can only sign in with a cookie.
+func parentAuthError(ctx *fiber.Ctx, status int, reason, message string) error {
+	return ctx.Status(status).JSON(ErrorResponse{ErrorMessage: message, Reason: &reason})
+}
+
+type ParentKey struct{}
+
+// ParentFromContext returns the session of the parent the request has been authenticated as.
+func ParentFromContext(ctx context.Context) (ParentSession, bool) {
+	p, ok := ctx.Value(ParentKey{}).(ParentSession)
+	return p, ok
+}
@@ -0,0 +1,133 @@
+package rest_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	mock_rest "github.com/girlguidingstaplehurst/district/internal/rest/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newParentApp(t *testing.T) (*fiber.App, *mock_rest.MockParentSessionStore) {
+	sessions := mock_rest.NewMockParentSessionStore(gomock.NewController(t))
+
+	app := fiber.New()
+	app.Use(rest.NewParentAuthenticator(sessions, testParentLogins.Sessions).Validate)
+	reply := func(c *fiber.Ctx) error {
+		p, _ := rest.ParentFromContext(c.UserContext())
+		return c.SendString(p.Email)
+	}
+	app.Get("/", reply)
+	app.Post("/", reply)
+
+	return app, sessions
+}
+
+func parentRequest(method, token, csrfToken string) *http.Request {
+	req := httptest.NewRequest(method, "/", nil)
+	req.AddCookie(&http.Cookie{Name: rest.ParentCookie, Value: token})
+	if csrfToken != "" {
+		req.Header.Set(rest.CSRFHeader, csrfToken)
+	}
+	return req
+}
+
+func TestParentAuthenticator_Validate(t *testing.T) {
+	tokenHash := sha256.Sum256([]byte("parent-token"))
+
+	session := func(lastSeen time.Duration) rest.ParentSession {
+		now := time.Now()
+		return rest.ParentSession{
+			ID:         uuid.New(),
+			TokenHash:  tokenHash[:],
+			Email:      "parent@example.com",
+			CSRFToken:  "csrf-token",
+			CreatedAt:  now.Add(-time.Hour),
+			LastSeenAt: now.Add(-lastSeen),
+			ExpiresAt:  now.Add(testParentLogins.Sessions.Idle - lastSeen),
+		}
+	}
+
+	t.Run("sessions are let in", func(t *testing.T) {
+		app, sessions := newParentApp(t)
+
+		sessions.EXPECT().GetParentSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(session(time.Second), nil)
+
+		resp, err := app.Test(parentRequest(http.MethodGet, "parent-token", ""))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("sessions are extended as they're used", func(t *testing.T) {
+		app, sessions := newParentApp(t)
+		s := session(10 * time.Minute)
+
+		sessions.EXPECT().GetParentSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(s, nil)
+		sessions.EXPECT().TouchParentSession(gomock.Any(), s.ID, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, now, expiresAt time.Time) error {
+				assert.Equal(t, now.Add(testParentLogins.Sessions.Idle), expiresAt)
+				return nil
+			})
+
+		resp, err := app.Test(parentRequest(http.MethodGet, "parent-token", ""))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("requests without a session are rejected", func(t *testing.T) {
+		app, _ := newParentApp(t)
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusUnauthorized, consts.AuthReasonMissingToken)
+	})
+
+	t.Run("admin sessions aren't accepted", func(t *testing.T) {
+		app, _ := newParentApp(t)
+
+		resp, err := app.Test(sessionRequest(http.MethodGet, "parent-token", ""))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusUnauthorized, consts.AuthReasonMissingToken)
+	})
+
+	t.Run("ended sessions are rejected", func(t *testing.T) {
+		app, sessions := newParentApp(t)
+
+		sessions.EXPECT().GetParentSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(rest.ParentSession{}, consts.ErrNotFound)
+
+		resp, err := app.Test(parentRequest(http.MethodGet, "parent-token", ""))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusUnauthorized, consts.AuthReasonSessionExpired)
+		assert.Contains(t, resp.Header.Get(fiber.HeaderSetCookie), rest.ParentCookie+"=;")
+	})
+
+	t.Run("changes need the CSRF token", func(t *testing.T) {
+		app, sessions := newParentApp(t)
+
+		sessions.EXPECT().GetParentSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(session(time.Second), nil)
+
+		resp, err := app.Test(parentRequest(http.MethodPost, "parent-token", "wrong"))
+		require.NoError(t, err)
+		assertAuthError(t, resp, fiber.StatusForbidden, consts.AuthReasonInvalidCSRFToken)
+	})
+
+	t.Run("changes with the CSRF token are let in", func(t *testing.T) {
+		app, sessions := newParentApp(t)
+
+		sessions.EXPECT().GetParentSession(gomock.Any(), tokenHash[:], gomock.Any()).Return(session(time.Second), nil)
+
+		resp, err := app.Test(parentRequest(http.MethodPost, "parent-token", "csrf-token"))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+}
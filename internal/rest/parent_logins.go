@@ -0,0 +1,156 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/thanhpk/randstr"
+)
+
+const parentLoginTokenLength = 43
+
+func (s *Server) CreateParentLogin(ctx context.Context, request CreateParentLoginRequestObject) (CreateParentLoginResponseObject, error) {
+	ip, _ := UserIPFromContext(ctx)
+	if err := s.captcha.Verify(ctx, request.Body.CaptchaToken, ip); err != nil {
+		slog.Error("captcha verification failed", "err", err)
+		return CreateParentLogin422JSONResponse{ErrorMessage: "captcha verification failed"}, nil
+	}
+
+	now := time.Now()
+
+	limit := s.limits.Load().ParentLogins
+
+	recent, err := s.db.CountParentLoginsFromIP(ctx, ip, now.Add(-limit.Window))
+	if err != nil {
+		slog.Error("failed to count parent logins", "err", err)
+		return CreateParentLogin500JSONResponse{ErrorMessage: "failed to send sign in link"}, nil
+	}
+
+	if recent >= limit.Limit {
+		slog.Warn("too many parent logins", "ip", ip, "count", recent)
+		return CreateParentLogin429JSONResponse{ErrorMessage: "too many requests, please try again later"}, nil
+	}
+
+	email := strings.ToLower(string(request.Body.Email))
+	token := randstr.Base62(parentLoginTokenLength)
+	expiresAt := now.Add(s.cfg.Parents.LinkExpiry)
+
+	// Every request is recorded, whether or not the email belongs to a family, so they all count towards the limit.
+	if err := s.db.CreateParentLogin(ctx, email, hashToken(token), ip, expiresAt); err != nil {
+		slog.Error("failed to create parent login", "err", err)
+		return CreateParentLogin500JSONResponse{ErrorMessage: "failed to send sign in link"}, nil
+	}
+
+	family, err := s.db.HasFamily(ctx, email)
+	if err != nil {
+		slog.Error("failed to check for family", "err", err)
+		return CreateParentLogin500JSONResponse{ErrorMessage: "failed to send sign in link"}, nil
+	}
+
+	if !family {
+		slog.Info("sign in link asked for by an email with no family", "ip", ip)
+		return CreateParentLogin202Response{}, nil
+	}
+
+	vars := map[string]any{
+		"LoginToken": token,
+		"ExpiresAt":  expiresAt,
+	}
+
+	// Failing to send is only logged, as responding differently would give away that the email belongs to a family.
+	if err := s.sendEmail(ctx, email, "parent-login-link", vars); err != nil {
+		slog.Error("failed to send parent login email", "err", err)
+	}
+
+	return CreateParentLogin202Response{}, nil
+}
+
+func (s *Server) CreateParentSession(ctx context.Context, request CreateParentSessionRequestObject) (CreateParentSessionResponseObject, error) {
+	now := time.Now()
+
+	email, err := s.db.RedeemParentLogin(ctx, hashToken(request.Body.Token), now)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return CreateParentSession401JSONResponse{ErrorMessage: "this sign in link has expired or has already been used"}, nil
+	case err != nil:
+		slog.Error("failed to redeem parent login", "err", err)
+		return CreateParentSession500JSONResponse{ErrorMessage: "failed to sign in"}, nil
+	}
+
+	token := randstr.Base62(sessionTokenLength)
+
+	session, err := s.db.CreateParentSession(ctx, ParentSession{
+		TokenHash:  hashToken(token),
+		Email:      email,
+		CSRFToken:  randstr.Base62(csrfTokenLength),
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  sessionExpiry(now, now, s.cfg.Parents.Sessions),
+	})
+	if err != nil {
+		slog.Error("failed to create parent session", "err", err)
+		return CreateParentSession500JSONResponse{ErrorMessage: "failed to sign in"}, nil
+	}
+
+	slog.Info("parent session started", "session", session.ID)
+
+	return CreateParentSession201JSONResponse{
+		Body:    parent(session),
+		Headers: CreateParentSession201ResponseHeaders{SetCookie: sessionCookie(ParentCookie, token, session.CreatedAt.Add(s.cfg.Parents.Sessions.Lifetime))},
+	}, nil
+}
+
+func (s *Server) DeleteParentSession(ctx context.Context, request DeleteParentSessionRequestObject) (DeleteParentSessionResponseObject, error) {
+	session, _ := ParentFromContext(ctx)
+	if err := s.db.DeleteParentSession(ctx, session.ID); err != nil {
+		slog.Error("failed to delete parent session", "err", err)
+		return DeleteParentSession500JSONResponse{ErrorMessage: "failed to sign out"}, nil
+	}
+
+	slog.Info("parent session ended", "session", session.ID)
+
+	return DeleteParentSession204Response{Headers: DeleteParentSession204ResponseHeaders{SetCookie: clearedSessionCookie(ParentCookie)}}, nil
+}
+
+func (s *Server) GetFamily(ctx context.Context, request GetFamilyRequestObject) (GetFamilyResponseObject, error) {
+	session, _ := ParentFromContext(ctx)
+
+	members, err := s.db.ListFamilyMembers(ctx, session.Email)
+	if err != nil {
+		slog.Error("failed to list family members", "err", err)
+		return GetFamily500JSONResponse{ErrorMessage: "failed to get family"}, nil
+	}
+
+	signups, err := s.db.ListFamilySignups(ctx, session.Email)
+	if err != nil {
+		slog.Error("failed to list family signups", "err", err)
+		return GetFamily500JSONResponse{ErrorMessage: "failed to get family"}, nil
+	}
+
+	if members == nil {
+		members = []Member{}
+	}
+
+	if signups == nil {
+		signups = []EventSignup{}
+	}
+
+	return GetFamily200JSONResponse{
+		Parent:  parent(session),
+		Members: members,
+		Signups: signups,
+	}, nil
+}
+
+func parent(session ParentSession) Parent {
+	return Parent{
+		Email:     openapi_types.Email(session.Email),
+		ExpiresAt: session.ExpiresAt,
+		CsrfToken: session.CSRFToken,
+	}
+}
@@ -0,0 +1,170 @@
+package rest_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_CreateParentLogin(t *testing.T) {
+	ctx := context.Background()
+	input := rest.ParentLoginInput{Email: "Parent@Example.com", CaptchaToken: "token"}
+
+	t.Run("emails a link to families", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		var tokenHash []byte
+		m.captcha.EXPECT().Verify(ctx, "token", "").Return(nil)
+		m.db.EXPECT().CountParentLoginsFromIP(ctx, "", gomock.Any()).Return(0, nil)
+		m.db.EXPECT().CreateParentLogin(ctx, "parent@example.com", gomock.Any(), "", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, hash []byte, _ string, expiresAt time.Time) error {
+				tokenHash = hash
+				assert.WithinDuration(t, time.Now().Add(testParentLogins.LinkExpiry), expiresAt, time.Minute)
+				return nil
+			})
+		m.db.EXPECT().HasFamily(ctx, "parent@example.com").Return(true, nil)
+		m.content.EXPECT().EmailTemplate(ctx, "parent-login-link", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, vars map[string]any) (rest.EmailContent, error) {
+				// Only the hash is stored, so the link can't be used by anyone who can read the database.
+				hash := sha256.Sum256([]byte(vars["LoginToken"].(string)))
+				assert.Equal(t, hash[:], tokenHash)
+				return rest.EmailContent{Subject: "subject", Body: "body"}, nil
+			})
+		m.email.EXPECT().Send(ctx, "parent@example.com", "subject", "body").Return(nil)
+
+		resp, err := s.CreateParentLogin(ctx, rest.CreateParentLoginRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.Equal(t, rest.CreateParentLogin202Response{}, resp)
+	})
+
+	t.Run("doesn't email anyone else, or say so", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.captcha.EXPECT().Verify(ctx, "token", "").Return(nil)
+		m.db.EXPECT().CountParentLoginsFromIP(ctx, "", gomock.Any()).Return(0, nil)
+		m.db.EXPECT().CreateParentLogin(ctx, "parent@example.com", gomock.Any(), "", gomock.Any()).Return(nil)
+		m.db.EXPECT().HasFamily(ctx, "parent@example.com").Return(false, nil)
+
+		resp, err := s.CreateParentLogin(ctx, rest.CreateParentLoginRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.Equal(t, rest.CreateParentLogin202Response{}, resp)
+	})
+
+	t.Run("failing to send the email isn't given away", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.captcha.EXPECT().Verify(ctx, "token", "").Return(nil)
+		m.db.EXPECT().CountParentLoginsFromIP(ctx, "", gomock.Any()).Return(0, nil)
+		m.db.EXPECT().CreateParentLogin(ctx, "parent@example.com", gomock.Any(), "", gomock.Any()).Return(nil)
+		m.db.EXPECT().HasFamily(ctx, "parent@example.com").Return(true, nil)
+		m.content.EXPECT().EmailTemplate(ctx, "parent-login-link", gomock.Any()).Return(rest.EmailContent{}, errors.New("boom"))
+
+		resp, err := s.CreateParentLogin(ctx, rest.CreateParentLoginRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.Equal(t, rest.CreateParentLogin202Response{}, resp)
+	})
+
+	t.Run("rate limited by IP", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.captcha.EXPECT().Verify(ctx, "token", "").Return(nil)
+		m.db.EXPECT().CountParentLoginsFromIP(ctx, "", gomock.Any()).Return(5, nil)
+
+		resp, err := s.CreateParentLogin(ctx, rest.CreateParentLoginRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.IsType(t, rest.CreateParentLogin429JSONResponse{}, resp)
+	})
+
+	t.Run("captcha failure", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.captcha.EXPECT().Verify(ctx, "token", "").Return(errors.New("invalid"))
+
+		resp, err := s.CreateParentLogin(ctx, rest.CreateParentLoginRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.IsType(t, rest.CreateParentLogin422JSONResponse{}, resp)
+	})
+}
+
+func TestServer_CreateParentSession(t *testing.T) {
+	ctx := context.Background()
+	tokenHash := sha256.Sum256([]byte("link-token"))
+	input := rest.ParentSessionInput{Token: "link-token"}
+
+	t.Run("starts a session", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().RedeemParentLogin(ctx, tokenHash[:], gomock.Any()).Return("parent@example.com", nil)
+		m.db.EXPECT().CreateParentSession(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, session rest.ParentSession) (rest.ParentSession, error) {
+				assert.Equal(t, "parent@example.com", session.Email)
+				assert.Equal(t, session.CreatedAt.Add(testParentLogins.Sessions.Idle), session.ExpiresAt)
+				session.ID = uuid.New()
+				return session, nil
+			})
+
+		resp, err := s.CreateParentSession(ctx, rest.CreateParentSessionRequestObject{Body: &input})
+		require.NoError(t, err)
+		require.IsType(t, rest.CreateParentSession201JSONResponse{}, resp)
+
+		created := resp.(rest.CreateParentSession201JSONResponse)
+		assert.Equal(t, "parent@example.com", string(created.Body.Email))
+		assert.NotEmpty(t, created.Body.CsrfToken)
+		assert.Contains(t, created.Headers.SetCookie, rest.ParentCookie+"=")
+		assert.Contains(t, created.Headers.SetCookie, "HttpOnly")
+	})
+
+	t.Run("used or expired links", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().RedeemParentLogin(ctx, tokenHash[:], gomock.Any()).Return("", consts.ErrNotFound)
+
+		resp, err := s.CreateParentSession(ctx, rest.CreateParentSessionRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.IsType(t, rest.CreateParentSession401JSONResponse{}, resp)
+	})
+}
+
+func TestServer_GetFamily(t *testing.T) {
+	session := rest.ParentSession{ID: uuid.New(), Email: "parent@example.com", CSRFToken: "csrf-token"}
+	ctx := context.WithValue(context.Background(), rest.ParentKey{}, session)
+
+	t.Run("only the parent's own family", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		members := []rest.Member{{Id: uuid.New(), Name: "Ada", ParentEmail: "parent@example.com"}}
+		m.db.EXPECT().ListFamilyMembers(ctx, "parent@example.com").Return(members, nil)
+		m.db.EXPECT().ListFamilySignups(ctx, "parent@example.com").Return(nil, nil)
+
+		resp, err := s.GetFamily(ctx, rest.GetFamilyRequestObject{})
+		require.NoError(t, err)
+		assert.Equal(t, rest.GetFamily200JSONResponse{
+			Parent:  rest.Parent{Email: "parent@example.com", CsrfToken: "csrf-token"},
+			Members: members,
+			Signups: []rest.EventSignup{},
+		}, resp)
+	})
+}
+
+func TestServer_DeleteParentSession(t *testing.T) {
+	session := rest.ParentSession{ID: uuid.New(), Email: "parent@example.com"}
+	ctx := context.WithValue(context.Background(), rest.ParentKey{}, session)
+
+	s, m := newTestServer(t)
+
+	m.db.EXPECT().DeleteParentSession(ctx, session.ID).Return(nil)
+
+	resp, err := s.DeleteParentSession(ctx, rest.DeleteParentSessionRequestObject{})
+	require.NoError(t, err)
+	require.IsType(t, rest.DeleteParentSession204Response{}, resp)
+	assert.Contains(t, resp.(rest.DeleteParentSession204Response).Headers.SetCookie, rest.ParentCookie+"=;")
+}
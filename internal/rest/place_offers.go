@@ -0,0 +1,270 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/thanhpk/randstr"
+)
+
+const offerTokenLength = 32
+
+var errNotEligibleForUnit = errors.New("the child is not the right age for this unit")
+
+func (s *Server) AdminGetWaitingList(ctx context.Context, request AdminGetWaitingListRequestObject) (AdminGetWaitingListResponseObject, error) {
+	if !allowed(ctx, PermissionRead, &request.UnitID) {
+		return AdminGetWaitingList403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	unit, err := s.db.GetUnit(ctx, request.UnitID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminGetWaitingList404JSONResponse{ErrorMessage: "unit not found"}, nil
+	case err != nil:
+		slog.Error("failed to get unit", "err", err)
+		return AdminGetWaitingList500JSONResponse{ErrorMessage: "failed to get waiting list"}, nil
+	}
+
+	entries, err := s.waitingList(ctx, unit, time.Now())
+	if err != nil {
+		slog.Error("failed to list waiting list", "err", err)
+		return AdminGetWaitingList500JSONResponse{ErrorMessage: "failed to get waiting list"}, nil
+	}
+
+	if request.Params.Order != nil && *request.Params.Order == AgeOut {
+		slices.SortStableFunc(entries, func(a, b WaitingListEntry) int {
+			return a.AgeOutDate.Compare(b.AgeOutDate.Time)
+		})
+	}
+
+	return AdminGetWaitingList200JSONResponse{Unit: unit, Entries: entries}, nil
+}
+
+func (s *Server) AdminOfferPlace(ctx context.Context, request AdminOfferPlaceRequestObject) (AdminOfferPlaceResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, &request.UnitID) {
+		return AdminOfferPlace403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	unit, err := s.db.GetUnit(ctx, request.UnitID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminOfferPlace404JSONResponse{ErrorMessage: "unit not found"}, nil
+	case err != nil:
+		slog.Error("failed to get unit", "err", err)
+		return AdminOfferPlace500JSONResponse{ErrorMessage: "failed to offer place"}, nil
+	}
+
+	joinRequest, err := s.db.GetJoinRequest(ctx, request.JoinRequestID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminOfferPlace404JSONResponse{ErrorMessage: "join request not found"}, nil
+	case err != nil:
+		slog.Error("failed to get join request", "err", err)
+		return AdminOfferPlace500JSONResponse{ErrorMessage: "failed to offer place"}, nil
+	}
+
+	if !slices.Contains(eligibleSections(joinRequest.DateOfBirth.Time, time.Now()), unit.Section) {
+		return AdminOfferPlace422JSONResponse{ErrorMessage: errNotEligibleForUnit.Error()}, nil
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+
+	offer, err := s.offerPlace(ctx, joinRequest, unit, email)
+	switch {
+	case errors.Is(err, consts.ErrConflict):
+		return AdminOfferPlace409JSONResponse{ErrorMessage: "the child is not waiting for this unit"}, nil
+	case err != nil:
+		slog.Error("failed to offer place", "err", err)
+		return AdminOfferPlace500JSONResponse{ErrorMessage: "failed to offer place"}, nil
+	}
+
+	audit(ctx, "place.offer", "join_request", request.JoinRequestID.String(), nil, offer)
+
+	return AdminOfferPlace201JSONResponse(offer), nil
+}
+
+func (s *Server) RespondToPlaceOffer(ctx context.Context, request RespondToPlaceOfferRequestObject) (RespondToPlaceOfferResponseObject, error) {
+	offer, err := s.db.RespondToPlaceOffer(ctx, request.OfferID, hashToken(request.Body.Token), request.Body.Accept,
+		time.Now())
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return RespondToPlaceOffer404JSONResponse{ErrorMessage: "offer not found"}, nil
+	case errors.Is(err, consts.ErrConflict):
+		return RespondToPlaceOffer409JSONResponse{ErrorMessage: "this offer has expired or has already been answered"}, nil
+	case err != nil:
+		slog.Error("failed to respond to place offer", "err", err)
+		return RespondToPlaceOffer500JSONResponse{ErrorMessage: "failed to respond to offer"}, nil
+	}
+
+	if !request.Body.Accept {
+		s.offerNextPlace(ctx, offer.Unit)
+	}
+
+	return RespondToPlaceOffer200JSONResponse(offer), nil
+}
+
+func (s *Server) AdminGetJoinRequestHistory(ctx context.Context, request AdminGetJoinRequestHistoryRequestObject) (AdminGetJoinRequestHistoryResponseObject, error) {
+	joinRequest, err := s.db.GetJoinRequest(ctx, request.JoinRequestID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminGetJoinRequestHistory404JSONResponse{ErrorMessage: "join request not found"}, nil
+	case err != nil:
+		slog.Error("failed to get join request", "err", err)
+		return AdminGetJoinRequestHistory500JSONResponse{ErrorMessage: "failed to get history"}, nil
+	}
+
+	p, _ := PrincipalFromContext(ctx)
+	if !p.Allows(PermissionRead, nil) && !p.AllowsAny(PermissionRead, joinRequest.PreferredUnits) {
+		return AdminGetJoinRequestHistory403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	events, err := s.db.ListJoinRequestEvents(ctx, request.JoinRequestID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminGetJoinRequestHistory404JSONResponse{ErrorMessage: "join request not found"}, nil
+	case err != nil:
+		slog.Error("failed to list join request events", "err", err)
+		return AdminGetJoinRequestHistory500JSONResponse{ErrorMessage: "failed to get history"}, nil
+	}
+
+	if events == nil {
+		events = []JoinRequestEvent{}
+	}
+
+	return AdminGetJoinRequestHistory200JSONResponse{Events: events}, nil
+}
+
+// ExpirePlaceOffers expires the offers that have not been answered in time, offering each place to the next child on
+// the waiting list.
+func (s *Server) ExpirePlaceOffers(ctx context.Context) error {
+	offers, err := s.db.ExpirePlaceOffers(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, offer := range offers {
+		slog.Info("place offer expired", "offer", offer.Id, "unit", offer.Unit)
+		s.offerNextPlace(ctx, offer.Unit)
+	}
+
+	return nil
+}
+
+// RunPlaceOfferExpiry calls ExpirePlaceOffers every interval until the context is done.
+func (s *Server) RunPlaceOfferExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.ExpirePlaceOffers(ctx); err != nil {
+				slog.Error("failed to expire place offers", "err", err)
+			}
+		}
+	}
+}
+
+// waitingList returns the children waiting for the unit who are still the right age for it, in the order they
+// registered.
+func (s *Server) waitingList(ctx context.Context, unit Unit, now time.Time) ([]WaitingListEntry, error) {
+	joinRequests, err := s.db.ListWaitingList(ctx, unit.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []WaitingListEntry{}
+	for _, j := range joinRequests {
+		dob := j.DateOfBirth.Time
+		if !slices.Contains(eligibleSections(dob, now), unit.Section) {
+			continue
+		}
+
+		entries = append(entries, WaitingListEntry{
+			Id:               j.Id,
+			ChildName:        j.ChildName,
+			DateOfBirth:      j.DateOfBirth,
+			Postcode:         j.Postcode,
+			PreferredUnits:   j.PreferredUnits,
+			EligibleSections: j.EligibleSections,
+			ParentName:       j.ParentName,
+			ParentEmail:      j.ParentEmail,
+			ParentPhone:      j.ParentPhone,
+			Notes:            j.Notes,
+			Status:           j.Status,
+			CreatedAt:        j.CreatedAt,
+			AgeOutDate:       openapi_types.Date{Time: dob.AddDate(sectionAges[string(unit.Section)].MaxAge, 0, 0)},
+		})
+	}
+
+	return entries, nil
+}
+
+func (s *Server) offerPlace(ctx context.Context, joinRequest JoinRequest, unit Unit, offeredBy string) (PlaceOffer, error) {
+	token := randstr.Base62(offerTokenLength)
+
+	offer, err := s.db.OfferPlace(ctx, joinRequest.Id, unit.Id, hashToken(token), time.Now().Add(s.cfg.OfferExpiry),
+		offeredBy)
+	if err != nil {
+		return PlaceOffer{}, err
+	}
+
+	vars := map[string]any{
+		"ChildName":  joinRequest.ChildName,
+		"ParentName": joinRequest.ParentName,
+		"UnitName":   unit.Name,
+		"OfferId":    offer.Id,
+		"OfferToken": token,
+		"ExpiresAt":  offer.ExpiresAt,
+	}
+
+	if err := s.sendEmail(ctx, string(joinRequest.ParentEmail), "place-offered", vars); err != nil {
+		slog.Error("failed to send place offer email", "err", err, "offer", offer.Id)
+	}
+
+	return offer, nil
+}
+
+// offerNextPlace offers a place in the unit to the next eligible child on its waiting list, if there is one.
+// Failures are logged rather than returned, as the place can still be offered by hand.
+func (s *Server) offerNextPlace(ctx context.Context, unitID string) {
+	unit, err := s.db.GetUnit(ctx, unitID)
+	if err != nil {
+		slog.Error("failed to get unit", "err", err, "unit", unitID)
+		return
+	}
+
+	entries, err := s.waitingList(ctx, unit, time.Now())
+	if err != nil {
+		slog.Error("failed to list waiting list", "err", err, "unit", unitID)
+		return
+	}
+
+	if len(entries) == 0 {
+		slog.Info("no one left on the waiting list to offer a place to", "unit", unitID)
+		return
+	}
+
+	next := entries[0]
+	joinRequest := JoinRequest{
+		Id:          next.Id,
+		ChildName:   next.ChildName,
+		ParentName:  next.ParentName,
+		ParentEmail: next.ParentEmail,
+	}
+
+	offer, err := s.offerPlace(ctx, joinRequest, unit, consts.ActorSystem)
+	if err != nil {
+		slog.Error("failed to offer next place", "err", err, "unit", unitID, "joinRequest", next.Id)
+		return
+	}
+
+	slog.Info("offered place to next on the waiting list", "offer", offer.Id, "unit", unitID)
+}
@@ -0,0 +1,183 @@
+package rest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var brownies = rest.Unit{Id: "1st-brownies", Name: "1st Staplehurst Brownies", Section: consts.SectionBrownies}
+
+func waitingChild(name string, age, months int) rest.JoinRequest {
+	return rest.JoinRequest{
+		Id:          uuid.New(),
+		ChildName:   name,
+		DateOfBirth: openapi_types.Date{Time: time.Now().AddDate(-age, -months, 0)},
+		ParentName:  "Parent of " + name,
+		ParentEmail: openapi_types.Email(name + "@example.com"),
+		Status:      consts.JoinRequestStatusWaiting,
+	}
+}
+
+func TestServer_AdminGetWaitingList(t *testing.T) {
+	ctx := commissionerContext()
+
+	first := waitingChild("first", 7, 1)
+	aged := waitingChild("aged", 10, 1)
+	second := waitingChild("second", 9, 6)
+
+	tests := []struct {
+		name  string
+		order *rest.AdminGetWaitingListParamsOrder
+		want  []string
+	}{
+		{name: "in registration order", want: []string{"first", "second"}},
+		{name: "in age-out order", order: ptr(rest.AgeOut), want: []string{"second", "first"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, m := newTestServer(t)
+			m.db.EXPECT().GetUnit(ctx, brownies.Id).Return(brownies, nil)
+			m.db.EXPECT().ListWaitingList(ctx, brownies.Id).Return([]rest.JoinRequest{first, aged, second}, nil)
+
+			resp, err := s.AdminGetWaitingList(ctx, rest.AdminGetWaitingListRequestObject{
+				UnitID: brownies.Id,
+				Params: rest.AdminGetWaitingListParams{Order: tt.order},
+			})
+			require.NoError(t, err)
+			require.IsType(t, rest.AdminGetWaitingList200JSONResponse{}, resp)
+
+			var names []string
+			for _, e := range resp.(rest.AdminGetWaitingList200JSONResponse).Entries {
+				names = append(names, e.ChildName)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestServer_AdminOfferPlace(t *testing.T) {
+	ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+
+	t.Run("the parent is emailed a link to respond", func(t *testing.T) {
+		s, m := newTestServer(t)
+		child := waitingChild("ada", 8, 0)
+		offer := rest.PlaceOffer{Id: uuid.New(), JoinRequestId: child.Id, Unit: brownies.Id}
+
+		m.db.EXPECT().GetUnit(ctx, brownies.Id).Return(brownies, nil)
+		m.db.EXPECT().GetJoinRequest(ctx, child.Id).Return(child, nil)
+		m.db.EXPECT().OfferPlace(ctx, child.Id, brownies.Id, gomock.Len(32),
+			gomock.Cond(func(expiresAt time.Time) bool {
+				return expiresAt.Sub(time.Now().Add(testOfferExpiry)).Abs() < time.Minute
+			}), "leader@staplehurstguiding.org.uk").Return(offer, nil)
+		m.content.EXPECT().EmailTemplate(ctx, "place-offered", gomock.Any()).
+			Return(rest.EmailContent{Subject: "subject", Body: "body"}, nil)
+		m.email.EXPECT().Send(ctx, "ada@example.com", "subject", "body").Return(nil)
+
+		resp, err := s.AdminOfferPlace(ctx, rest.AdminOfferPlaceRequestObject{
+			UnitID:        brownies.Id,
+			JoinRequestID: child.Id,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminOfferPlace201JSONResponse(offer), resp)
+	})
+
+	t.Run("children too old for the unit cannot be offered a place", func(t *testing.T) {
+		s, m := newTestServer(t)
+		child := waitingChild("ada", 10, 0)
+
+		m.db.EXPECT().GetUnit(ctx, brownies.Id).Return(brownies, nil)
+		m.db.EXPECT().GetJoinRequest(ctx, child.Id).Return(child, nil)
+
+		resp, err := s.AdminOfferPlace(ctx, rest.AdminOfferPlaceRequestObject{
+			UnitID:        brownies.Id,
+			JoinRequestID: child.Id,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminOfferPlace422JSONResponse{ErrorMessage: "the child is not the right age for this unit"}, resp)
+	})
+
+	t.Run("children who are not waiting cannot be offered a place", func(t *testing.T) {
+		s, m := newTestServer(t)
+		child := waitingChild("ada", 8, 0)
+
+		m.db.EXPECT().GetUnit(ctx, brownies.Id).Return(brownies, nil)
+		m.db.EXPECT().GetJoinRequest(ctx, child.Id).Return(child, nil)
+		m.db.EXPECT().OfferPlace(ctx, child.Id, brownies.Id, gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(rest.PlaceOffer{}, consts.ErrConflict)
+
+		resp, err := s.AdminOfferPlace(ctx, rest.AdminOfferPlaceRequestObject{
+			UnitID:        brownies.Id,
+			JoinRequestID: child.Id,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminOfferPlace409JSONResponse{ErrorMessage: "the child is not waiting for this unit"}, resp)
+	})
+}
+
+func TestServer_RespondToPlaceOffer(t *testing.T) {
+	ctx := commissionerContext()
+	offerID := uuid.New()
+
+	t.Run("declining offers the place to the next child", func(t *testing.T) {
+		s, m := newTestServer(t)
+		next := waitingChild("next", 8, 0)
+		declined := rest.PlaceOffer{Id: offerID, Unit: brownies.Id, Status: consts.PlaceOfferStatusDeclined}
+
+		m.db.EXPECT().RespondToPlaceOffer(ctx, offerID, gomock.Len(32), false, gomock.Any()).Return(declined, nil)
+		m.db.EXPECT().GetUnit(ctx, brownies.Id).Return(brownies, nil)
+		m.db.EXPECT().ListWaitingList(ctx, brownies.Id).Return([]rest.JoinRequest{next}, nil)
+		m.db.EXPECT().OfferPlace(ctx, next.Id, brownies.Id, gomock.Any(), gomock.Any(), consts.ActorSystem).
+			Return(rest.PlaceOffer{Id: uuid.New()}, nil)
+		m.content.EXPECT().EmailTemplate(ctx, "place-offered", gomock.Any()).
+			Return(rest.EmailContent{Subject: "subject", Body: "body"}, nil)
+		m.email.EXPECT().Send(ctx, "next@example.com", "subject", "body").Return(nil)
+
+		resp, err := s.RespondToPlaceOffer(ctx, rest.RespondToPlaceOfferRequestObject{
+			OfferID: offerID,
+			Body:    &rest.PlaceOfferResponse{Token: "token", Accept: false},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.RespondToPlaceOffer200JSONResponse(declined), resp)
+	})
+
+	t.Run("expired offers cannot be accepted", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().RespondToPlaceOffer(ctx, offerID, gomock.Len(32), true, gomock.Any()).
+			Return(rest.PlaceOffer{}, consts.ErrConflict)
+
+		resp, err := s.RespondToPlaceOffer(ctx, rest.RespondToPlaceOfferRequestObject{
+			OfferID: offerID,
+			Body:    &rest.PlaceOfferResponse{Token: "token", Accept: true},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.RespondToPlaceOffer409JSONResponse{
+			ErrorMessage: "this offer has expired or has already been answered",
+		}, resp)
+	})
+}
+
+func TestServer_ExpirePlaceOffers(t *testing.T) {
+	ctx := commissionerContext()
+	s, m := newTestServer(t)
+
+	m.db.EXPECT().ExpirePlaceOffers(ctx, gomock.Any()).
+		Return([]rest.PlaceOffer{{Id: uuid.New(), Unit: brownies.Id}}, nil)
+	m.db.EXPECT().GetUnit(ctx, brownies.Id).Return(brownies, nil)
+	m.db.EXPECT().ListWaitingList(ctx, brownies.Id).Return([]rest.JoinRequest{waitingChild("aged", 10, 1)}, nil)
+
+	require.NoError(t, s.ExpirePlaceOffers(ctx))
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
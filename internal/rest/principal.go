@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Principal is the admin a request has been authenticated as.
+type Principal struct {
+	Email        string
+	Name         string
+	HostedDomain string
+	Roles        Roles
+	// Expiry is when the admin's sign in expires, or zero if it doesn't.
+	Expiry time.Time
+	// SessionID is the cookie session the admin signed in with, or uuid.Nil if they used a bearer token.
+	SessionID uuid.UUID
+	CSRFToken string
+	// APIKeyID is the API key the request was made with, or uuid.Nil if it wasn't.
+	APIKeyID uuid.UUID
+	// Scopes limit the permissions the roles give, when the request was made with an API key. Nil allows everything
+	// the roles do.
+	Scopes []Permission
+}
+
+// Allows reports whether the roles, limited to the scopes, give the permission for the unit.
+func (p Principal) Allows(permission Permission, unit *string) bool {
+	return p.scoped(permission) && p.Roles.Allows(permission, unit)
+}
+
+// AllowsAny reports whether the roles, limited to the scopes, give the permission for any of the units.
+func (p Principal) AllowsAny(permission Permission, units []string) bool {
+	return p.scoped(permission) && p.Roles.AllowsAny(permission, units)
+}
+
+func (p Principal) scoped(permission Permission) bool {
+	return p.Scopes == nil || slices.Contains(p.Scopes, permission)
+}
+
+type PrincipalKey struct{}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(PrincipalKey{}).(Principal)
+	return p, ok
+}
+
+func UserEmailFromContext(ctx context.Context) (string, bool) {
+	p, ok := PrincipalFromContext(ctx)
+	return p.Email, ok
+}
@@ -0,0 +1,175 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/ratio"
+)
+
+var activities = []string{consts.ActivityMeeting, consts.ActivityOuting, consts.ActivityResidential}
+
+var ratioEnforcements = []string{consts.RatioEnforcementFlag, consts.RatioEnforcementBlock}
+
+func (s *Server) AdminListRatioRules(ctx context.Context, request AdminListRatioRulesRequestObject) (AdminListRatioRulesResponseObject, error) {
+	if !allowedAnywhere(ctx, PermissionRead) {
+		return AdminListRatioRules403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	rules, err := s.db.ListRatioRules(ctx)
+	if err != nil {
+		slog.Error("failed to list ratio rules", "err", err)
+		return AdminListRatioRules500JSONResponse{ErrorMessage: "failed to list ratio rules"}, nil
+	}
+
+	if rules == nil {
+		rules = []RatioRule{}
+	}
+
+	return AdminListRatioRules200JSONResponse{Rules: rules}, nil
+}
+
+func (s *Server) AdminSaveRatioRules(ctx context.Context, request AdminSaveRatioRulesRequestObject) (AdminSaveRatioRulesResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminSaveRatioRules403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	if err := validateRatioRules(request.Body.Rules); err != nil {
+		return AdminSaveRatioRules422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+
+	if err := s.db.SaveRatioRules(ctx, request.Body.Rules, email); err != nil {
+		slog.Error("failed to save ratio rules", "err", err)
+		return AdminSaveRatioRules500JSONResponse{ErrorMessage: "failed to save ratio rules"}, nil
+	}
+
+	rules, err := s.db.ListRatioRules(ctx)
+	if err != nil {
+		slog.Error("failed to list ratio rules", "err", err)
+		return AdminSaveRatioRules500JSONResponse{ErrorMessage: "failed to list ratio rules"}, nil
+	}
+
+	audit(ctx, "ratio_rules.save", "ratio_rules", "", nil, nil)
+
+	return AdminSaveRatioRules200JSONResponse{Rules: rules}, nil
+}
+
+func (s *Server) AdminCheckRatio(ctx context.Context, request AdminCheckRatioRequestObject) (AdminCheckRatioResponseObject, error) {
+	if !allowedAnywhere(ctx, PermissionRead) {
+		return AdminCheckRatio403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	if !slices.Contains(activities, string(request.Body.Activity)) {
+		return AdminCheckRatio422JSONResponse{ErrorMessage: "activity is not valid"}, nil
+	}
+
+	rules, err := s.ratioRules(ctx)
+	if err != nil {
+		slog.Error("failed to list ratio rules", "err", err)
+		return AdminCheckRatio500JSONResponse{ErrorMessage: "failed to list ratio rules"}, nil
+	}
+
+	adultHelpers := 0
+	if request.Body.AdultHelpers != nil {
+		adultHelpers = *request.Body.AdultHelpers
+	}
+
+	check, err := ratioCheck(rules[string(request.Body.Activity)], request.Body.Girls, adultHelpers)
+	if err != nil {
+		return AdminCheckRatio422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	return AdminCheckRatio200JSONResponse(check), nil
+}
+
+// ratioRules returns the ratio rules keyed by activity.
+func (s *Server) ratioRules(ctx context.Context) (map[string]ratio.Rules, error) {
+	list, err := s.db.ListRatioRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := map[string]ratio.Rules{}
+	for _, r := range list {
+		activity := string(r.Activity)
+		if rules[activity] == nil {
+			rules[activity] = ratio.Rules{}
+		}
+
+		rules[activity][string(r.Section)] = ratio.Rule{GirlsPerAdult: r.GirlsPerAdult, MinAdults: r.MinAdults}
+	}
+
+	return rules, nil
+}
+
+// addRatios fills in how each event that takes sign-ups compares to the ratio. The ratio is for information, so it is
+// left out rather than failing the request if it can't be worked out.
+func (s *Server) addRatios(ctx context.Context, events ...*AdminEvent) {
+	rules, err := s.ratioRules(ctx)
+	if err != nil {
+		slog.Error("failed to list ratio rules", "err", err)
+		return
+	}
+
+	for _, e := range events {
+		if e.ConfirmedBySection == nil {
+			continue
+		}
+
+		check, err := ratioCheck(rules[string(e.Activity)], *e.ConfirmedBySection, e.AdultHelpers)
+		if err != nil {
+			slog.Error("failed to check event ratio", "event", e.Id, "err", err)
+			continue
+		}
+
+		e.Ratio = &check
+	}
+}
+
+func ratioCheck(rules ratio.Rules, girls map[string]int, adultHelpers int) (RatioCheck, error) {
+	required, err := rules.RequiredAdults(girls)
+	if err != nil {
+		return RatioCheck{}, err
+	}
+
+	return RatioCheck{
+		RequiredAdults: required,
+		AdultHelpers:   adultHelpers,
+		Met:            adultHelpers >= required,
+	}, nil
+}
+
+func validateRatioRules(rules []RatioRule) error {
+	seen := map[[2]string]bool{}
+	for _, r := range rules {
+		if !slices.Contains(activities, string(r.Activity)) {
+			return errors.New("activity is not valid")
+		}
+
+		if !slices.Contains(sections, string(r.Section)) {
+			return errors.New("section is not valid")
+		}
+
+		if r.GirlsPerAdult < 1 {
+			return errors.New("girls per adult must be at least 1")
+		}
+
+		if r.MinAdults < 0 {
+			return errors.New("minimum adults must not be negative")
+		}
+
+		key := [2]string{string(r.Activity), string(r.Section)}
+		if seen[key] {
+			return fmt.Errorf("%s %s has more than one rule", r.Section, r.Activity)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
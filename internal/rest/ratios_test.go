@@ -0,0 +1,123 @@
+package rest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func meetingRules() []rest.RatioRule {
+	return []rest.RatioRule{
+		{Activity: consts.ActivityMeeting, Section: consts.SectionBrownies, GirlsPerAdult: 8, MinAdults: 2},
+		{Activity: consts.ActivityMeeting, Section: consts.SectionGuides, GirlsPerAdult: 12, MinAdults: 2},
+	}
+}
+
+func TestServer_AdminCheckRatio(t *testing.T) {
+	ctx := commissionerContext()
+	helpers := 3
+
+	tests := []struct {
+		name string
+		body rest.RatioCheckRequest
+		resp rest.AdminCheckRatioResponseObject
+	}{
+		{
+			name: "mixed sections share adults",
+			body: rest.RatioCheckRequest{
+				Activity:     consts.ActivityMeeting,
+				Girls:        map[string]int{consts.SectionBrownies: 12, consts.SectionGuides: 18},
+				AdultHelpers: &helpers,
+			},
+			resp: rest.AdminCheckRatio200JSONResponse{RequiredAdults: 3, AdultHelpers: 3, Met: true},
+		},
+		{
+			name: "small groups still need the minimum",
+			body: rest.RatioCheckRequest{
+				Activity: consts.ActivityMeeting,
+				Girls:    map[string]int{consts.SectionBrownies: 1},
+			},
+			resp: rest.AdminCheckRatio200JSONResponse{RequiredAdults: 2, AdultHelpers: 0, Met: false},
+		},
+		{
+			name: "sections without a rule are rejected",
+			body: rest.RatioCheckRequest{
+				Activity: consts.ActivityMeeting,
+				Girls:    map[string]int{consts.SectionRangers: 4},
+			},
+			resp: rest.AdminCheckRatio422JSONResponse{ErrorMessage: "no ratio rule for rangers"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, m := newTestServer(t)
+			m.db.EXPECT().ListRatioRules(ctx).Return(meetingRules(), nil)
+
+			resp, err := s.AdminCheckRatio(ctx, rest.AdminCheckRatioRequestObject{Body: &tt.body})
+			require.NoError(t, err)
+			assert.Equal(t, tt.resp, resp)
+		})
+	}
+}
+
+func TestServer_AdminSaveRatioRules(t *testing.T) {
+	ctx := commissionerContext()
+
+	t.Run("duplicate rules are rejected", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		rules := append(meetingRules(), meetingRules()[0])
+
+		resp, err := s.AdminSaveRatioRules(ctx, rest.AdminSaveRatioRulesRequestObject{
+			Body: &rest.RatioRules{Rules: rules},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminSaveRatioRules422JSONResponse{ErrorMessage: "brownies meeting has more than one rule"}, resp)
+	})
+
+	t.Run("saved rules are returned with the rest", func(t *testing.T) {
+		s, m := newTestServer(t)
+		rules := meetingRules()[:1]
+
+		m.db.EXPECT().SaveRatioRules(ctx, rules, commissionerEmail).Return(nil)
+		m.db.EXPECT().ListRatioRules(ctx).Return(meetingRules(), nil)
+
+		resp, err := s.AdminSaveRatioRules(ctx, rest.AdminSaveRatioRulesRequestObject{
+			Body: &rest.RatioRules{Rules: rules},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminSaveRatioRules200JSONResponse{Rules: meetingRules()}, resp)
+	})
+}
+
+func TestServer_AdminUpdateEvent_BlockedRatio(t *testing.T) {
+	ctx := commissionerContext()
+	event := signupEvent(time.Now().AddDate(0, 1, 0))
+	event.ConfirmedBySection = &map[string]int{consts.SectionBrownies: 12}
+
+	helpers := 1
+	enforcement := rest.RatioEnforcement(consts.RatioEnforcementBlock)
+	body := rest.EventInput{
+		Title:            event.Title,
+		Start:            event.Start,
+		End:              event.End,
+		Status:           event.Status,
+		Signups:          event.Signups,
+		AdultHelpers:     &helpers,
+		RatioEnforcement: &enforcement,
+	}
+
+	s, m := newTestServer(t)
+	m.db.EXPECT().GetEvent(ctx, event.Id).Return(event, nil)
+	m.db.EXPECT().ListRatioRules(ctx).Return(meetingRules(), nil)
+
+	resp, err := s.AdminUpdateEvent(ctx, rest.AdminUpdateEventRequestObject{EventID: event.Id, Body: &body})
+	require.NoError(t, err)
+	assert.Equal(t, rest.AdminUpdateEvent422JSONResponse{
+		ErrorMessage: "the confirmed sign-ups need at least 2 adult helpers",
+	}, resp)
+}
@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+)
+
+func (s *Server) AdminListRevocations(ctx context.Context, request AdminListRevocationsRequestObject) (AdminListRevocationsResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminListRevocations403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	revocations, err := s.db.ListRevocations(ctx)
+	if err != nil {
+		slog.Error("failed to list revocations", "err", err)
+		return AdminListRevocations500JSONResponse{ErrorMessage: "failed to list revocations"}, nil
+	}
+
+	if revocations == nil {
+		revocations = []Revocation{}
+	}
+
+	return AdminListRevocations200JSONResponse{Revocations: revocations}, nil
+}
+
+func (s *Server) AdminRevokeUser(ctx context.Context, request AdminRevokeUserRequestObject) (AdminRevokeUserResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminRevokeUser403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+
+	// Otherwise the district could be left without anyone able to lift the revocation.
+	if strings.EqualFold(email, string(request.Body.Email)) {
+		return AdminRevokeUser400JSONResponse{ErrorMessage: "you can't revoke your own access"}, nil
+	}
+
+	revocation, err := s.db.RevokeUser(ctx, *request.Body, email)
+	switch {
+	case errors.Is(err, consts.ErrConflict):
+		return AdminRevokeUser409JSONResponse{ErrorMessage: "this admin's access has already been revoked"}, nil
+	case err != nil:
+		slog.Error("failed to revoke user", "err", err)
+		return AdminRevokeUser500JSONResponse{ErrorMessage: "failed to revoke access"}, nil
+	}
+
+	slog.Info("admin access revoked", "email", revocation.Email, "by", email)
+	audit(ctx, "user.revoke", "revocation", revocation.Id.String(), nil, revocation)
+
+	return AdminRevokeUser201JSONResponse(revocation), nil
+}
+
+func (s *Server) AdminRemoveRevocation(ctx context.Context, request AdminRemoveRevocationRequestObject) (AdminRemoveRevocationResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminRemoveRevocation403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	err := s.db.DeleteRevocation(ctx, request.RevocationID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminRemoveRevocation404JSONResponse{ErrorMessage: "revocation not found"}, nil
+	case err != nil:
+		slog.Error("failed to remove revocation", "err", err)
+		return AdminRemoveRevocation500JSONResponse{ErrorMessage: "failed to remove revocation"}, nil
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+	slog.Info("revocation removed", "id", request.RevocationID, "by", email)
+	audit(ctx, "revocation.remove", "revocation", request.RevocationID.String(), nil, nil)
+
+	return AdminRemoveRevocation204Response{}, nil
+}
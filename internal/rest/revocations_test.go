@@ -0,0 +1,83 @@
+package rest_test
+
+import (
+	"testing"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_AdminRevokeUser(t *testing.T) {
+	input := rest.RevocationInput{Email: "leaver@staplehurstguiding.org.uk"}
+
+	t.Run("revokes the admin's access", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := commissionerContext()
+
+		m.db.EXPECT().RevokeUser(ctx, input, commissionerEmail).
+			Return(rest.Revocation{Id: uuid.New(), Email: input.Email, RevokedBy: commissionerEmail}, nil)
+
+		resp, err := s.AdminRevokeUser(ctx, rest.AdminRevokeUserRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminRevokeUser201JSONResponse{}, resp)
+	})
+
+	t.Run("already revoked", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := commissionerContext()
+
+		m.db.EXPECT().RevokeUser(ctx, input, commissionerEmail).Return(rest.Revocation{}, consts.ErrConflict)
+
+		resp, err := s.AdminRevokeUser(ctx, rest.AdminRevokeUserRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminRevokeUser409JSONResponse{}, resp)
+	})
+
+	t.Run("admins can't revoke themselves", func(t *testing.T) {
+		s, _ := newTestServer(t)
+
+		resp, err := s.AdminRevokeUser(commissionerContext(), rest.AdminRevokeUserRequestObject{
+			Body: &rest.RevocationInput{Email: "DC@staplehurstguiding.org.uk"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminRevokeUser400JSONResponse{}, resp)
+	})
+
+	t.Run("unit leaders can't revoke access", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+
+		resp, err := s.AdminRevokeUser(ctx, rest.AdminRevokeUserRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminRevokeUser403JSONResponse{}, resp)
+	})
+}
+
+func TestServer_AdminRevokeSession(t *testing.T) {
+	id := uuid.New()
+
+	t.Run("revokes the session", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := commissionerContext()
+
+		m.db.EXPECT().RevokeSession(ctx, id, commissionerEmail).Return(nil)
+
+		resp, err := s.AdminRevokeSession(ctx, rest.AdminRevokeSessionRequestObject{SessionID: id})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminRevokeSession204Response{}, resp)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := commissionerContext()
+
+		m.db.EXPECT().RevokeSession(ctx, id, commissionerEmail).Return(consts.ErrNotFound)
+
+		resp, err := s.AdminRevokeSession(ctx, rest.AdminRevokeSessionRequestObject{SessionID: id})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminRevokeSession404JSONResponse{}, resp)
+	})
+}
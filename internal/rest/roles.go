@@ -0,0 +1,196 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Permission is something a role allows, within its unit or across the district.
+type Permission int
+
+const (
+	// PermissionRead allows seeing records, other than members' sensitive details.
+	PermissionRead Permission = iota
+	// PermissionWrite allows changing records.
+	PermissionWrite
+	// PermissionSensitive allows seeing and changing members' sensitive details.
+	PermissionSensitive
+)
+
+var rolePermissions = map[string][]Permission{
+	consts.RoleDistrictCommissioner: {PermissionRead, PermissionWrite, PermissionSensitive},
+	consts.RoleUnitLeader:           {PermissionRead, PermissionWrite, PermissionSensitive},
+	consts.RoleTreasurer:            {PermissionRead},
+	consts.RoleHelper:               {PermissionRead},
+}
+
+var errForbidden = errors.New("your roles don't allow this")
+
+// Roles are the roles assigned to the signed in admin.
+type Roles []RoleAssignment
+
+// Allows reports whether any of the roles gives the permission for the unit. Roles without a unit apply across the
+// district, and are the only roles that give permissions for district records, which have a nil unit.
+func (r Roles) Allows(permission Permission, unit *string) bool {
+	return slices.ContainsFunc(r, func(role RoleAssignment) bool {
+		if !slices.Contains(rolePermissions[string(role.Role)], permission) {
+			return false
+		}
+
+		return role.Unit == nil || (unit != nil && *role.Unit == *unit)
+	})
+}
+
+// AllowsAny reports whether any of the roles gives the permission for any of the units.
+func (r Roles) AllowsAny(permission Permission, units []string) bool {
+	return slices.ContainsFunc(units, func(unit string) bool {
+		return r.Allows(permission, &unit)
+	})
+}
+
+func UserRolesFromContext(ctx context.Context) Roles {
+	p, _ := PrincipalFromContext(ctx)
+	return p.Roles
+}
+
+// allowed reports whether the signed in admin has the permission for the unit, or the district if unit is nil.
+func allowed(ctx context.Context, permission Permission, unit *string) bool {
+	p, _ := PrincipalFromContext(ctx)
+	return p.Allows(permission, unit)
+}
+
+// allowedAnywhere reports whether the signed in admin has the permission for at least one unit, or the district.
+func allowedAnywhere(ctx context.Context, permission Permission) bool {
+	p, _ := PrincipalFromContext(ctx)
+	if !p.scoped(permission) {
+		return false
+	}
+
+	return slices.ContainsFunc(p.Roles, func(role RoleAssignment) bool {
+		return slices.Contains(rolePermissions[string(role.Role)], permission)
+	})
+}
+
+func (s *Server) AdminGetMe(ctx context.Context, request AdminGetMeRequestObject) (AdminGetMeResponseObject, error) {
+	email, _ := UserEmailFromContext(ctx)
+
+	roles := UserRolesFromContext(ctx)
+	if roles == nil {
+		roles = Roles{}
+	}
+
+	me := AdminGetMe200JSONResponse{Email: openapi_types.Email(email), Roles: roles}
+
+	if p, ok := PrincipalFromContext(ctx); ok {
+		if p.Name != "" {
+			me.Name = &p.Name
+		}
+		if !p.Expiry.IsZero() {
+			me.ExpiresAt = &p.Expiry
+		}
+		if p.CSRFToken != "" {
+			me.CsrfToken = &p.CSRFToken
+		}
+	}
+
+	return me, nil
+}
+
+func (s *Server) AdminListRoles(ctx context.Context, request AdminListRolesRequestObject) (AdminListRolesResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminListRoles403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	roles, err := s.db.ListRoleAssignments(ctx)
+	if err != nil {
+		slog.Error("failed to list roles", "err", err)
+		return AdminListRoles500JSONResponse{ErrorMessage: "failed to list roles"}, nil
+	}
+
+	if roles == nil {
+		roles = []RoleAssignment{}
+	}
+
+	return AdminListRoles200JSONResponse{Roles: roles}, nil
+}
+
+func (s *Server) AdminAssignRole(ctx context.Context, request AdminAssignRoleRequestObject) (AdminAssignRoleResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminAssignRole403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	if err := validateRoleAssignment(request.Body); err != nil {
+		return AdminAssignRole422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	if request.Body.Unit != nil {
+		_, err := s.db.GetUnit(ctx, *request.Body.Unit)
+		switch {
+		case errors.Is(err, consts.ErrNotFound):
+			return AdminAssignRole422JSONResponse{ErrorMessage: "unit not found"}, nil
+		case err != nil:
+			slog.Error("failed to get unit", "err", err)
+			return AdminAssignRole500JSONResponse{ErrorMessage: "failed to assign role"}, nil
+		}
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+
+	role, err := s.db.AddRoleAssignment(ctx, *request.Body, email)
+	switch {
+	case errors.Is(err, consts.ErrConflict):
+		return AdminAssignRole409JSONResponse{ErrorMessage: "the admin already has this role"}, nil
+	case err != nil:
+		slog.Error("failed to assign role", "err", err)
+		return AdminAssignRole500JSONResponse{ErrorMessage: "failed to assign role"}, nil
+	}
+
+	slog.Info("role assigned", "email", role.Email, "role", role.Role, "unit", role.Unit, "by", email)
+	audit(ctx, "role.assign", "role", role.Id.String(), nil, role)
+
+	return AdminAssignRole201JSONResponse(role), nil
+}
+
+func (s *Server) AdminRemoveRole(ctx context.Context, request AdminRemoveRoleRequestObject) (AdminRemoveRoleResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminRemoveRole403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	err := s.db.DeleteRoleAssignment(ctx, request.RoleID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminRemoveRole404JSONResponse{ErrorMessage: "role assignment not found"}, nil
+	case err != nil:
+		slog.Error("failed to remove role", "err", err)
+		return AdminRemoveRole500JSONResponse{ErrorMessage: "failed to remove role"}, nil
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+	slog.Info("role removed", "id", request.RoleID, "by", email)
+	audit(ctx, "role.remove", "role", request.RoleID.String(), nil, nil)
+
+	return AdminRemoveRole204Response{}, nil
+}
+
+func validateRoleAssignment(role *RoleAssignmentInput) error {
+	switch string(role.Role) {
+	case consts.RoleDistrictCommissioner:
+		if role.Unit != nil {
+			return errors.New("district commissioners can't be given a unit")
+		}
+	case consts.RoleUnitLeader, consts.RoleHelper:
+		if role.Unit == nil {
+			return errors.New("unit leaders and helpers must be given a unit")
+		}
+	case consts.RoleTreasurer:
+	default:
+		return errors.New("role is not valid")
+	}
+
+	return nil
+}
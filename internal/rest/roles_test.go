@@ -0,0 +1,108 @@
+package rest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoles_Allows(t *testing.T) {
+	brownies, guides := "1st-brownies", "1st-guides"
+
+	tests := []struct {
+		name       string
+		roles      rest.Roles
+		permission rest.Permission
+		unit       *string
+		want       bool
+	}{
+		{"commissioners can change anything", rest.Roles{role(rest.DistrictCommissioner, "")}, rest.PermissionWrite, &guides, true},
+		{"commissioners can change district records", rest.Roles{role(rest.DistrictCommissioner, "")}, rest.PermissionWrite, nil, true},
+		{"leaders can see their unit's sensitive details", rest.Roles{role(rest.UnitLeader, brownies)}, rest.PermissionSensitive, &brownies, true},
+		{"leaders can't see other units", rest.Roles{role(rest.UnitLeader, brownies)}, rest.PermissionRead, &guides, false},
+		{"leaders can't change district records", rest.Roles{role(rest.UnitLeader, brownies)}, rest.PermissionWrite, nil, false},
+		{"district treasurers can see every unit", rest.Roles{role(rest.Treasurer, "")}, rest.PermissionRead, &guides, true},
+		{"treasurers can't change anything", rest.Roles{role(rest.Treasurer, "")}, rest.PermissionWrite, &guides, false},
+		{"helpers can't see sensitive details", rest.Roles{role(rest.Helper, brownies)}, rest.PermissionSensitive, &brownies, false},
+		{"any role can allow it", rest.Roles{role(rest.Helper, brownies), role(rest.UnitLeader, guides)}, rest.PermissionWrite, &guides, true},
+		{"no roles allow nothing", nil, rest.PermissionRead, &brownies, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.roles.Allows(tt.permission, tt.unit))
+		})
+	}
+}
+
+func TestServer_AdminListUnitMembers_Forbidden(t *testing.T) {
+	ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+	s, _ := newTestServer(t)
+
+	resp, err := s.AdminListUnitMembers(ctx, rest.AdminListUnitMembersRequestObject{UnitID: "1st-guides"})
+	require.NoError(t, err)
+	assert.Equal(t, rest.AdminListUnitMembers403JSONResponse{ErrorMessage: "your roles don't allow this"}, resp)
+}
+
+func TestServer_AdminGetMoveUpReport_OnlyVisibleUnits(t *testing.T) {
+	ctx := adminContext("rainbows@staplehurstguiding.org.uk", role(rest.UnitLeader, "2nd-rainbows"))
+	s, m := newTestServer(t)
+
+	m.db.EXPECT().ListMembers(ctx, nil).Return([]rest.Member{
+		member("rainbow", "2nd-rainbows", date(2019, time.October, 3)),
+		member("guide", "1st-guides", date(2012, time.November, 1)),
+	}, nil)
+	m.db.EXPECT().ListAdminUnits(ctx).Return(adminUnits(), nil)
+
+	resp, err := s.AdminGetMoveUpReport(ctx, rest.AdminGetMoveUpReportRequestObject{
+		Params: rest.AdminGetMoveUpReportParams{Term: ptr("2026-autumn")},
+	})
+	require.NoError(t, err)
+
+	report := resp.(rest.AdminGetMoveUpReport200JSONResponse)
+	require.Len(t, report.MoveUps, 1)
+	assert.Equal(t, "rainbow", report.MoveUps[0].Member.Name)
+}
+
+func TestServer_AdminAssignRole(t *testing.T) {
+	ctx := commissionerContext()
+
+	t.Run("only commissioners can assign roles", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		ctx := adminContext("leader@staplehurstguiding.org.uk", role(rest.UnitLeader, "1st-brownies"))
+
+		resp, err := s.AdminAssignRole(ctx, rest.AdminAssignRoleRequestObject{
+			Body: &rest.RoleAssignmentInput{Email: "helper@example.com", Role: rest.Helper, Unit: ptr("1st-brownies")},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminAssignRole403JSONResponse{}, resp)
+	})
+
+	t.Run("unit leaders must be given a unit", func(t *testing.T) {
+		s, _ := newTestServer(t)
+
+		resp, err := s.AdminAssignRole(ctx, rest.AdminAssignRoleRequestObject{
+			Body: &rest.RoleAssignmentInput{Email: "leader@example.com", Role: rest.UnitLeader},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminAssignRole422JSONResponse{
+			ErrorMessage: "unit leaders and helpers must be given a unit",
+		}, resp)
+	})
+
+	t.Run("a role can only be assigned once", func(t *testing.T) {
+		s, m := newTestServer(t)
+		input := rest.RoleAssignmentInput{Email: "leader@example.com", Role: rest.UnitLeader, Unit: ptr("1st-brownies")}
+
+		m.db.EXPECT().GetUnit(ctx, "1st-brownies").Return(rest.Unit{Id: "1st-brownies", Section: consts.SectionBrownies}, nil)
+		m.db.EXPECT().AddRoleAssignment(ctx, input, commissionerEmail).Return(rest.RoleAssignment{}, consts.ErrConflict)
+
+		resp, err := s.AdminAssignRole(ctx, rest.AdminAssignRoleRequestObject{Body: &input})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminAssignRole409JSONResponse{ErrorMessage: "the admin already has this role"}, resp)
+	})
+}
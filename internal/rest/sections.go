@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+)
+
+var sections = []string{consts.SectionRainbows, consts.SectionBrownies, consts.SectionGuides, consts.SectionRangers}
+
+// sectionAges are the ages, in whole years, at which girls can be in each section. A girl can be in a section from
+// her MinAge birthday until her MaxAge birthday.
+var sectionAges = map[string]struct{ MinAge, MaxAge int }{
+	consts.SectionRainbows: {4, 7},
+	consts.SectionBrownies: {7, 10},
+	consts.SectionGuides:   {10, 14},
+	consts.SectionRangers:  {14, 18},
+}
+
+// eligibleSections returns the sections that someone born on dob can be in on the date of at.
+func eligibleSections(dob, at time.Time) []Section {
+	age := ageOn(dob, at)
+
+	var eligible []Section
+	for _, section := range sections {
+		ages := sectionAges[section]
+		if age >= ages.MinAge && age < ages.MaxAge {
+			eligible = append(eligible, Section(section))
+		}
+	}
+
+	return eligible
+}
+
+// ageOn returns the age in whole years, on the date of at, of someone born on dob.
+func ageOn(dob, at time.Time) int {
+	age := at.Year() - dob.Year()
+	if at.Month() < dob.Month() || (at.Month() == dob.Month() && at.Day() < dob.Day()) {
+		age--
+	}
+
+	return age
+}
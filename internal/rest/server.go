@@ -2,19 +2,415 @@ package rest
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 //go:generate go run go.uber.org/mock/mockgen -source server.go -destination mock/server.go
 
 var _ StrictServerInterface = (*Server)(nil)
 
+// Database is every repository the server needs. Handlers depend on these interfaces rather than SQL, so they can be
+// tested against mocks, or the in-memory fakes in internal/database/memdb.
+type Database interface {
+	EventRepository
+	SignupRepository
+	RatioRuleRepository
+	UnitRepository
+	MemberRepository
+	AdminDirectory
+	RoleRepository
+	InvitationRepository
+	SessionRepository
+	APIKeyRepository
+	RevocationRepository
+	AuditRepository
+	JoinRequestRepository
+	PlaceOfferRepository
+	FamilyRepository
+}
+
+type EventRepository interface {
+	ListEvents(ctx context.Context, filter EventFilter) ([]AdminEvent, error)
+	GetEvent(ctx context.Context, id uuid.UUID) (AdminEvent, error)
+	CreateEvent(ctx context.Context, event EventInput, createdBy string) (AdminEvent, error)
+	UpdateEvent(ctx context.Context, id uuid.UUID, event EventInput) (AdminEvent, error)
+	DeleteEvent(ctx context.Context, id uuid.UUID) error
+}
+
+type SignupRepository interface {
+	// AddEventSignup confirms the sign-up if the event has space, and the ratio allows when the event blocks sign-ups
+	// that break it, otherwise it joins the waiting list.
+	AddEventSignup(ctx context.Context, eventID uuid.UUID, signup EventSignupInput, tokenHash []byte) (EventSignup, error)
+	ListEventSignups(ctx context.Context, eventID uuid.UUID, statuses ...string) ([]EventSignup, error)
+	// CancelEventSignup cancels the sign-up, returning it as it was beforehand. When tokenHash is nil, the cancellation
+	// token is not checked.
+	CancelEventSignup(ctx context.Context, eventID, signupID uuid.UUID, tokenHash []byte) (EventSignup, error)
+	// PromoteEventSignups confirms waiting list sign-ups, oldest first, until the event is full. When the event blocks
+	// sign-ups that break the ratio, those are skipped over.
+	PromoteEventSignups(ctx context.Context, eventID uuid.UUID) ([]EventSignup, error)
+}
+
+type RatioRuleRepository interface {
+	ListRatioRules(ctx context.Context) ([]RatioRule, error)
+	// SaveRatioRules creates or replaces the rules for each activity and section given, leaving any others unchanged.
+	SaveRatioRules(ctx context.Context, rules []RatioRule, updatedBy string) error
+}
+
+type UnitRepository interface {
+	ListUnits(ctx context.Context) ([]Unit, error)
+	GetUnit(ctx context.Context, id string) (Unit, error)
+	ListAdminUnits(ctx context.Context) ([]AdminUnit, error)
+	UpdateUnit(ctx context.Context, id string, settings UnitSettings) (AdminUnit, error)
+}
+
+type MemberRepository interface {
+	// ListMembers lists the members of the unit, or of the whole district if unitID is nil.
+	ListMembers(ctx context.Context, unitID *string) ([]Member, error)
+	GetMember(ctx context.Context, id uuid.UUID) (Member, error)
+	// TransferMember moves the member to another unit, returning consts.ErrConflict if it is full.
+	TransferMember(ctx context.Context, memberID uuid.UUID, toUnit, transferredBy string) (Member, error)
+	CreateMember(ctx context.Context, unitID string, member MemberInput) (Member, error)
+	UpdateMember(ctx context.Context, id uuid.UUID, member MemberInput) (Member, error)
+	// GetMemberSensitive returns the member's encrypted sensitive details, which are empty if they have not been set.
+	GetMemberSensitive(ctx context.Context, id uuid.UUID) (EncryptedData, error)
+	SetMemberSensitive(ctx context.Context, id uuid.UUID, data EncryptedData) error
+	AddMemberAccess(ctx context.Context, memberID uuid.UUID, accessedBy, ip string) error
+	ListMemberAccess(ctx context.Context, memberID uuid.UUID) ([]MemberAccess, error)
+}
+
+type RoleRepository interface {
+	RoleLoader
+	ListRoleAssignments(ctx context.Context) ([]RoleAssignment, error)
+	// AddRoleAssignment returns consts.ErrConflict if the admin already has the role.
+	AddRoleAssignment(ctx context.Context, role RoleAssignmentInput, createdBy string) (RoleAssignment, error)
+	DeleteRoleAssignment(ctx context.Context, id uuid.UUID) error
+}
+
+type InvitationRepository interface {
+	ListInvitations(ctx context.Context) ([]Invitation, error)
+	// CreateInvitation returns consts.ErrConflict if the email has already been invited, unless that invitation
+	// expired without being accepted.
+	CreateInvitation(ctx context.Context, invitation InvitationInput, invitedBy string, expiresAt time.Time) (Invitation, error)
+	DeleteInvitation(ctx context.Context, id uuid.UUID) error
+	// AcceptInvitation records that the invited email has signed in, returning consts.ErrNotFound if it has no
+	// invitation, or the invitation expired before it was accepted.
+	AcceptInvitation(ctx context.Context, email string, now time.Time) error
+}
+
+type SessionRepository interface {
+	SessionStore
+	// ListSessions lists the sessions that haven't expired by now, for one admin, or everyone if email is nil.
+	ListSessions(ctx context.Context, email *string, now time.Time) ([]Session, error)
+	// RevokeSession deletes the session and adds it to the revoked sessions, returning consts.ErrNotFound if it
+	// doesn't exist.
+	RevokeSession(ctx context.Context, id uuid.UUID, revokedBy string) error
+}
+
+type APIKeyRepository interface {
+	// ListAPIKeys lists the admin's API keys, or everyone's if owner is nil.
+	ListAPIKeys(ctx context.Context, owner *string) ([]APIKey, error)
+	GetAPIKey(ctx context.Context, id uuid.UUID) (APIKey, error)
+	// CreateAPIKey returns consts.ErrConflict if the owner already has a key with the same name.
+	CreateAPIKey(ctx context.Context, key APIKeyInput, owner string, keyHash []byte) (APIKey, error)
+	DeleteAPIKey(ctx context.Context, id uuid.UUID) error
+	// FindAPIKey returns consts.ErrNotFound if no API key has the hash, or it expired before now.
+	FindAPIKey(ctx context.Context, keyHash []byte, now time.Time) (APIKey, error)
+	// TouchAPIKey records the API key being used at now, from the IP address.
+	TouchAPIKey(ctx context.Context, id uuid.UUID, now time.Time, ip string) error
+}
+
+type RevocationRepository interface {
+	RevocationLoader
+	// RevokeUser revokes the admin's access and ends their sessions, returning consts.ErrConflict if it has already
+	// been revoked.
+	RevokeUser(ctx context.Context, revocation RevocationInput, revokedBy string) (Revocation, error)
+	DeleteRevocation(ctx context.Context, id uuid.UUID) error
+}
+
+type AuditRepository interface {
+	AuditStore
+	// ListAuditEntries lists the entries matching the filter, most recent first.
+	ListAuditEntries(ctx context.Context, filter AuditFilter) ([]AuditEntry, error)
+}
+
+type JoinRequestRepository interface {
+	AddJoinRequest(ctx context.Context, joinRequest JoinRequestInput, eligible []Section, ip string) (uuid.UUID, error)
+	// CountJoinRequestsFromIP counts the join requests made from the IP address since the given time.
+	CountJoinRequestsFromIP(ctx context.Context, ip string, since time.Time) (int, error)
+	GetJoinRequest(ctx context.Context, id uuid.UUID) (JoinRequest, error)
+	// ListWaitingList returns the waiting join requests that prefer the unit, oldest first.
+	ListWaitingList(ctx context.Context, unitID string) ([]JoinRequest, error)
+	ListJoinRequestEvents(ctx context.Context, joinRequestID uuid.UUID) ([]JoinRequestEvent, error)
+}
+
+type PlaceOfferRepository interface {
+	// OfferPlace offers a place in the unit to a waiting join request, returning consts.ErrConflict if it is not
+	// waiting for the unit.
+	OfferPlace(ctx context.Context, joinRequestID uuid.UUID, unitID string, tokenHash []byte, expiresAt time.Time, offeredBy string) (PlaceOffer, error)
+	// RespondToPlaceOffer accepts or declines an offer, returning consts.ErrConflict if it has expired or already
+	// been responded to. Accepting makes the child a member of the unit.
+	RespondToPlaceOffer(ctx context.Context, offerID uuid.UUID, tokenHash []byte, accept bool, now time.Time) (PlaceOffer, error)
+	// ExpirePlaceOffers expires the offers that have not been responded to in time, returning them.
+	ExpirePlaceOffers(ctx context.Context, now time.Time) ([]PlaceOffer, error)
+}
+
+// FamilyRepository is what parents can sign in to: the members and sign-ups their email is the contact for.
+type FamilyRepository interface {
+	// CreateParentLogin records a sign in link being asked for, from the IP address.
+	CreateParentLogin(ctx context.Context, email string, tokenHash []byte, ip string, expiresAt time.Time) error
+	// CountParentLoginsFromIP counts the sign in links asked for from the IP address since the given time.
+	CountParentLoginsFromIP(ctx context.Context, ip string, since time.Time) (int, error)
+	// RedeemParentLogin uses up the sign in link, returning the email it was sent to, or consts.ErrNotFound if no link
+	// has the token, it expired before now, or it has already been used.
+	RedeemParentLogin(ctx context.Context, tokenHash []byte, now time.Time) (string, error)
+	ParentSessionStore
+	// HasFamily reports whether the email is the parent's contact for any members or event sign-ups.
+	HasFamily(ctx context.Context, email string) (bool, error)
+	ListFamilyMembers(ctx context.Context, email string) ([]Member, error)
+	ListFamilySignups(ctx context.Context, email string) ([]EventSignup, error)
+}
+
+// RoleLoader loads the roles assigned to an admin, which are empty if they have none.
+type RoleLoader interface {
+	ListUserRoles(ctx context.Context, email string) ([]RoleAssignment, error)
+}
+
+// AdminDirectory knows who can sign in as an admin, beyond the hosted domains and allow-list, and what they can do.
+type AdminDirectory interface {
+	RoleLoader
+	// AcceptInvitation records that the invited email has signed in, returning consts.ErrNotFound if it has no
+	// invitation, or the invitation expired before it was accepted.
+	AcceptInvitation(ctx context.Context, email string, now time.Time) error
+	SessionStore
+	// FindAPIKey returns consts.ErrNotFound if no API key has the hash, or it expired before now.
+	FindAPIKey(ctx context.Context, keyHash []byte, now time.Time) (APIKey, error)
+	// TouchAPIKey records the API key being used at now, from the IP address.
+	TouchAPIKey(ctx context.Context, id uuid.UUID, now time.Time, ip string) error
+}
+
+// SessionStore keeps admins' cookie sessions.
+type SessionStore interface {
+	CreateSession(ctx context.Context, session Session) (Session, error)
+	// GetSession returns consts.ErrNotFound if no session has the token, or it expired before now.
+	GetSession(ctx context.Context, tokenHash []byte, now time.Time) (Session, error)
+	// TouchSession records the session being used at now, extending it until expiresAt.
+	TouchSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error
+	DeleteSession(ctx context.Context, id uuid.UUID) error
+}
+
+// Session is an admin's cookie session, started by exchanging an ID token.
+type Session struct {
+	ID uuid.UUID
+	// TokenHash is the hash of the cookie's value, so the sessions can't be taken over from the database.
+	TokenHash    []byte
+	Email        string
+	Name         string
+	HostedDomain string
+	// CSRFToken must be sent with requests that change anything.
+	CSRFToken  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+}
+
+// SessionConfig sets how long admin sessions last.
+type SessionConfig struct {
+	// Idle is how long a session lasts without being used.
+	Idle time.Duration
+	// Lifetime is the longest a session lasts, however much it's used.
+	Lifetime time.Duration
+}
+
+// ParentSessionStore keeps parents' cookie sessions, which are kept apart from admins' so neither can be used as the
+// other.
+type ParentSessionStore interface {
+	CreateParentSession(ctx context.Context, session ParentSession) (ParentSession, error)
+	// GetParentSession returns consts.ErrNotFound if no session has the token, or it expired before now.
+	GetParentSession(ctx context.Context, tokenHash []byte, now time.Time) (ParentSession, error)
+	// TouchParentSession records the session being used at now, extending it until expiresAt.
+	TouchParentSession(ctx context.Context, id uuid.UUID, now, expiresAt time.Time) error
+	DeleteParentSession(ctx context.Context, id uuid.UUID) error
+}
+
+// ParentSession is a parent's cookie session, started from a sign in link. It only gives access to the records of
+// the family the email is the contact for.
+type ParentSession struct {
+	ID        uuid.UUID
+	TokenHash []byte
+	Email     string
+	// CSRFToken must be sent with requests that change anything.
+	CSRFToken  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+}
+
+// ParentLoginConfig sets how long parents' sign in links and sessions last.
+type ParentLoginConfig struct {
+	// LinkExpiry is how long a sign in link can be used for.
+	LinkExpiry time.Duration
+	Sessions   SessionConfig
+}
+
+// AuditStore keeps the audit log, which can only be added to.
+type AuditStore interface {
+	AddAuditEntry(ctx context.Context, entry AuditEntry) error
+}
+
+// RevocationLoader loads the admins and sessions whose access has been revoked.
+type RevocationLoader interface {
+	ListRevocations(ctx context.Context) ([]Revocation, error)
+	// ListRevokedSessions lists the revoked sessions that would not have expired by now.
+	ListRevokedSessions(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+}
+
+// Identity is who an ID token says its holder is.
+type Identity struct {
+	Issuer  string
+	Subject string
+	// Email has been verified by the issuer.
+	Email string
+	Name  string
+	// HostedDomain is the Google Workspace domain the account belongs to, if any.
+	HostedDomain string
+	Expiry       time.Time
+}
+
+// TokenVerifier checks an ID token's signature and claims, returning the identity it holds.
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (Identity, error)
+}
+
+// EventFilter restricts the events returned by Database.ListEvents. Events are included when they overlap the From-To
+// range, belong to Unit or the whole district, and have one of Statuses. A nil Unit or empty Statuses matches all.
+type EventFilter struct {
+	From     time.Time
+	To       time.Time
+	Unit     *string
+	Statuses []string
+}
+
+// AuditFilter restricts the entries returned by Database.ListAuditEntries. Nil fields match all. Before is the ID of
+// an entry, so only those older than it are returned.
+type AuditFilter struct {
+	Actor      *string
+	Action     *string
+	TargetType *string
+	TargetID   *string
+	Since      *time.Time
+	Until      *time.Time
+	Before     *int64
+	Limit      int
+}
+
+// EncryptedData is data encrypted by an Encrypter, with the ID of the key that encrypted it.
+type EncryptedData struct {
+	KeyID      string
+	Ciphertext []byte
+}
+
+type Encrypter interface {
+	Encrypt(plaintext, additionalData []byte) (keyID string, ciphertext []byte, err error)
+	Decrypt(keyID string, ciphertext, additionalData []byte) ([]byte, error)
+}
+
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token string, ip string) error
+}
+
+type ContentManager interface {
+	EmailTemplate(ctx context.Context, key string, vars map[string]any) (EmailContent, error)
+}
+
+type EmailContent struct {
+	Subject string
+	Body    string
+}
+
+type EmailSender interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// ServerConfig are the Server's settings.
+type ServerConfig struct {
+	// OfferExpiry is how long a place offer can be responded to before it expires.
+	OfferExpiry time.Duration
+	// InvitationExpiry is how long an admin invitation can be accepted for.
+	InvitationExpiry time.Duration
+	// Sessions controls how long the admin site's sessions last.
+	Sessions SessionConfig
+	// Parents controls parents' sign in links and sessions.
+	Parents ParentLoginConfig
+	// Limits are the rate limits the Server starts with, which UpdateLimits changes.
+	Limits Limits
+}
+
+// Limits are the rate limits on public requests, which can change while the Server is running.
+type Limits struct {
+	JoinRequests RateLimit
+	ParentLogins RateLimit
+}
+
+// RateLimit is the most requests accepted from one IP address within Window.
+type RateLimit struct {
+	Limit  int
+	Window time.Duration
+}
+
 type Server struct {
+	db        Database
+	captcha   CaptchaVerifier
+	content   ContentManager
+	email     EmailSender
+	encrypter Encrypter
+	cfg       ServerConfig
+	limits    atomic.Pointer[Limits]
+}
+
+// NewServer creates a Server with the settings in cfg.
+func NewServer(db Database, captcha CaptchaVerifier, content ContentManager, email EmailSender, encrypter Encrypter, cfg ServerConfig) *Server {
+	s := &Server{
+		db:        db,
+		captcha:   captcha,
+		content:   content,
+		email:     email,
+		encrypter: encrypter,
+		cfg:       cfg,
+	}
+	s.limits.Store(&cfg.Limits)
+
+	return s
 }
 
-func NewServer() *Server {
-	return &Server{}
+// UpdateLimits changes the rate limits, for requests from then on.
+func (s *Server) UpdateLimits(limits Limits) {
+	s.limits.Store(&limits)
 }
 
 func (s *Server) ContactUs(ctx context.Context, request ContactUsRequestObject) (ContactUsResponseObject, error) {
 	return ContactUs200Response{}, nil
 }
+
+// EmailTemplates are the Contentful emails the server sends, so they can be checked before they're needed.
+var EmailTemplates = []string{
+	"admin-invited",
+	"event-signup-confirmed",
+	"event-signup-promoted",
+	"event-signup-waitlisted",
+	"join-request-received",
+	"member-transferred",
+	"parent-login-link",
+	"place-offered",
+}
+
+func (s *Server) sendEmail(ctx context.Context, to, key string, vars map[string]any) error {
+	content, err := s.content.EmailTemplate(ctx, key, vars)
+	if err != nil {
+		return err
+	}
+
+	return s.email.Send(ctx, to, content.Subject, content.Body)
+}
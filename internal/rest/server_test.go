@@ -0,0 +1,78 @@
+package rest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	mock_rest "github.com/girlguidingstaplehurst/district/internal/rest/mock"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testOfferExpiry      = 7 * 24 * time.Hour
+	testInvitationExpiry = 14 * 24 * time.Hour
+)
+
+var (
+	testSessions     = rest.SessionConfig{Idle: 2 * time.Hour, Lifetime: 24 * time.Hour}
+	testParentLogins = rest.ParentLoginConfig{
+		LinkExpiry: 15 * time.Minute,
+		Sessions:   rest.SessionConfig{Idle: time.Hour, Lifetime: 12 * time.Hour},
+	}
+	testLimits = rest.Limits{
+		JoinRequests: rest.RateLimit{Limit: 5, Window: 24 * time.Hour},
+		ParentLogins: rest.RateLimit{Limit: 5, Window: time.Hour},
+	}
+)
+
+type mocks struct {
+	db      *mock_rest.MockDatabase
+	captcha *mock_rest.MockCaptchaVerifier
+	content *mock_rest.MockContentManager
+	email   *mock_rest.MockEmailSender
+	crypt   *mock_rest.MockEncrypter
+}
+
+func newTestServer(t *testing.T) (*rest.Server, mocks) {
+	ctrl := gomock.NewController(t)
+
+	m := mocks{
+		db:      mock_rest.NewMockDatabase(ctrl),
+		captcha: mock_rest.NewMockCaptchaVerifier(ctrl),
+		content: mock_rest.NewMockContentManager(ctrl),
+		email:   mock_rest.NewMockEmailSender(ctrl),
+		crypt:   mock_rest.NewMockEncrypter(ctrl),
+	}
+
+	return rest.NewServer(m.db, m.captcha, m.content, m.email, m.crypt, rest.ServerConfig{
+		OfferExpiry:      testOfferExpiry,
+		InvitationExpiry: testInvitationExpiry,
+		Sessions:         testSessions,
+		Parents:          testParentLogins,
+		Limits:           testLimits,
+	}), m
+}
+
+const commissionerEmail = "dc@staplehurstguiding.org.uk"
+
+// role assigns the role for the unit, or across the district if unit is empty.
+func role(name rest.RoleName, unit string) rest.RoleAssignment {
+	r := rest.RoleAssignment{Role: name}
+	if unit != "" {
+		r.Unit = &unit
+	}
+
+	return r
+}
+
+// adminContext returns a context for the admin signed in with the roles.
+func adminContext(email string, roles ...rest.RoleAssignment) context.Context {
+	return context.WithValue(context.Background(), rest.PrincipalKey{}, rest.Principal{Email: email, Roles: roles})
+}
+
+// commissionerContext returns a context for a district commissioner, who can do everything.
+func commissionerContext() context.Context {
+	return adminContext(commissionerEmail, role(rest.DistrictCommissioner, ""))
+}
@@ -0,0 +1,177 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/thanhpk/randstr"
+)
+
+// SessionCookie holds the admin's session token. The __Host- prefix stops it being set by another subdomain.
+const SessionCookie = "__Host-district-session"
+
+// CSRFHeader carries the session's CSRF token on requests that change anything.
+const CSRFHeader = "X-CSRF-Token"
+
+const (
+	sessionTokenLength = 43
+	csrfTokenLength    = 32
+)
+
+func (s *Server) AdminCreateSession(ctx context.Context, request AdminCreateSessionRequestObject) (AdminCreateSessionResponseObject, error) {
+	p, _ := PrincipalFromContext(ctx)
+	if p.SessionID != uuid.Nil || p.APIKeyID != uuid.Nil {
+		return AdminCreateSession400JSONResponse{ErrorMessage: "sign in with an ID token to start a session"}, nil
+	}
+
+	token := randstr.Base62(sessionTokenLength)
+	now := time.Now()
+
+	session, err := s.db.CreateSession(ctx, Session{
+		TokenHash:    hashToken(token),
+		Email:        p.Email,
+		Name:         p.Name,
+		HostedDomain: p.HostedDomain,
+		CSRFToken:    randstr.Base62(csrfTokenLength),
+		CreatedAt:    now,
+		LastSeenAt:   now,
+		ExpiresAt:    sessionExpiry(now, now, s.cfg.Sessions),
+	})
+	if err != nil {
+		slog.Error("failed to create session", "err", err)
+		return AdminCreateSession500JSONResponse{ErrorMessage: "failed to start session"}, nil
+	}
+
+	slog.Info("admin session started", "email", p.Email, "session", session.ID)
+	audit(ctx, "session.create", "session", session.ID.String(), nil, nil)
+
+	me := AdminUser{
+		Email:     openapi_types.Email(session.Email),
+		Roles:     p.Roles,
+		ExpiresAt: &session.ExpiresAt,
+		CsrfToken: &session.CSRFToken,
+	}
+	if session.Name != "" {
+		me.Name = &session.Name
+	}
+
+	return AdminCreateSession201JSONResponse{
+		Body:    me,
+		Headers: AdminCreateSession201ResponseHeaders{SetCookie: sessionCookie(SessionCookie, token, session.CreatedAt.Add(s.cfg.Sessions.Lifetime))},
+	}, nil
+}
+
+func (s *Server) AdminDeleteSession(ctx context.Context, request AdminDeleteSessionRequestObject) (AdminDeleteSessionResponseObject, error) {
+	p, _ := PrincipalFromContext(ctx)
+	if p.SessionID != uuid.Nil {
+		if err := s.db.DeleteSession(ctx, p.SessionID); err != nil {
+			slog.Error("failed to delete session", "err", err)
+			return AdminDeleteSession500JSONResponse{ErrorMessage: "failed to sign out"}, nil
+		}
+
+		slog.Info("admin session ended", "email", p.Email, "session", p.SessionID)
+		audit(ctx, "session.delete", "session", p.SessionID.String(), nil, nil)
+	}
+
+	return AdminDeleteSession204Response{Headers: AdminDeleteSession204ResponseHeaders{SetCookie: clearedSessionCookie(SessionCookie)}}, nil
+}
+
+func (s *Server) AdminListSessions(ctx context.Context, request AdminListSessionsRequestObject) (AdminListSessionsResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminListSessions403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	sessions, err := s.db.ListSessions(ctx, (*string)(request.Params.Email), time.Now())
+	if err != nil {
+		slog.Error("failed to list sessions", "err", err)
+		return AdminListSessions500JSONResponse{ErrorMessage: "failed to list sessions"}, nil
+	}
+
+	p, _ := PrincipalFromContext(ctx)
+
+	resp := AdminListSessions200JSONResponse{Sessions: []AdminSession{}}
+	for _, session := range sessions {
+		as := AdminSession{
+			Id:         session.ID,
+			Email:      openapi_types.Email(session.Email),
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.ID == p.SessionID,
+		}
+		if session.Name != "" {
+			as.Name = &session.Name
+		}
+
+		resp.Sessions = append(resp.Sessions, as)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) AdminRevokeSession(ctx context.Context, request AdminRevokeSessionRequestObject) (AdminRevokeSessionResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminRevokeSession403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	email, _ := UserEmailFromContext(ctx)
+
+	err := s.db.RevokeSession(ctx, request.SessionID, email)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminRevokeSession404JSONResponse{ErrorMessage: "session not found"}, nil
+	case err != nil:
+		slog.Error("failed to revoke session", "err", err)
+		return AdminRevokeSession500JSONResponse{ErrorMessage: "failed to revoke session"}, nil
+	}
+
+	slog.Info("admin session revoked", "session", request.SessionID, "by", email)
+	audit(ctx, "session.revoke", "session", request.SessionID.String(), nil, nil)
+
+	return AdminRevokeSession204Response{}, nil
+}
+
+// sessionExpiry is when a session last used at now expires: after it has been idle for too long, or at the end of its
+// lifetime, whichever is sooner.
+func sessionExpiry(createdAt, now time.Time, cfg SessionConfig) time.Time {
+	idle := now.Add(cfg.Idle)
+	if end := createdAt.Add(cfg.Lifetime); end.Before(idle) {
+		return end
+	}
+
+	return idle
+}
+
+// sessionCookie holds a session's token, for admins or parents depending on its name.
+func sessionCookie(name, token string, expires time.Time) string {
+	c := http.Cookie{
+		Name:     name,
+		Value:    token,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	}
+
+	return c.String()
+}
+
+func clearedSessionCookie(name string) string {
+	c := http.Cookie{
+		Name:     name,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	}
+
+	return c.String()
+}
@@ -0,0 +1,112 @@
+package rest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_AdminCreateSession(t *testing.T) {
+	t.Run("starts a session for the signed in admin", func(t *testing.T) {
+		s, m := newTestServer(t)
+		ctx := context.WithValue(context.Background(), rest.PrincipalKey{}, rest.Principal{
+			Email: "leader@staplehurstguiding.org.uk",
+			Name:  "Lucy Leader",
+			Roles: rest.Roles{role(rest.UnitLeader, "1st-brownies")},
+		})
+
+		var token []byte
+		m.db.EXPECT().CreateSession(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, session rest.Session) (rest.Session, error) {
+			assert.Equal(t, "leader@staplehurstguiding.org.uk", session.Email)
+			assert.Equal(t, session.CreatedAt.Add(testSessions.Idle), session.ExpiresAt)
+			assert.NotEmpty(t, session.CSRFToken)
+			token = session.TokenHash
+
+			session.ID = uuid.New()
+			return session, nil
+		})
+
+		resp, err := s.AdminCreateSession(ctx, rest.AdminCreateSessionRequestObject{})
+		require.NoError(t, err)
+		require.IsType(t, rest.AdminCreateSession201JSONResponse{}, resp)
+
+		created := resp.(rest.AdminCreateSession201JSONResponse)
+		assert.Equal(t, "Lucy Leader", *created.Body.Name)
+		assert.NotEmpty(t, *created.Body.CsrfToken)
+
+		cookie, err := http.ParseSetCookie(created.Headers.SetCookie)
+		require.NoError(t, err)
+		assert.Equal(t, rest.SessionCookie, cookie.Name)
+		assert.True(t, cookie.HttpOnly)
+		assert.True(t, cookie.Secure)
+		assert.Equal(t, http.SameSiteStrictMode, cookie.SameSite)
+		assert.WithinDuration(t, time.Now().Add(testSessions.Lifetime), cookie.Expires, time.Minute)
+
+		// Only the hash of the cookie is kept.
+		assert.NotEqual(t, []byte(cookie.Value), token)
+	})
+
+	t.Run("sessions can't start sessions", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		ctx := context.WithValue(context.Background(), rest.PrincipalKey{}, rest.Principal{
+			Email:     "leader@staplehurstguiding.org.uk",
+			SessionID: uuid.New(),
+		})
+
+		resp, err := s.AdminCreateSession(ctx, rest.AdminCreateSessionRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, rest.AdminCreateSession400JSONResponse{}, resp)
+	})
+}
+
+func TestServer_AdminDeleteSession(t *testing.T) {
+	s, m := newTestServer(t)
+	id := uuid.New()
+	ctx := context.WithValue(context.Background(), rest.PrincipalKey{}, rest.Principal{
+		Email:     "leader@staplehurstguiding.org.uk",
+		SessionID: id,
+	})
+
+	m.db.EXPECT().DeleteSession(ctx, id).Return(nil)
+
+	resp, err := s.AdminDeleteSession(ctx, rest.AdminDeleteSessionRequestObject{})
+	require.NoError(t, err)
+	require.IsType(t, rest.AdminDeleteSession204Response{}, resp)
+
+	cookie, err := http.ParseSetCookie(resp.(rest.AdminDeleteSession204Response).Headers.SetCookie)
+	require.NoError(t, err)
+	assert.Equal(t, rest.SessionCookie, cookie.Name)
+	assert.Equal(t, -1, cookie.MaxAge)
+}
+
+func TestServer_AdminListSessions(t *testing.T) {
+	s, m := newTestServer(t)
+	current := uuid.New()
+	ctx := context.WithValue(context.Background(), rest.PrincipalKey{}, rest.Principal{
+		Email:     commissionerEmail,
+		Roles:     rest.Roles{role(rest.DistrictCommissioner, "")},
+		SessionID: current,
+	})
+
+	m.db.EXPECT().ListSessions(ctx, nil, gomock.Any()).Return([]rest.Session{
+		{ID: current, Email: commissionerEmail},
+		{ID: uuid.New(), Email: "leader@staplehurstguiding.org.uk", Name: "Lucy Leader"},
+	}, nil)
+
+	resp, err := s.AdminListSessions(ctx, rest.AdminListSessionsRequestObject{})
+	require.NoError(t, err)
+	require.IsType(t, rest.AdminListSessions200JSONResponse{}, resp)
+
+	sessions := resp.(rest.AdminListSessions200JSONResponse).Sessions
+	require.Len(t, sessions, 2)
+	assert.True(t, sessions[0].Current)
+	assert.False(t, sessions[1].Current)
+	assert.Equal(t, "Lucy Leader", *sessions[1].Name)
+}
@@ -0,0 +1,329 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/google/uuid"
+	"github.com/thanhpk/randstr"
+)
+
+const signupTokenLength = 32
+
+var (
+	errSignupsNotOpen    = errors.New("this event is not taking sign-ups")
+	errSignupsClosed     = errors.New("sign-ups for this event have closed")
+	errSectionNotAllowed = errors.New("this event is not open to the selected section")
+	errTooYoung          = errors.New("the participant is too young for this event")
+	errTooOld            = errors.New("the participant is too old for this event")
+)
+
+func (s *Server) SignUpForEvent(ctx context.Context, request SignUpForEventRequestObject) (SignUpForEventResponseObject, error) {
+	ip, _ := UserIPFromContext(ctx)
+	if err := s.captcha.Verify(ctx, request.Body.CaptchaToken, ip); err != nil {
+		slog.Error("captcha verification failed", "err", err)
+		return SignUpForEvent422JSONResponse{ErrorMessage: "captcha verification failed"}, nil
+	}
+
+	event, err := s.db.GetEvent(ctx, request.EventID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return SignUpForEvent404JSONResponse{ErrorMessage: "event not found"}, nil
+	case err != nil:
+		slog.Error("failed to get event", "err", err)
+		return SignUpForEvent500JSONResponse{ErrorMessage: "failed to sign up"}, nil
+	}
+
+	if !slices.Contains(publicEventStatuses, string(event.Status)) {
+		return SignUpForEvent404JSONResponse{ErrorMessage: "event not found"}, nil
+	}
+
+	if err := signupsOpen(event, time.Now()); err != nil {
+		return SignUpForEvent409JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	if err := validateSignup(event, request.Body); err != nil {
+		return SignUpForEvent422JSONResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	token := randstr.Base62(signupTokenLength)
+
+	signup, err := s.db.AddEventSignup(ctx, event.Id, *request.Body, hashToken(token))
+	if err != nil {
+		slog.Error("failed to add event signup", "err", err)
+		return SignUpForEvent500JSONResponse{ErrorMessage: "failed to sign up"}, nil
+	}
+
+	key := "event-signup-confirmed"
+	if signup.Status == consts.SignupStatusWaitlisted {
+		key = "event-signup-waitlisted"
+	}
+
+	vars := signupEmailVars(event, signup)
+	vars["CancelToken"] = token
+
+	if err := s.sendEmail(ctx, string(signup.ContactEmail), key, vars); err != nil {
+		slog.Error("failed to send signup email", "err", err, "signup", signup.Id)
+	}
+
+	return SignUpForEvent201JSONResponse{
+		Id:     signup.Id,
+		Status: signup.Status,
+	}, nil
+}
+
+func (s *Server) CancelEventSignup(ctx context.Context, request CancelEventSignupRequestObject) (CancelEventSignupResponseObject, error) {
+	err := s.cancelSignup(ctx, request.EventID, request.SignupID, hashToken(request.Body.Token))
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return CancelEventSignup404JSONResponse{ErrorMessage: "sign-up not found"}, nil
+	case err != nil:
+		slog.Error("failed to cancel event signup", "err", err)
+		return CancelEventSignup500JSONResponse{ErrorMessage: "failed to cancel sign-up"}, nil
+	}
+
+	return CancelEventSignup204Response{}, nil
+}
+
+func (s *Server) AdminCancelEventSignup(ctx context.Context, request AdminCancelEventSignupRequestObject) (AdminCancelEventSignupResponseObject, error) {
+	event, err := s.db.GetEvent(ctx, request.EventID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminCancelEventSignup404JSONResponse{ErrorMessage: "event not found"}, nil
+	case err != nil:
+		slog.Error("failed to get event", "err", err)
+		return AdminCancelEventSignup500JSONResponse{ErrorMessage: "failed to cancel sign-up"}, nil
+	}
+
+	if !allowed(ctx, PermissionWrite, event.Unit) {
+		return AdminCancelEventSignup403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	err = s.cancelSignup(ctx, request.EventID, request.SignupID, nil)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminCancelEventSignup404JSONResponse{ErrorMessage: "sign-up not found"}, nil
+	case err != nil:
+		slog.Error("failed to cancel event signup", "err", err)
+		return AdminCancelEventSignup500JSONResponse{ErrorMessage: "failed to cancel sign-up"}, nil
+	}
+
+	audit(ctx, "signup.cancel", "signup", request.SignupID.String(), nil, nil)
+
+	return AdminCancelEventSignup204Response{}, nil
+}
+
+func (s *Server) AdminListEventSignups(ctx context.Context, request AdminListEventSignupsRequestObject) (AdminListEventSignupsResponseObject, error) {
+	event, err := s.db.GetEvent(ctx, request.EventID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminListEventSignups404JSONResponse{ErrorMessage: "event not found"}, nil
+	case err != nil:
+		slog.Error("failed to get event", "err", err)
+		return AdminListEventSignups500JSONResponse{ErrorMessage: "failed to list sign-ups"}, nil
+	}
+
+	if !allowed(ctx, PermissionRead, event.Unit) {
+		return AdminListEventSignups403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	signups, err := s.db.ListEventSignups(ctx, request.EventID)
+	if err != nil {
+		slog.Error("failed to list event signups", "err", err)
+		return AdminListEventSignups500JSONResponse{ErrorMessage: "failed to list sign-ups"}, nil
+	}
+
+	if signups == nil {
+		signups = []EventSignup{}
+	}
+
+	return AdminListEventSignups200JSONResponse{Signups: signups}, nil
+}
+
+func (s *Server) AdminExportEventAttendees(ctx context.Context, request AdminExportEventAttendeesRequestObject) (AdminExportEventAttendeesResponseObject, error) {
+	event, err := s.db.GetEvent(ctx, request.EventID)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminExportEventAttendees404JSONResponse{ErrorMessage: "event not found"}, nil
+	case err != nil:
+		slog.Error("failed to get event", "err", err)
+		return AdminExportEventAttendees500JSONResponse{ErrorMessage: "failed to export attendees"}, nil
+	}
+
+	if !allowed(ctx, PermissionRead, event.Unit) {
+		return AdminExportEventAttendees403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	signups, err := s.db.ListEventSignups(ctx, request.EventID, consts.SignupStatusConfirmed)
+	if err != nil {
+		slog.Error("failed to list event signups", "err", err)
+		return AdminExportEventAttendees500JSONResponse{ErrorMessage: "failed to export attendees"}, nil
+	}
+
+	body, err := attendeesCSV(signups)
+	if err != nil {
+		slog.Error("failed to write attendees csv", "err", err)
+		return AdminExportEventAttendees500JSONResponse{ErrorMessage: "failed to export attendees"}, nil
+	}
+
+	audit(ctx, "attendees.export", "event", event.Id.String(), nil, nil)
+
+	return AdminExportEventAttendees200TextcsvResponse{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+	}, nil
+}
+
+// cancelSignup cancels the sign-up and, if that frees a place, promotes from the waiting list.
+func (s *Server) cancelSignup(ctx context.Context, eventID, signupID uuid.UUID, tokenHash []byte) error {
+	signup, err := s.db.CancelEventSignup(ctx, eventID, signupID, tokenHash)
+	if err != nil {
+		return err
+	}
+
+	if signup.Status == consts.SignupStatusConfirmed {
+		s.promoteSignups(ctx, eventID)
+	}
+
+	return nil
+}
+
+// promoteSignups fills any free places on the event from its waiting list, and lets those promoted know. Failures
+// are logged rather than returned, as the change that freed the places has already been made.
+func (s *Server) promoteSignups(ctx context.Context, eventID uuid.UUID) {
+	promoted, err := s.db.PromoteEventSignups(ctx, eventID)
+	if err != nil {
+		slog.Error("failed to promote event signups", "err", err, "event", eventID)
+		return
+	}
+
+	if len(promoted) == 0 {
+		return
+	}
+
+	event, err := s.db.GetEvent(ctx, eventID)
+	if err != nil {
+		slog.Error("failed to get event", "err", err, "event", eventID)
+		return
+	}
+
+	for _, signup := range promoted {
+		err := s.sendEmail(ctx, string(signup.ContactEmail), "event-signup-promoted", signupEmailVars(event, signup))
+		if err != nil {
+			slog.Error("failed to send signup promotion email", "err", err, "signup", signup.Id)
+		}
+	}
+}
+
+func signupsOpen(event AdminEvent, now time.Time) error {
+	if event.Signups == nil || string(event.Status) == consts.EventStatusCancelled {
+		return errSignupsNotOpen
+	}
+
+	if now.After(event.Signups.Deadline) {
+		return errSignupsClosed
+	}
+
+	return nil
+}
+
+func validateSignup(event AdminEvent, signup *EventSignupInput) error {
+	settings := event.Signups
+
+	if settings.Sections != nil && len(*settings.Sections) > 0 && !slices.Contains(*settings.Sections, signup.Section) {
+		return errSectionNotAllowed
+	}
+
+	age := ageOn(signup.DateOfBirth.Time, event.Start)
+
+	if settings.MinAge != nil && age < *settings.MinAge {
+		return errTooYoung
+	}
+
+	if settings.MaxAge != nil && age > *settings.MaxAge {
+		return errTooOld
+	}
+
+	return nil
+}
+
+func signupEmailVars(event AdminEvent, signup EventSignup) map[string]any {
+	return map[string]any{
+		"EventID":         event.Id,
+		"EventTitle":      event.Title,
+		"EventStart":      event.Start,
+		"EventEnd":        event.End,
+		"EventLocation":   event.Location,
+		"SignupID":        signup.Id,
+		"ParticipantName": signup.ParticipantName,
+		"ContactName":     signup.ContactName,
+		"Status":          signup.Status,
+	}
+}
+
+func attendeesCSV(signups []EventSignup) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	err := w.Write([]string{
+		"Name", "Date of Birth", "Section", "Unit", "Contact Name", "Contact Email", "Contact Phone", "Notes",
+		"Signed Up",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, signup := range signups {
+		err := w.Write([]string{
+			csvSafe(signup.ParticipantName),
+			signup.DateOfBirth.String(),
+			string(signup.Section),
+			csvSafe(deref(signup.Unit)),
+			csvSafe(signup.ContactName),
+			csvSafe(string(signup.ContactEmail)),
+			csvSafe(deref(signup.ContactPhone)),
+			csvSafe(deref(signup.Notes)),
+			signup.CreatedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("flushing csv: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// csvSafe stops values entered by the public from being treated as formulas when the export is opened in a
+// spreadsheet.
+func csvSafe(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func hashToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,219 @@
+package rest_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func signupEvent(start time.Time) rest.AdminEvent {
+	capacity, minAge, maxAge := 20, 7, 10
+	sections := []rest.Section{consts.SectionBrownies}
+
+	return rest.AdminEvent{
+		Id:     uuid.New(),
+		Title:  "Brownie Revels",
+		Start:  start,
+		End:    start.Add(4 * time.Hour),
+		Status: consts.EventStatusApproved,
+		Signups: &rest.EventSignupSettings{
+			Capacity: &capacity,
+			Deadline: start.AddDate(0, 0, -7),
+			Sections: &sections,
+			MinAge:   &minAge,
+			MaxAge:   &maxAge,
+		},
+	}
+}
+
+func TestServer_SignUpForEvent(t *testing.T) {
+	ctx := commissionerContext()
+	start := time.Now().AddDate(0, 1, 0)
+
+	signup := rest.EventSignupInput{
+		ParticipantName: "Ada",
+		DateOfBirth:     openapi_types.Date{Time: start.AddDate(-8, 0, 0)},
+		Section:         consts.SectionBrownies,
+		ContactName:     "Parent",
+		ContactEmail:    "parent@example.com",
+		CaptchaToken:    "token",
+	}
+
+	t.Run("waitlisted sign-ups are told they are on the waiting list", func(t *testing.T) {
+		s, m := newTestServer(t)
+		event := signupEvent(start)
+
+		m.captcha.EXPECT().Verify(ctx, "token", "").Return(nil)
+		m.db.EXPECT().GetEvent(ctx, event.Id).Return(event, nil)
+		m.db.EXPECT().AddEventSignup(ctx, event.Id, signup, gomock.Len(32)).Return(rest.EventSignup{
+			Id:           uuid.New(),
+			EventId:      event.Id,
+			ContactEmail: signup.ContactEmail,
+			Status:       consts.SignupStatusWaitlisted,
+		}, nil)
+		m.content.EXPECT().EmailTemplate(ctx, "event-signup-waitlisted", gomock.Any()).
+			Return(rest.EmailContent{Subject: "subject", Body: "body"}, nil)
+		m.email.EXPECT().Send(ctx, "parent@example.com", "subject", "body").Return(nil)
+
+		resp, err := s.SignUpForEvent(ctx, rest.SignUpForEventRequestObject{EventID: event.Id, Body: &signup})
+		require.NoError(t, err)
+		require.IsType(t, rest.SignUpForEvent201JSONResponse{}, resp)
+		assert.Equal(t, rest.SignupStatus(consts.SignupStatusWaitlisted), resp.(rest.SignUpForEvent201JSONResponse).Status)
+	})
+
+	tests := []struct {
+		name   string
+		event  func(rest.AdminEvent) rest.AdminEvent
+		signup func(rest.EventSignupInput) rest.EventSignupInput
+		resp   rest.SignUpForEventResponseObject
+	}{
+		{
+			name: "provisional events are not found",
+			event: func(e rest.AdminEvent) rest.AdminEvent {
+				e.Status = consts.EventStatusProvisional
+				return e
+			},
+			resp: rest.SignUpForEvent404JSONResponse{ErrorMessage: "event not found"},
+		},
+		{
+			name: "events without sign-ups are closed",
+			event: func(e rest.AdminEvent) rest.AdminEvent {
+				e.Signups = nil
+				return e
+			},
+			resp: rest.SignUpForEvent409JSONResponse{ErrorMessage: "this event is not taking sign-ups"},
+		},
+		{
+			name: "sign-ups after the deadline are closed",
+			event: func(e rest.AdminEvent) rest.AdminEvent {
+				e.Signups.Deadline = time.Now().Add(-time.Minute)
+				return e
+			},
+			resp: rest.SignUpForEvent409JSONResponse{ErrorMessage: "sign-ups for this event have closed"},
+		},
+		{
+			name: "other sections are rejected",
+			signup: func(s rest.EventSignupInput) rest.EventSignupInput {
+				s.Section = consts.SectionGuides
+				return s
+			},
+			resp: rest.SignUpForEvent422JSONResponse{ErrorMessage: "this event is not open to the selected section"},
+		},
+		{
+			name: "participants older than the maximum age are rejected",
+			signup: func(s rest.EventSignupInput) rest.EventSignupInput {
+				s.DateOfBirth = openapi_types.Date{Time: start.AddDate(-11, 0, 0)}
+				return s
+			},
+			resp: rest.SignUpForEvent422JSONResponse{ErrorMessage: "the participant is too old for this event"},
+		},
+		{
+			name: "participants are too young until their birthday",
+			signup: func(s rest.EventSignupInput) rest.EventSignupInput {
+				s.DateOfBirth = openapi_types.Date{Time: start.AddDate(-7, 0, 1)}
+				return s
+			},
+			resp: rest.SignUpForEvent422JSONResponse{ErrorMessage: "the participant is too young for this event"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, m := newTestServer(t)
+
+			event := signupEvent(start)
+			if tt.event != nil {
+				event = tt.event(event)
+			}
+
+			body := signup
+			if tt.signup != nil {
+				body = tt.signup(body)
+			}
+
+			m.captcha.EXPECT().Verify(ctx, "token", "").Return(nil)
+			m.db.EXPECT().GetEvent(ctx, event.Id).Return(event, nil)
+
+			resp, err := s.SignUpForEvent(ctx, rest.SignUpForEventRequestObject{EventID: event.Id, Body: &body})
+			require.NoError(t, err)
+			assert.Equal(t, tt.resp, resp)
+		})
+	}
+}
+
+func TestServer_AdminCancelEventSignup(t *testing.T) {
+	ctx := commissionerContext()
+	event := signupEvent(time.Now().AddDate(0, 1, 0))
+	signupID := uuid.New()
+
+	t.Run("cancelling a confirmed sign-up promotes from the waiting list", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().CancelEventSignup(ctx, event.Id, signupID, nil).
+			Return(rest.EventSignup{Id: signupID, Status: consts.SignupStatusConfirmed}, nil)
+		m.db.EXPECT().PromoteEventSignups(ctx, event.Id).
+			Return([]rest.EventSignup{{Id: uuid.New(), ContactEmail: "next@example.com"}}, nil)
+		m.db.EXPECT().GetEvent(ctx, event.Id).Return(event, nil).Times(2)
+		m.content.EXPECT().EmailTemplate(ctx, "event-signup-promoted", gomock.Any()).
+			Return(rest.EmailContent{Subject: "subject", Body: "body"}, nil)
+		m.email.EXPECT().Send(ctx, "next@example.com", "subject", "body").Return(nil)
+
+		resp, err := s.AdminCancelEventSignup(ctx, rest.AdminCancelEventSignupRequestObject{
+			EventID:  event.Id,
+			SignupID: signupID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminCancelEventSignup204Response{}, resp)
+	})
+
+	t.Run("cancelling a waitlisted sign-up frees no places", func(t *testing.T) {
+		s, m := newTestServer(t)
+
+		m.db.EXPECT().GetEvent(ctx, event.Id).Return(event, nil)
+		m.db.EXPECT().CancelEventSignup(ctx, event.Id, signupID, nil).
+			Return(rest.EventSignup{Id: signupID, Status: consts.SignupStatusWaitlisted}, nil)
+
+		resp, err := s.AdminCancelEventSignup(ctx, rest.AdminCancelEventSignupRequestObject{
+			EventID:  event.Id,
+			SignupID: signupID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rest.AdminCancelEventSignup204Response{}, resp)
+	})
+}
+
+func TestServer_AdminExportEventAttendees(t *testing.T) {
+	ctx := commissionerContext()
+	event := signupEvent(time.Now().AddDate(0, 1, 0))
+	created := time.Date(2026, time.January, 5, 9, 30, 0, 0, time.UTC)
+
+	s, m := newTestServer(t)
+	m.db.EXPECT().GetEvent(ctx, event.Id).Return(event, nil)
+	m.db.EXPECT().ListEventSignups(ctx, event.Id, consts.SignupStatusConfirmed).Return([]rest.EventSignup{{
+		ParticipantName: "=HYPERLINK(\"x\")",
+		DateOfBirth:     openapi_types.Date{Time: time.Date(2018, time.March, 4, 0, 0, 0, 0, time.UTC)},
+		Section:         consts.SectionBrownies,
+		ContactName:     "Parent",
+		ContactEmail:    "parent@example.com",
+		CreatedAt:       created,
+	}}, nil)
+
+	resp, err := s.AdminExportEventAttendees(ctx, rest.AdminExportEventAttendeesRequestObject{EventID: event.Id})
+	require.NoError(t, err)
+	require.IsType(t, rest.AdminExportEventAttendees200TextcsvResponse{}, resp)
+
+	body, err := io.ReadAll(resp.(rest.AdminExportEventAttendees200TextcsvResponse).Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Name,Date of Birth,Section,Unit,Contact Name,Contact Email,Contact Phone,Notes,Signed Up\n"+
+		"\"'=HYPERLINK(\"\"x\"\")\",2018-03-04,brownies,,Parent,parent@example.com,,,2026-01-05T09:30:00Z\n",
+		string(body))
+}
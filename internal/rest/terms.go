@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// term is a school term. Spring runs from January to March, summer from April to August and autumn from September to
+// December, so that every date falls in a term.
+type term struct {
+	Name  string
+	Start time.Time
+	// End is the first day after the term.
+	End time.Time
+}
+
+var termStartMonths = map[string]time.Month{
+	"spring": time.January,
+	"summer": time.April,
+	"autumn": time.September,
+}
+
+var errInvalidTerm = errors.New("term must be a year and spring, summer or autumn, such as 2026-autumn")
+
+// termOf returns the term that the date falls in.
+func termOf(t time.Time) term {
+	name := "spring"
+	switch {
+	case t.Month() >= time.September:
+		name = "autumn"
+	case t.Month() >= time.April:
+		name = "summer"
+	}
+
+	return newTerm(t.Year(), name)
+}
+
+// parseTerm parses a term written as the year and name, such as 2026-autumn.
+func parseTerm(s string) (term, error) {
+	year, name, found := strings.Cut(s, "-")
+	if !found {
+		return term{}, errInvalidTerm
+	}
+
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		return term{}, errInvalidTerm
+	}
+
+	if _, ok := termStartMonths[name]; !ok {
+		return term{}, errInvalidTerm
+	}
+
+	return newTerm(y, name), nil
+}
+
+func newTerm(year int, name string) term {
+	start := time.Date(year, termStartMonths[name], 1, 0, 0, 0, 0, time.UTC)
+
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	switch name {
+	case "spring":
+		end = time.Date(year, termStartMonths["summer"], 1, 0, 0, 0, 0, time.UTC)
+	case "summer":
+		end = time.Date(year, termStartMonths["autumn"], 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return term{Name: fmt.Sprintf("%d-%s", year, name), Start: start, End: end}
+}
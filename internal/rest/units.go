@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+)
+
+func (s *Server) ListUnits(ctx context.Context, request ListUnitsRequestObject) (ListUnitsResponseObject, error) {
+	units, err := s.db.ListUnits(ctx)
+	if err != nil {
+		slog.Error("failed to list units", "err", err)
+		return ListUnits500JSONResponse{ErrorMessage: "failed to list units"}, nil
+	}
+
+	if units == nil {
+		units = []Unit{}
+	}
+
+	return ListUnits200JSONResponse{Units: units}, nil
+}
+
+func (s *Server) AdminListUnits(ctx context.Context, request AdminListUnitsRequestObject) (AdminListUnitsResponseObject, error) {
+	if !allowedAnywhere(ctx, PermissionRead) {
+		return AdminListUnits403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	units, err := s.db.ListAdminUnits(ctx)
+	if err != nil {
+		slog.Error("failed to list units", "err", err)
+		return AdminListUnits500JSONResponse{ErrorMessage: "failed to list units"}, nil
+	}
+
+	if units == nil {
+		units = []AdminUnit{}
+	}
+
+	return AdminListUnits200JSONResponse{Units: units}, nil
+}
+
+func (s *Server) AdminUpdateUnit(ctx context.Context, request AdminUpdateUnitRequestObject) (AdminUpdateUnitResponseObject, error) {
+	if !allowed(ctx, PermissionWrite, nil) {
+		return AdminUpdateUnit403JSONResponse{ErrorMessage: errForbidden.Error()}, nil
+	}
+
+	if request.Body.Capacity != nil && *request.Body.Capacity < 0 {
+		return AdminUpdateUnit422JSONResponse{ErrorMessage: "capacity must not be negative"}, nil
+	}
+
+	unit, err := s.db.UpdateUnit(ctx, request.UnitID, *request.Body)
+	switch {
+	case errors.Is(err, consts.ErrNotFound):
+		return AdminUpdateUnit404JSONResponse{ErrorMessage: "unit not found"}, nil
+	case err != nil:
+		slog.Error("failed to update unit", "err", err)
+		return AdminUpdateUnit500JSONResponse{ErrorMessage: "failed to update unit"}, nil
+	}
+
+	audit(ctx, "unit.update", "unit", string(request.UnitID), nil, request.Body)
+
+	return AdminUpdateUnit200JSONResponse(unit), nil
+}
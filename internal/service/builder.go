@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/girlguidingstaplehurst/district"
+	schema "github.com/girlguidingstaplehurst/district/db"
+	"github.com/girlguidingstaplehurst/district/internal/captcha"
+	"github.com/girlguidingstaplehurst/district/internal/config"
+	"github.com/girlguidingstaplehurst/district/internal/content"
+	"github.com/girlguidingstaplehurst/district/internal/database"
+	"github.com/girlguidingstaplehurst/district/internal/email"
+	"github.com/girlguidingstaplehurst/district/internal/encryption"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/gofiber/contrib/otelfiber"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/filesystem"
+	fibermiddleware "github.com/oapi-codegen/fiber-middleware"
+)
+
+// Builder assembles the service's HTTP app from its config. The outside services it talks to can be given with the
+// With methods, such as fakes in tests. Whatever isn't given is created from the config.
+type Builder struct {
+	cfg     *config.Config
+	db      rest.Database
+	captcha rest.CaptchaVerifier
+	content rest.ContentManager
+	email   rest.EmailSender
+	tokens  rest.TokenVerifier
+	watcher *config.Watcher
+}
+
+// NewBuilder creates a Builder for the config, which must already be valid.
+func NewBuilder(cfg *config.Config) *Builder {
+	return &Builder{cfg: cfg}
+}
+
+// WithDatabase uses db rather than opening the Postgres database configured, which is then neither connected to nor
+// migrated.
+func (b *Builder) WithDatabase(db rest.Database) *Builder {
+	b.db = db
+	return b
+}
+
+// WithCaptcha uses v rather than verifying captchas with Google reCAPTCHA.
+func (b *Builder) WithCaptcha(v rest.CaptchaVerifier) *Builder {
+	b.captcha = v
+	return b
+}
+
+// WithContent uses m rather than fetching email templates from Contentful.
+func (b *Builder) WithContent(m rest.ContentManager) *Builder {
+	b.content = m
+	return b
+}
+
+// WithEmail uses s rather than sending email through the SMTP server configured.
+func (b *Builder) WithEmail(s rest.EmailSender) *Builder {
+	b.email = s
+	return b
+}
+
+// WithTokenVerifier uses v to verify admins' ID tokens, rather than Google and the providers configured.
+func (b *Builder) WithTokenVerifier(v rest.TokenVerifier) *Builder {
+	b.tokens = v
+	return b
+}
+
+// WithWatcher applies the settings w reloads to the app, without a restart. The sign in settings are always applied,
+// as are the captcha and SMTP settings unless a captcha verifier or email sender was given.
+func (b *Builder) WithWatcher(w *config.Watcher) *Builder {
+	b.watcher = w
+	return b
+}
+
+// Build builds the service's HTTP app: the site, the API and everything in front of it. Its background work, such as
+// expiring place offers, runs once the Lifecycle is started, and what Build opened is closed when it's stopped.
+func (b *Builder) Build(ctx context.Context) (_ *fiber.App, _ *Lifecycle, err error) {
+	cfg := b.cfg
+	lc := new(Lifecycle)
+
+	// Whatever was opened before failing is closed again.
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, stop(lc, cfg.HTTP.StopTimeout))
+		}
+	}()
+
+	if cfg.Telemetry.Enabled {
+		otelShutdown, err := setupOTelSDK(ctx, cfg.Telemetry)
+		if err != nil {
+			return nil, nil, err
+		}
+		lc.OnStop(otelShutdown)
+	}
+
+	db := b.db
+	if db == nil {
+		if db, err = b.openDatabase(ctx, lc); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	tokens := b.tokens
+	if tokens == nil {
+		if tokens, err = tokenVerifier(cfg.Auth); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	verifier := b.captcha
+	if verifier == nil {
+		v := captcha.NewVerifier(cfg.Captcha.Secret, cfg.Captcha.Armed, cfg.Captcha.MinScore)
+		b.onChange(func(cfg *config.Config) { v.Update(cfg.Captcha.Secret, cfg.Captcha.Armed, cfg.Captcha.MinScore) })
+		verifier = v
+	}
+
+	sender := b.email
+	if sender == nil {
+		s := email.NewSender(cfg.SMTP.Server, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password)
+		b.onChange(func(cfg *config.Config) {
+			s.Update(cfg.SMTP.Server, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password)
+		})
+		sender = s
+	}
+
+	contentManager := b.content
+	if contentManager == nil {
+		contentManager = content.NewManager(cfg.Content.URL, cfg.Content.Token)
+	}
+
+	app := fiber.New(fiber.Config{
+		ProxyHeader: cfg.HTTP.ProxyHeader,
+	})
+
+	app.Use(otelfiber.Middleware())
+
+	app.Use("/", filesystem.New(filesystem.Config{
+		Root:       http.FS(booking.Files),
+		PathPrefix: "/build",
+	}))
+
+	htmlPaths := []string{"/2nd-rainbows", "/1st-brownies", "/4th-brownies", "/1st-guides", "/1st-rangers"}
+	app.Use(htmlPaths, func(c *fiber.Ctx) error {
+		return filesystem.SendFile(c, http.FS(booking.IndexHTML), "/build/index.html")
+	})
+
+	swagger, err := rest.GetSwagger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app.Use(fibermiddleware.OapiRequestValidatorWithOptions(swagger, &fibermiddleware.Options{
+		Options: openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc},
+	}))
+
+	ipExtractor := rest.NewIPExtractor()
+	app.Use(ipExtractor.Extract)
+
+	sessions := rest.SessionConfig{Idle: cfg.Auth.Sessions.Idle, Lifetime: cfg.Auth.Sessions.Lifetime}
+
+	// The denylist is loaded before serving, so revoked admins aren't let in while it's empty.
+	denylist := rest.NewDenylist(db)
+	if err := denylist.Refresh(ctx); err != nil {
+		return nil, nil, fmt.Errorf("loading denylist: %w", err)
+	}
+
+	lc.Go(func(ctx context.Context) { denylist.Run(ctx, cfg.Auth.Revocations.Refresh) })
+
+	jwtAuth := rest.NewJWTAuthenticator(tokens, db, denylist, sessions, cfg.Auth.Domains, cfg.Auth.AllowList)
+	app.Use("/api/v1/admin", jwtAuth.Validate, rest.NewAuditor(db).Record)
+
+	b.onChange(func(cfg *config.Config) { jwtAuth.UpdateAdmission(cfg.Auth.Domains, cfg.Auth.AllowList) })
+
+	parents := rest.ParentLoginConfig{
+		LinkExpiry: cfg.Auth.Parents.LinkExpiry,
+		Sessions:   rest.SessionConfig{Idle: cfg.Auth.Parents.Sessions.Idle, Lifetime: cfg.Auth.Parents.Sessions.Lifetime},
+	}
+	app.Use("/api/v1/family", rest.NewParentAuthenticator(db, parents.Sessions).Validate)
+
+	keys, err := encryption.ParseKeys(cfg.Encryption.Keys)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid encryption.keys: %w", err)
+	}
+
+	keyring, err := encryption.NewKeyring(cfg.Encryption.KeyID, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rs := rest.NewServer(db, verifier, contentManager, sender, keyring, rest.ServerConfig{
+		OfferExpiry:      cfg.PlaceOffers.Expiry,
+		InvitationExpiry: cfg.Auth.Invitations.Expiry,
+		Sessions:         sessions,
+		Parents:          parents,
+		Limits:           limits(cfg.RateLimits),
+	})
+	rest.RegisterHandlers(app, rest.NewStrictHandler(rs, nil))
+
+	b.onChange(func(cfg *config.Config) { rs.UpdateLimits(limits(cfg.RateLimits)) })
+
+	lc.Go(func(ctx context.Context) { rs.RunPlaceOfferExpiry(ctx, cfg.PlaceOffers.Interval) })
+
+	if b.watcher != nil {
+		lc.Go(func(ctx context.Context) { b.watcher.Run(ctx, cfg.Reload.Interval) })
+	}
+
+	return app, lc, nil
+}
+
+// openDatabase connects to the Postgres database configured, migrating it first if asked to. The pool is closed when
+// the Lifecycle is stopped.
+func (b *Builder) openDatabase(ctx context.Context, lc *Lifecycle) (rest.Database, error) {
+	pool, err := database.NewPool(ctx, b.cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	lc.OnStop(func(context.Context) error {
+		pool.Close()
+		return nil
+	})
+
+	if b.cfg.Database.Migrate {
+		migrator, err := database.NewMigrator(pool, schema.Migrations)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := migrator.Up(ctx); err != nil {
+			return nil, fmt.Errorf("migrating database: %w", err)
+		}
+	}
+
+	return database.NewDatabase(pool), nil
+}
+
+// onChange calls fn with each config the watcher reloads, if there is one.
+func (b *Builder) onChange(fn func(cfg *config.Config)) {
+	if b.watcher != nil {
+		b.watcher.OnChange(func(cfg *config.Config, _ []string) { fn(cfg) })
+	}
+}
+
+func limits(cfg config.RateLimitConfig) rest.Limits {
+	return rest.Limits{
+		JoinRequests: rest.RateLimit{Limit: cfg.JoinRequests.Limit, Window: cfg.JoinRequests.Window},
+		ParentLogins: rest.RateLimit{Limit: cfg.ParentLogins.Limit, Window: cfg.ParentLogins.Window},
+	}
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Lifecycle runs the app's background work, such as expiring place offers, and releases what was opened for it, such
+// as the database pool.
+type Lifecycle struct {
+	workers []func(context.Context)
+	closers []func(context.Context) error
+
+	cancel context.CancelFunc
+	done   sync.WaitGroup
+}
+
+// Go adds fn to the work run in the background from Start until Stop. It must return once its context is done.
+func (l *Lifecycle) Go(fn func(ctx context.Context)) {
+	l.workers = append(l.workers, fn)
+}
+
+// OnStop adds fn to what Stop calls once the background work has finished. They're called in the reverse of the order
+// they were added, so what was opened first is closed last.
+func (l *Lifecycle) OnStop(fn func(ctx context.Context) error) {
+	l.closers = append(l.closers, fn)
+}
+
+// Start runs the background work until Stop is called or ctx is done.
+func (l *Lifecycle) Start(ctx context.Context) {
+	ctx, l.cancel = context.WithCancel(ctx)
+
+	for _, fn := range l.workers {
+		l.done.Go(func() { fn(ctx) })
+	}
+}
+
+// Stop ends the background work and waits for it to finish, then calls the OnStop functions, joining their errors.
+// If ctx is done before the work finishes, the OnStop functions are called anyway. Stop returns once ctx is done, even
+// if something it's waiting for is stuck, such as a closer that ignores ctx.
+func (l *Lifecycle) Stop(ctx context.Context) error {
+	stopped := make(chan error, 1)
+	go func() { stopped <- l.stop(ctx) }()
+
+	select {
+	case err := <-stopped:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("stopping: %w", ctx.Err())
+	}
+}
+
+func (l *Lifecycle) stop(ctx context.Context) error {
+	var err error
+
+	if l.cancel != nil {
+		l.cancel()
+
+		finished := make(chan struct{})
+		go func() {
+			l.done.Wait()
+			close(finished)
+		}()
+
+		select {
+		case <-finished:
+		case <-ctx.Done():
+			err = fmt.Errorf("waiting for background work to stop: %w", ctx.Err())
+		}
+	}
+
+	for i := len(l.closers) - 1; i >= 0; i-- {
+		err = errors.Join(err, l.closers[i](ctx))
+	}
+	l.closers = nil
+
+	return err
+}
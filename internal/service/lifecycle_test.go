@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLifecycle(t *testing.T) {
+	var events []string
+
+	lc := new(service.Lifecycle)
+	lc.Go(func(ctx context.Context) {
+		<-ctx.Done()
+		events = append(events, "worker stopped")
+	})
+	lc.OnStop(func(context.Context) error {
+		events = append(events, "telemetry flushed")
+		return nil
+	})
+	lc.OnStop(func(context.Context) error {
+		events = append(events, "pool closed")
+		return errors.New("boom")
+	})
+
+	lc.Start(context.Background())
+	err := lc.Stop(context.Background())
+
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, []string{"worker stopped", "pool closed", "telemetry flushed"}, events)
+}
+
+func TestLifecycle_StopTimesOut(t *testing.T) {
+	var closed atomic.Bool
+
+	lc := new(service.Lifecycle)
+	lc.Go(func(context.Context) { select {} })
+	lc.OnStop(func(context.Context) error {
+		closed.Store(true)
+		return nil
+	})
+
+	lc.Start(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, lc.Stop(ctx), context.Canceled)
+	assert.Eventually(t, closed.Load, time.Second, time.Millisecond,
+		"closers are called even when the work doesn't stop in time")
+}
+
+func TestLifecycle_StuckCloser(t *testing.T) {
+	lc := new(service.Lifecycle)
+	lc.OnStop(func(context.Context) error { select {} })
+
+	lc.Start(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, lc.Stop(ctx), context.DeadlineExceeded)
+}
@@ -25,7 +25,7 @@ import (
 
 // setupOTelSDK bootstraps the OpenTelemetry pipeline.
 // If it does not return an error, make sure to call shutdown for proper cleanup.
-func setupOTelSDK(ctx context.Context) (shutdown func(context.Context) error, err error) {
+func setupOTelSDK(ctx context.Context, cfg config.TelemetryConfig) (shutdown func(context.Context) error, err error) {
 	var shutdownFuncs []func(context.Context) error
 
 	// shutdown calls cleanup functions registered via shutdownFuncs.
@@ -49,7 +49,7 @@ func setupOTelSDK(ctx context.Context) (shutdown func(context.Context) error, er
 	prop := newPropagator()
 	otel.SetTextMapPropagator(prop)
 
-	res := newResource()
+	res := newResource(cfg.ServiceName)
 
 	// Set up trace provider.
 	tracerProvider, err := newTraceProvider(ctx, res)
@@ -70,7 +70,7 @@ func setupOTelSDK(ctx context.Context) (shutdown func(context.Context) error, er
 	otel.SetMeterProvider(meterProvider)
 
 	// Set up logger provider.
-	loggerProvider, err := newLoggerProvider(ctx, res)
+	loggerProvider, err := newLoggerProvider(ctx, res, cfg.ServiceName)
 	if err != nil {
 		handleErr(err)
 		return
@@ -123,7 +123,7 @@ func newMeterProvider(ctx context.Context, res *resource.Resource) (*metric.Mete
 	return meterProvider, nil
 }
 
-func newLoggerProvider(ctx context.Context, res *resource.Resource) (*log.LoggerProvider, error) {
+func newLoggerProvider(ctx context.Context, res *resource.Resource, serviceName string) (*log.LoggerProvider, error) {
 	logExporter, err := otlploggrpc.New(ctx)
 	if err != nil {
 		return nil, err
@@ -137,17 +137,17 @@ func newLoggerProvider(ctx context.Context, res *resource.Resource) (*log.Logger
 	global.SetLoggerProvider(loggerProvider)
 
 	// Configure slog to use OTLP
-	slog.SetDefault(otelslog.NewLogger("booking"))
+	slog.SetDefault(otelslog.NewLogger(serviceName))
 
 	return loggerProvider, nil
 }
 
-func newResource() *resource.Resource {
+func newResource(serviceName string) *resource.Resource {
 	r, err := resource.Merge(
 		resource.Default(),
 		resource.NewWithAttributes(
 			semconv.SchemaURL,
-			semconv.ServiceNameKey.String("booking"),
+			semconv.ServiceNameKey.String(serviceName),
 			semconv.ServiceVersionKey.String(config.Version)))
 	if err != nil {
 		slog.Error("failed to instantiate otel resource, continuing with default", "err", err)
@@ -3,79 +3,134 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
-	"os"
+	"slices"
+	"time"
 
-	"github.com/getkin/kin-openapi/openapi3filter"
-	"github.com/girlguidingstaplehurst/district"
 	"github.com/girlguidingstaplehurst/district/internal/config"
-	"github.com/girlguidingstaplehurst/district/internal/rest"
-	"github.com/gofiber/contrib/otelfiber"
-	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/filesystem"
-	fibermiddleware "github.com/oapi-codegen/fiber-middleware"
+	"github.com/girlguidingstaplehurst/district/internal/oidc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 type Service struct {
+	configPath string
 }
 
-func NewService() *Service {
-	return &Service{}
+// NewService creates a Service configured from the YAML file at configPath, over the defaults. If configPath is empty,
+// the file named by BOOKING_CONFIG is used, if any.
+func NewService(configPath string) *Service {
+	return &Service{configPath: configPath}
 }
 
+// Run serves the app until ctx is done, then shuts down gracefully: requests in flight are given http.shutdowntimeout
+// to finish, then the background work and telemetry flush are given http.stoptimeout.
 func (s *Service) Run(ctx context.Context) error {
 	svcCfg := new(config.Config)
 
-	if err := config.Load(svcCfg); err != nil {
+	if err := config.Load(svcCfg, s.configPath); err != nil {
 		return err
 	}
 
-	//TODO set up config struct
-	if _, ok := os.LookupEnv("OTEL_SERVICE_NAME"); ok {
-		// Set up OpenTelemetry.
-		otelShutdown, err := setupOTelSDK(ctx)
-		if err != nil {
-			return err
-		}
-		// Handle shutdown properly so nothing leaks.
-		defer func() {
-			err = errors.Join(err, otelShutdown(context.Background()))
-		}()
+	if err := svcCfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
 	}
 
-	app := fiber.New(fiber.Config{
-		ProxyHeader: "X-Forwarded-For",
-	})
-
-	app.Use(otelfiber.Middleware())
-
-	app.Use("/", filesystem.New(filesystem.Config{
-		Root:       http.FS(booking.Files),
-		PathPrefix: "/build",
-	}))
+	watcher, err := config.NewWatcher(s.configPath, svcCfg)
+	if err != nil {
+		return err
+	}
 
-	htmlPaths := []string{"/2nd-rainbows", "/1st-brownies", "/4th-brownies", "/1st-guides", "/1st-rangers"}
-	app.Use(htmlPaths, func(c *fiber.Ctx) error {
-		return filesystem.SendFile(c, http.FS(booking.IndexHTML), "/build/index.html")
+	watcher.OnChange(func(_ *config.Config, changed []string) {
+		if restart := slices.DeleteFunc(slices.Clone(changed), liveSetting); len(restart) > 0 {
+			slog.Warn("config changed that needs a restart to apply", "settings", restart)
+		}
 	})
 
-	swagger, err := rest.GetSwagger()
+	app, lc, err := NewBuilder(svcCfg).WithWatcher(watcher).Build(ctx)
 	if err != nil {
 		return err
 	}
 
-	app.Use(fibermiddleware.OapiRequestValidatorWithOptions(swagger, &fibermiddleware.Options{
-		Options: openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc},
-	}))
+	// The background work keeps running while requests drain, and is only stopped once they have.
+	lc.Start(context.WithoutCancel(ctx))
+
+	listening := make(chan error, 1)
+	go func() { listening <- app.Listen(svcCfg.HTTP.Address) }()
+
+	select {
+	case err := <-listening:
+		return errors.Join(err, stop(lc, svcCfg.HTTP.StopTimeout))
+	case <-ctx.Done():
+	}
 
-	ipExtractor := rest.NewIPExtractor()
-	app.Use(ipExtractor.Extract)
+	slog.Info("shutting down, waiting for requests in flight", "timeout", svcCfg.HTTP.ShutdownTimeout)
 
-	jwtAuth := rest.NewJWTAuthenticator(os.Getenv("GOOGLE_CLIENT_ID"), "kathielambcentre.org", "staplehurstguiding.org.uk") //TODO externalize
-	app.Use("/api/v1/admin", jwtAuth.Validate)
+	drainCtx, cancel := context.WithTimeout(context.Background(), svcCfg.HTTP.ShutdownTimeout)
+	defer cancel()
 
-	rs := rest.NewServer()
-	rest.RegisterHandlers(app, rest.NewStrictHandler(rs, nil))
+	// New connections are refused straight away, and those idle are closed.
+	if err = app.ShutdownWithContext(drainCtx); err != nil {
+		err = fmt.Errorf("waiting for requests in flight: %w", err)
+	}
+
+	// Then the background work is stopped, the database pool closed, and telemetry flushed, in that order.
+	return errors.Join(err, <-listening, stop(lc, svcCfg.HTTP.StopTimeout))
+}
+
+// stop stops lc, giving up after timeout so a stuck worker or telemetry exporter can't hold up the exit.
+func stop(lc *Lifecycle, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return lc.Stop(ctx)
+}
+
+// liveSettings are the settings a config reload applies without a restart.
+var liveSettings = []string{
+	"auth.domains",
+	"auth.allowlist",
+	"captcha.secret",
+	"captcha.armed",
+	"captcha.minscore",
+	"smtp.server",
+	"smtp.port",
+	"smtp.username",
+	"smtp.password",
+	"ratelimits.joinrequests.limit",
+	"ratelimits.joinrequests.window",
+	"ratelimits.parentlogins.limit",
+	"ratelimits.parentlogins.window",
+}
+
+func liveSetting(key string) bool {
+	return slices.Contains(liveSettings, key)
+}
+
+// tokenVerifier verifies Google ID tokens, and those from the other OpenID Connect providers configured.
+func tokenVerifier(cfg config.AuthConfig) (*oidc.Verifiers, error) {
+	client := &http.Client{Timeout: 10 * time.Second, Transport: otelhttp.NewTransport(http.DefaultTransport)}
+
+	google, err := oidc.NewGoogle(cfg.GoogleClientID, client)
+	if err != nil {
+		return nil, err
+	}
+
+	verifiers := []oidc.IssuerVerifier{google}
+	for _, p := range cfg.Providers {
+		verifiers = append(verifiers, oidc.NewProvider(oidc.ProviderConfig{
+			Issuer:    p.Issuer,
+			Audiences: p.Audiences,
+			Claims: oidc.ClaimMapping{
+				Email:         p.Claims.Email,
+				EmailVerified: p.Claims.EmailVerified,
+				Name:          p.Claims.Name,
+				HostedDomain:  p.Claims.HostedDomain,
+			},
+			TrustEmail: p.TrustEmail,
+		}, client))
+	}
 
-	return app.Listen(":8080")
+	return oidc.NewVerifiers(verifiers...)
 }
@@ -12,10 +12,294 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
+	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	Admin_authScopes     = "admin_auth.Scopes"
+	Admin_sessionScopes  = "admin_session.Scopes"
+	Parent_sessionScopes = "parent_session.Scopes"
+)
+
+// Defines values for APIKeyScope.
+const (
+	Read      APIKeyScope = "read"
+	Sensitive APIKeyScope = "sensitive"
+	Write     APIKeyScope = "write"
+)
+
+// Defines values for Activity.
+const (
+	Meeting     Activity = "meeting"
+	Outing      Activity = "outing"
+	Residential Activity = "residential"
+)
+
+// Defines values for AuditEntryCredential.
+const (
+	CredentialAPIKey  AuditEntryCredential = "api_key"
+	CredentialSession AuditEntryCredential = "session"
+	CredentialToken   AuditEntryCredential = "token"
+)
+
+// Defines values for EventStatus.
+const (
+	EventStatusApproved          EventStatus = "approved"
+	EventStatusAwaitingDocuments EventStatus = "awaiting documents"
+	EventStatusCancelled         EventStatus = "cancelled"
+	EventStatusProvisional       EventStatus = "provisional"
+)
+
+// Defines values for JoinRequestStatus.
+const (
+	JoinRequestStatusAccepted JoinRequestStatus = "accepted"
+	JoinRequestStatusDeclined JoinRequestStatus = "declined"
+	JoinRequestStatusExpired  JoinRequestStatus = "expired"
+	JoinRequestStatusOffered  JoinRequestStatus = "offered"
+	JoinRequestStatusWaiting  JoinRequestStatus = "waiting"
+)
+
+// Defines values for PlaceOfferStatus.
+const (
+	PlaceOfferStatusAccepted PlaceOfferStatus = "accepted"
+	PlaceOfferStatusDeclined PlaceOfferStatus = "declined"
+	PlaceOfferStatusExpired  PlaceOfferStatus = "expired"
+	PlaceOfferStatusOffered  PlaceOfferStatus = "offered"
+)
+
+// Defines values for RatioEnforcement.
+const (
+	Block RatioEnforcement = "block"
+	Flag  RatioEnforcement = "flag"
+)
+
+// Defines values for RoleName.
+const (
+	DistrictCommissioner RoleName = "district_commissioner"
+	Helper               RoleName = "helper"
+	Treasurer            RoleName = "treasurer"
+	UnitLeader           RoleName = "unit_leader"
+)
+
+// Defines values for Section.
+const (
+	Brownies Section = "brownies"
+	Guides   Section = "guides"
+	Rainbows Section = "rainbows"
+	Rangers  Section = "rangers"
+)
+
+// Defines values for SignupStatus.
+const (
+	SignupStatusCancelled  SignupStatus = "cancelled"
+	SignupStatusConfirmed  SignupStatus = "confirmed"
+	SignupStatusWaitlisted SignupStatus = "waitlisted"
+)
+
+// Defines values for AdminGetWaitingListParamsOrder.
+const (
+	AgeOut     AdminGetWaitingListParamsOrder = "ageOut"
+	Registered AdminGetWaitingListParamsOrder = "registered"
+)
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt When the key stops working, which must be within a year.
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// LastUsedAt When the key was last used, to within a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	LastUsedIP *string    `json:"lastUsedIP,omitempty"`
+
+	// Name What the key is for.
+	Name string `json:"name"`
+
+	// Owner The admin whose roles the key uses.
+	Owner  openapi_types.Email `json:"owner"`
+	Scopes []APIKeyScope       `json:"scopes"`
+}
+
+// APIKeyInput defines model for APIKeyInput.
+type APIKeyInput struct {
+	// ExpiresAt When the key stops working, which must be within a year.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Name What the key is for.
+	Name   string        `json:"name"`
+	Scopes []APIKeyScope `json:"scopes"`
+}
+
+// APIKeyScope - read: see records, other than members' sensitive details.
+// - write: change records.
+// - sensitive: see and change members' sensitive details.
+type APIKeyScope string
+
+// APIKeys defines model for APIKeys.
+type APIKeys struct {
+	ApiKeys []APIKey `json:"apiKeys"`
+}
+
+// Activity The kind of activity, which decides the ratio rules that apply. Defaults to meeting.
+type Activity string
+
+// AdminEvent defines model for AdminEvent.
+type AdminEvent struct {
+	// Activity The kind of activity, which decides the ratio rules that apply. Defaults to meeting.
+	Activity     Activity `json:"activity"`
+	AdultHelpers int      `json:"adultHelpers"`
+
+	// ConfirmedBySection The number of confirmed sign-ups in each section.
+	ConfirmedBySection *map[string]int `json:"confirmedBySection,omitempty"`
+
+	// ConfirmedSignups The number of confirmed sign-ups. Only present when the event takes sign-ups.
+	ConfirmedSignups *int               `json:"confirmedSignups,omitempty"`
+	CreatedAt        time.Time          `json:"createdAt"`
+	CreatedBy        string             `json:"createdBy"`
+	Description      *string            `json:"description,omitempty"`
+	End              time.Time          `json:"end"`
+	Id               openapi_types.UUID `json:"id"`
+	Location         *string            `json:"location,omitempty"`
+	Ratio            *RatioCheck        `json:"ratio,omitempty"`
+
+	// RatioEnforcement What happens when the event does not meet the adult to child ratio. Defaults to flag.
+	// - flag: the event is marked as not meeting the ratio, but sign-ups are still confirmed.
+	// - block: sign-ups that would break the ratio join the waiting list, and the adult helpers cannot be reduced below the ratio.
+	RatioEnforcement RatioEnforcement `json:"ratioEnforcement"`
+
+	// Signups Present when the event takes sign-ups.
+	Signups *EventSignupSettings `json:"signups,omitempty"`
+	Start   time.Time            `json:"start"`
+	Status  EventStatus          `json:"status"`
+	Title   string               `json:"title"`
+
+	// Unit The unit running the event. District-wide events have no unit.
+	Unit      *string   `json:"unit,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// WaitlistedSignups The number of sign-ups on the waiting list. Only present when the event takes sign-ups.
+	WaitlistedSignups *int `json:"waitlistedSignups,omitempty"`
+}
+
+// AdminListEventSignupsResponse defines model for AdminListEventSignupsResponse.
+type AdminListEventSignupsResponse struct {
+	Signups []EventSignup `json:"signups"`
+}
+
+// AdminListEventsResponse defines model for AdminListEventsResponse.
+type AdminListEventsResponse struct {
+	Events []AdminEvent `json:"events"`
+}
+
+// AdminListUnitsResponse defines model for AdminListUnitsResponse.
+type AdminListUnitsResponse struct {
+	Units []AdminUnit `json:"units"`
+}
+
+// AdminSession defines model for AdminSession.
+type AdminSession struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current Whether this is the session the request was made with.
+	Current   bool                `json:"current"`
+	Email     openapi_types.Email `json:"email"`
+	ExpiresAt time.Time           `json:"expiresAt"`
+	Id        openapi_types.UUID  `json:"id"`
+
+	// LastSeenAt When the session was last used, to within a minute.
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	Name       *string   `json:"name,omitempty"`
+}
+
+// AdminSessions defines model for AdminSessions.
+type AdminSessions struct {
+	Sessions []AdminSession `json:"sessions"`
+}
+
+// AdminUnit defines model for AdminUnit.
+type AdminUnit struct {
+	// Capacity The most members the unit can take. Absent if it has not been set.
+	Capacity *int `json:"capacity,omitempty"`
+
+	// FreePlaces The number of places left. Absent if the capacity has not been set.
+	FreePlaces *int `json:"freePlaces,omitempty"`
+
+	// Id Short name used in URLs, such as 1st-brownies.
+	Id          string               `json:"id"`
+	LeaderEmail *openapi_types.Email `json:"leaderEmail,omitempty"`
+
+	// Members The number of current members.
+	Members int     `json:"members"`
+	Name    string  `json:"name"`
+	Section Section `json:"section"`
+}
+
+// AdminUser defines model for AdminUser.
+type AdminUser struct {
+	// CsrfToken The token to send in the X-CSRF-Token header with requests that change anything, when signed in with a
+	// session.
+	CsrfToken *string             `json:"csrfToken,omitempty"`
+	Email     openapi_types.Email `json:"email"`
+
+	// ExpiresAt When the admin's sign in expires.
+	ExpiresAt *time.Time       `json:"expiresAt,omitempty"`
+	Name      *string          `json:"name,omitempty"`
+	Roles     []RoleAssignment `json:"roles"`
+}
+
+// AuditChange A field's value before and after the action. A field that was added has no before, and one that was removed has no after.
+type AuditChange struct {
+	After  interface{} `json:"after,omitempty"`
+	Before interface{} `json:"before,omitempty"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Action What the admin did, such as member.update, or the method and route for actions without a name.
+	Action string `json:"action"`
+
+	// Actor The admin who took the action.
+	Actor string `json:"actor"`
+
+	// Changes The fields the action changed, by name.
+	Changes *map[string]AuditChange `json:"changes,omitempty"`
+
+	// Credential How the admin signed in.
+	Credential *AuditEntryCredential `json:"credential,omitempty"`
+	Id         int64                 `json:"id"`
+	Ip         *string               `json:"ip,omitempty"`
+	Method     string                `json:"method"`
+	OccurredAt time.Time             `json:"occurredAt"`
+	Path       string                `json:"path"`
+
+	// Status The HTTP status the action responded with.
+	Status     int     `json:"status"`
+	TargetId   *string `json:"targetId,omitempty"`
+	TargetType *string `json:"targetType,omitempty"`
+	TraceId    *string `json:"traceId,omitempty"`
+}
+
+// AuditEntryCredential How the admin signed in.
+type AuditEntryCredential string
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	Entries []AuditEntry `json:"entries"`
+
+	// NextBefore Pass as `before` to get the next page, if there is one.
+	NextBefore *int64 `json:"nextBefore,omitempty"`
+}
+
+// CancelEventSignupRequest defines model for CancelEventSignupRequest.
+type CancelEventSignupRequest struct {
+	Token string `json:"token"`
+}
+
 // ContactUsMessage defines model for ContactUsMessage.
 type ContactUsMessage struct {
 	Email   openapi_types.Email `json:"email"`
@@ -23,266 +307,9053 @@ type ContactUsMessage struct {
 	Name    string              `json:"name"`
 }
 
+// CreatedAPIKey defines model for CreatedAPIKey.
+type CreatedAPIKey struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt When the key stops working, which must be within a year.
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Key The key itself, which can't be retrieved again.
+	Key string `json:"key"`
+
+	// LastUsedAt When the key was last used, to within a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	LastUsedIP *string    `json:"lastUsedIP,omitempty"`
+
+	// Name What the key is for.
+	Name string `json:"name"`
+
+	// Owner The admin whose roles the key uses.
+	Owner  openapi_types.Email `json:"owner"`
+	Scopes []APIKeyScope       `json:"scopes"`
+}
+
+// EmergencyContact defines model for EmergencyContact.
+type EmergencyContact struct {
+	Name         string  `json:"name"`
+	Phone        string  `json:"phone"`
+	Relationship *string `json:"relationship,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	ErrorMessage string `json:"error_message"`
+
+	// Reason Why an admin request couldn't be authenticated, so clients can tell what to do next:
+	// - missing_token: sign in.
+	// - invalid_request: the Authorization header isn't a single bearer token.
+	// - invalid_token: the token can't be trusted; sign in again.
+	// - expired_token: get a new token.
+	// - email_not_verified: the account's email must be verified with its provider.
+	// - account_not_allowed: the account can't sign in here; sign in with another.
+	// - no_roles: the account has signed in, but needs a role before it can do anything.
+	// - session_expired: the session has ended; sign in again.
+	// - invalid_csrf_token: the X-CSRF-Token header is missing or doesn't match the session.
+	// - access_revoked: the account's access has been revoked.
+	// - auth_unavailable: the sign in couldn't be checked; try again shortly.
+	Reason *string `json:"reason,omitempty"`
 }
 
-// ContactUsJSONRequestBody defines body for ContactUs for application/json ContentType.
-type ContactUsJSONRequestBody = ContactUsMessage
+// Event defines model for Event.
+type Event struct {
+	// ConfirmedSignups The number of confirmed sign-ups. Only present when the event takes sign-ups.
+	ConfirmedSignups *int               `json:"confirmedSignups,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	End              time.Time          `json:"end"`
+	Id               openapi_types.UUID `json:"id"`
+	Location         *string            `json:"location,omitempty"`
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+	// Signups Present when the event takes sign-ups.
+	Signups *EventSignupSettings `json:"signups,omitempty"`
+	Start   time.Time            `json:"start"`
+	Status  EventStatus          `json:"status"`
+	Title   string               `json:"title"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+	// Unit The unit running the event. District-wide events have no unit.
+	Unit *string `json:"unit,omitempty"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// EventInput defines model for EventInput.
+type EventInput struct {
+	// Activity The kind of activity, which decides the ratio rules that apply. Defaults to meeting.
+	Activity *Activity `json:"activity,omitempty"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// AdultHelpers The number of adult helpers planned for the event. Defaults to none.
+	AdultHelpers *int      `json:"adultHelpers,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	End          time.Time `json:"end"`
+	Location     *string   `json:"location,omitempty"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// RatioEnforcement What happens when the event does not meet the adult to child ratio. Defaults to flag.
+	// - flag: the event is marked as not meeting the ratio, but sign-ups are still confirmed.
+	// - block: sign-ups that would break the ratio join the waiting list, and the adult helpers cannot be reduced below the ratio.
+	RatioEnforcement *RatioEnforcement `json:"ratioEnforcement,omitempty"`
+
+	// Signups Present when the event takes sign-ups.
+	Signups *EventSignupSettings `json:"signups,omitempty"`
+	Start   time.Time            `json:"start"`
+	Status  EventStatus          `json:"status"`
+	Title   string               `json:"title"`
+	Unit    *string              `json:"unit,omitempty"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// EventSignup defines model for EventSignup.
+type EventSignup struct {
+	ContactEmail    openapi_types.Email `json:"contactEmail"`
+	ContactName     string              `json:"contactName"`
+	ContactPhone    *string             `json:"contactPhone,omitempty"`
+	CreatedAt       time.Time           `json:"createdAt"`
+	DateOfBirth     openapi_types.Date  `json:"dateOfBirth"`
+	EventId         openapi_types.UUID  `json:"eventId"`
+	Id              openapi_types.UUID  `json:"id"`
+	Notes           *string             `json:"notes,omitempty"`
+	ParticipantName string              `json:"participantName"`
+	Section         Section             `json:"section"`
+	Status          SignupStatus        `json:"status"`
+	Unit            *string             `json:"unit,omitempty"`
+}
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+// EventSignupInput defines model for EventSignupInput.
+type EventSignupInput struct {
+	CaptchaToken string              `json:"captchaToken"`
+	ContactEmail openapi_types.Email `json:"contactEmail"`
+	ContactName  string              `json:"contactName"`
+	ContactPhone *string             `json:"contactPhone,omitempty"`
+	DateOfBirth  openapi_types.Date  `json:"dateOfBirth"`
+
+	// Notes Anything the organisers should know, such as dietary requirements.
+	Notes           *string `json:"notes,omitempty"`
+	ParticipantName string  `json:"participantName"`
+	Section         Section `json:"section"`
+	Unit            *string `json:"unit,omitempty"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+// EventSignupResult defines model for EventSignupResult.
+type EventSignupResult struct {
+	Id     openapi_types.UUID `json:"id"`
+	Status SignupStatus       `json:"status"`
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+// EventSignupSettings Present when the event takes sign-ups.
+type EventSignupSettings struct {
+	// Capacity The maximum number of confirmed sign-ups. Further sign-ups join the waiting list.
+	Capacity *int      `json:"capacity,omitempty"`
+	Deadline time.Time `json:"deadline"`
+
+	// MaxAge The maximum age, in years, on the day the event starts.
+	MaxAge *int `json:"maxAge,omitempty"`
+
+	// MinAge The minimum age, in years, on the day the event starts.
+	MinAge *int `json:"minAge,omitempty"`
+
+	// Sections The sections that may sign up. Any section may sign up when empty.
+	Sections *[]Section `json:"sections,omitempty"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// ContactUsWithBody request with any body
-	ContactUsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// EventStatus defines model for EventStatus.
+type EventStatus string
+
+// Family defines model for Family.
+type Family struct {
+	// Members The children the parent is the contact for.
+	Members []Member `json:"members"`
+	Parent  Parent   `json:"parent"`
+
+	// Signups The event sign-ups the parent made.
+	Signups []EventSignup `json:"signups"`
+}
+
+// Invitation defines model for Invitation.
+type Invitation struct {
+	// AcceptedAt When the invited admin first signed in.
+	AcceptedAt *time.Time          `json:"acceptedAt,omitempty"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	Email      openapi_types.Email `json:"email"`
+
+	// ExpiresAt When the invitation expires, if it hasn't been accepted.
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+	InvitedBy string             `json:"invitedBy"`
+}
+
+// InvitationInput defines model for InvitationInput.
+type InvitationInput struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// Invitations defines model for Invitations.
+type Invitations struct {
+	Invitations []Invitation `json:"invitations"`
+}
+
+// JoinRequest defines model for JoinRequest.
+type JoinRequest struct {
+	ChildName   string             `json:"childName"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	DateOfBirth openapi_types.Date `json:"dateOfBirth"`
+
+	// EligibleSections The sections the child was old enough to join when she registered.
+	EligibleSections []Section           `json:"eligibleSections"`
+	Id               openapi_types.UUID  `json:"id"`
+	Notes            *string             `json:"notes,omitempty"`
+	ParentEmail      openapi_types.Email `json:"parentEmail"`
+	ParentName       string              `json:"parentName"`
+	ParentPhone      *string             `json:"parentPhone,omitempty"`
+	Postcode         string              `json:"postcode"`
+	PreferredUnits   []string            `json:"preferredUnits"`
+	Status           JoinRequestStatus   `json:"status"`
+}
+
+// JoinRequestEvent defines model for JoinRequestEvent.
+type JoinRequestEvent struct {
+	// Action What happened, such as registered, offered, accepted, declined or expired.
+	Action string `json:"action"`
+
+	// Actor The admin who made the change, the parent's email address, or system.
+	Actor     string              `json:"actor"`
+	CreatedAt time.Time           `json:"createdAt"`
+	OfferId   *openapi_types.UUID `json:"offerId,omitempty"`
+	Status    JoinRequestStatus   `json:"status"`
+}
+
+// JoinRequestHistory defines model for JoinRequestHistory.
+type JoinRequestHistory struct {
+	Events []JoinRequestEvent `json:"events"`
+}
+
+// JoinRequestInput defines model for JoinRequestInput.
+type JoinRequestInput struct {
+	CaptchaToken string              `json:"captchaToken"`
+	ChildName    string              `json:"childName"`
+	DateOfBirth  openapi_types.Date  `json:"dateOfBirth"`
+	Notes        *string             `json:"notes,omitempty"`
+	ParentEmail  openapi_types.Email `json:"parentEmail"`
+	ParentName   string              `json:"parentName"`
+	ParentPhone  *string             `json:"parentPhone,omitempty"`
+	Postcode     string              `json:"postcode"`
+
+	// PreferredUnits The units the child would like to join, most preferred first.
+	PreferredUnits []string `json:"preferredUnits"`
+}
+
+// JoinRequestResult defines model for JoinRequestResult.
+type JoinRequestResult struct {
+	// EligibleSections The sections the child is old enough to join today.
+	EligibleSections []Section          `json:"eligibleSections"`
+	Id               openapi_types.UUID `json:"id"`
+}
+
+// JoinRequestStatus defines model for JoinRequestStatus.
+type JoinRequestStatus string
+
+// ListEventsResponse defines model for ListEventsResponse.
+type ListEventsResponse struct {
+	Events []Event `json:"events"`
+}
+
+// ListMembersResponse defines model for ListMembersResponse.
+type ListMembersResponse struct {
+	Members []Member `json:"members"`
+}
+
+// ListUnitsResponse defines model for ListUnitsResponse.
+type ListUnitsResponse struct {
+	Units []Unit `json:"units"`
+}
+
+// Member defines model for Member.
+type Member struct {
+	DateOfBirth openapi_types.Date `json:"dateOfBirth"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// JoinedAt When the member joined the district.
+	JoinedAt    time.Time           `json:"joinedAt"`
+	Name        string              `json:"name"`
+	ParentEmail openapi_types.Email `json:"parentEmail"`
+	ParentName  string              `json:"parentName"`
+	ParentPhone *string             `json:"parentPhone,omitempty"`
+	Unit        string              `json:"unit"`
+}
+
+// MemberAccess defines model for MemberAccess.
+type MemberAccess struct {
+	AccessedAt time.Time `json:"accessedAt"`
+	AccessedBy string    `json:"accessedBy"`
+	Ip         *string   `json:"ip,omitempty"`
+}
+
+// MemberAccessLog defines model for MemberAccessLog.
+type MemberAccessLog struct {
+	Accesses []MemberAccess `json:"accesses"`
+}
+
+// MemberInput defines model for MemberInput.
+type MemberInput struct {
+	DateOfBirth openapi_types.Date  `json:"dateOfBirth"`
+	Name        string              `json:"name"`
+	ParentEmail openapi_types.Email `json:"parentEmail"`
+	ParentName  string              `json:"parentName"`
+	ParentPhone *string             `json:"parentPhone,omitempty"`
+}
+
+// MemberSensitive Details that are encrypted when stored, and only shown to admins for the member's unit.
+type MemberSensitive struct {
+	Allergies         *string            `json:"allergies,omitempty"`
+	EmergencyContacts []EmergencyContact `json:"emergencyContacts"`
+	MedicalNotes      *string            `json:"medicalNotes,omitempty"`
+}
+
+// MemberTransferRequest defines model for MemberTransferRequest.
+type MemberTransferRequest struct {
+	ToUnit string `json:"toUnit"`
+}
+
+// MoveUp defines model for MoveUp.
+type MoveUp struct {
+	// EligibleFrom The member's birthday on which she becomes old enough for the next section.
+	EligibleFrom openapi_types.Date `json:"eligibleFrom"`
+	Member       Member             `json:"member"`
+
+	// Overdue True if she was already old enough before the term started.
+	Overdue   bool    `json:"overdue"`
+	ToSection Section `json:"toSection"`
+}
+
+// MoveUpReport defines model for MoveUpReport.
+type MoveUpReport struct {
+	// End The last day of the term.
+	End     openapi_types.Date `json:"end"`
+	MoveUps []MoveUp           `json:"moveUps"`
+	Start   openapi_types.Date `json:"start"`
+	Term    string             `json:"term"`
+
+	// Units The units in the sections the members are moving up to, with their free places.
+	Units []AdminUnit `json:"units"`
+}
+
+// Parent defines model for Parent.
+type Parent struct {
+	// CsrfToken The token to send in the X-CSRF-Token header with requests that change anything.
+	CsrfToken string              `json:"csrfToken"`
+	Email     openapi_types.Email `json:"email"`
+
+	// ExpiresAt When the parent's session expires, if it isn't used before then.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ParentLoginInput defines model for ParentLoginInput.
+type ParentLoginInput struct {
+	CaptchaToken string              `json:"captchaToken"`
+	Email        openapi_types.Email `json:"email"`
+}
+
+// ParentSessionInput defines model for ParentSessionInput.
+type ParentSessionInput struct {
+	// Token The token from the sign in link.
+	Token string `json:"token"`
+}
+
+// PlaceOffer defines model for PlaceOffer.
+type PlaceOffer struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy The admin who made the offer, or system if it was offered automatically.
+	CreatedBy     string             `json:"createdBy"`
+	ExpiresAt     time.Time          `json:"expiresAt"`
+	Id            openapi_types.UUID `json:"id"`
+	JoinRequestId openapi_types.UUID `json:"joinRequestId"`
+	RespondedAt   *time.Time         `json:"respondedAt,omitempty"`
+	Status        PlaceOfferStatus   `json:"status"`
+	Unit          string             `json:"unit"`
+}
+
+// PlaceOfferResponse defines model for PlaceOfferResponse.
+type PlaceOfferResponse struct {
+	Accept bool   `json:"accept"`
+	Token  string `json:"token"`
+}
+
+// PlaceOfferStatus defines model for PlaceOfferStatus.
+type PlaceOfferStatus string
+
+// RatioCheck defines model for RatioCheck.
+type RatioCheck struct {
+	AdultHelpers   int  `json:"adultHelpers"`
+	Met            bool `json:"met"`
+	RequiredAdults int  `json:"requiredAdults"`
+}
+
+// RatioCheckRequest defines model for RatioCheckRequest.
+type RatioCheckRequest struct {
+	// Activity The kind of activity, which decides the ratio rules that apply. Defaults to meeting.
+	Activity     Activity `json:"activity"`
+	AdultHelpers *int     `json:"adultHelpers,omitempty"`
+
+	// Girls The number of girls attending from each section.
+	Girls map[string]int `json:"girls"`
+}
+
+// RatioEnforcement What happens when the event does not meet the adult to child ratio. Defaults to flag.
+// - flag: the event is marked as not meeting the ratio, but sign-ups are still confirmed.
+// - block: sign-ups that would break the ratio join the waiting list, and the adult helpers cannot be reduced below the ratio.
+type RatioEnforcement string
+
+// RatioRule defines model for RatioRule.
+type RatioRule struct {
+	// Activity The kind of activity, which decides the ratio rules that apply. Defaults to meeting.
+	Activity      Activity `json:"activity"`
+	GirlsPerAdult int      `json:"girlsPerAdult"`
+
+	// MinAdults The minimum number of adults whenever any girls from the section attend.
+	MinAdults int     `json:"minAdults"`
+	Section   Section `json:"section"`
+}
+
+// RatioRules defines model for RatioRules.
+type RatioRules struct {
+	Rules []RatioRule `json:"rules"`
+}
+
+// Revocation defines model for Revocation.
+type Revocation struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	Email     openapi_types.Email `json:"email"`
+	Id        openapi_types.UUID  `json:"id"`
+	Reason    *string             `json:"reason,omitempty"`
+	RevokedBy string              `json:"revokedBy"`
+}
+
+// RevocationInput defines model for RevocationInput.
+type RevocationInput struct {
+	Email  openapi_types.Email `json:"email"`
+	Reason *string             `json:"reason,omitempty"`
+}
+
+// Revocations defines model for Revocations.
+type Revocations struct {
+	Revocations []Revocation `json:"revocations"`
+}
+
+// RoleAssignment defines model for RoleAssignment.
+type RoleAssignment struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	CreatedBy string              `json:"createdBy"`
+	Email     openapi_types.Email `json:"email"`
+	Id        openapi_types.UUID  `json:"id"`
+
+	// Role - district_commissioner: can do everything, across the district.
+	// - unit_leader: can manage their unit, including its members' sensitive details.
+	// - treasurer: can see records, but not sensitive details, across the district or for their unit.
+	// - helper: can see their unit's records, but not sensitive details, and can't change anything.
+	Role RoleName `json:"role"`
+
+	// Unit The unit the role is for. Absent for roles across the whole district.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// RoleAssignmentInput defines model for RoleAssignmentInput.
+type RoleAssignmentInput struct {
+	Email openapi_types.Email `json:"email"`
+
+	// Role - district_commissioner: can do everything, across the district.
+	// - unit_leader: can manage their unit, including its members' sensitive details.
+	// - treasurer: can see records, but not sensitive details, across the district or for their unit.
+	// - helper: can see their unit's records, but not sensitive details, and can't change anything.
+	Role RoleName `json:"role"`
+
+	// Unit The unit the role is for. Absent for roles across the whole district.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// RoleAssignments defines model for RoleAssignments.
+type RoleAssignments struct {
+	Roles []RoleAssignment `json:"roles"`
+}
+
+// RoleName - district_commissioner: can do everything, across the district.
+// - unit_leader: can manage their unit, including its members' sensitive details.
+// - treasurer: can see records, but not sensitive details, across the district or for their unit.
+// - helper: can see their unit's records, but not sensitive details, and can't change anything.
+type RoleName string
+
+// Section defines model for Section.
+type Section string
+
+// SignupStatus defines model for SignupStatus.
+type SignupStatus string
+
+// Unit defines model for Unit.
+type Unit struct {
+	// Id Short name used in URLs, such as 1st-brownies.
+	Id      string  `json:"id"`
+	Name    string  `json:"name"`
+	Section Section `json:"section"`
+}
+
+// UnitSettings defines model for UnitSettings.
+type UnitSettings struct {
+	Capacity    *int                 `json:"capacity,omitempty"`
+	LeaderEmail *openapi_types.Email `json:"leaderEmail,omitempty"`
+}
+
+// WaitingList defines model for WaitingList.
+type WaitingList struct {
+	Entries []WaitingListEntry `json:"entries"`
+	Unit    Unit               `json:"unit"`
+}
+
+// WaitingListEntry defines model for WaitingListEntry.
+type WaitingListEntry struct {
+	// AgeOutDate The date the child becomes too old for the unit's section.
+	AgeOutDate  openapi_types.Date `json:"ageOutDate"`
+	ChildName   string             `json:"childName"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	DateOfBirth openapi_types.Date `json:"dateOfBirth"`
+
+	// EligibleSections The sections the child was old enough to join when she registered.
+	EligibleSections []Section           `json:"eligibleSections"`
+	Id               openapi_types.UUID  `json:"id"`
+	Notes            *string             `json:"notes,omitempty"`
+	ParentEmail      openapi_types.Email `json:"parentEmail"`
+	ParentName       string              `json:"parentName"`
+	ParentPhone      *string             `json:"parentPhone,omitempty"`
+	Postcode         string              `json:"postcode"`
+	PreferredUnits   []string            `json:"preferredUnits"`
+	Status           JoinRequestStatus   `json:"status"`
+}
+
+// APIKeyID defines model for APIKeyID.
+type APIKeyID = openapi_types.UUID
+
+// AuditActionQuery defines model for AuditActionQuery.
+type AuditActionQuery = string
+
+// AuditActorQuery defines model for AuditActorQuery.
+type AuditActorQuery = string
+
+// AuditSinceQuery defines model for AuditSinceQuery.
+type AuditSinceQuery = time.Time
+
+// AuditTargetIDQuery defines model for AuditTargetIDQuery.
+type AuditTargetIDQuery = string
+
+// AuditTargetTypeQuery defines model for AuditTargetTypeQuery.
+type AuditTargetTypeQuery = string
+
+// AuditUntilQuery defines model for AuditUntilQuery.
+type AuditUntilQuery = time.Time
+
+// EventID defines model for EventID.
+type EventID = openapi_types.UUID
+
+// FromQuery defines model for FromQuery.
+type FromQuery = time.Time
+
+// InvitationID defines model for InvitationID.
+type InvitationID = openapi_types.UUID
+
+// JoinRequestID defines model for JoinRequestID.
+type JoinRequestID = openapi_types.UUID
+
+// MemberID defines model for MemberID.
+type MemberID = openapi_types.UUID
+
+// OfferID defines model for OfferID.
+type OfferID = openapi_types.UUID
+
+// RevocationID defines model for RevocationID.
+type RevocationID = openapi_types.UUID
+
+// RoleID defines model for RoleID.
+type RoleID = openapi_types.UUID
+
+// SessionID defines model for SessionID.
+type SessionID = openapi_types.UUID
+
+// SignupID defines model for SignupID.
+type SignupID = openapi_types.UUID
+
+// ToQuery defines model for ToQuery.
+type ToQuery = time.Time
+
+// UnitID defines model for UnitID.
+type UnitID = string
+
+// UnitQuery defines model for UnitQuery.
+type UnitQuery = string
+
+// AdminListAuditLogParams defines parameters for AdminListAuditLog.
+type AdminListAuditLogParams struct {
+	// Actor Only include actions by this admin.
+	Actor *AuditActorQuery `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action Only include this kind of action, such as member.update.
+	Action *AuditActionQuery `form:"action,omitempty" json:"action,omitempty"`
+
+	// TargetType Only include actions on this kind of record, such as member.
+	TargetType *AuditTargetTypeQuery `form:"targetType,omitempty" json:"targetType,omitempty"`
+
+	// TargetId Only include actions on this record.
+	TargetId *AuditTargetIDQuery `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// Since Only include actions at or after this time.
+	Since *AuditSinceQuery `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only include actions before this time.
+	Until *AuditUntilQuery `form:"until,omitempty" json:"until,omitempty"`
+
+	// Before Only include entries before this one, to get the next page.
+	Before *int64 `form:"before,omitempty" json:"before,omitempty"`
+	Limit  *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// AdminExportAuditLogParams defines parameters for AdminExportAuditLog.
+type AdminExportAuditLogParams struct {
+	// Actor Only include actions by this admin.
+	Actor *AuditActorQuery `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action Only include this kind of action, such as member.update.
+	Action *AuditActionQuery `form:"action,omitempty" json:"action,omitempty"`
+
+	// TargetType Only include actions on this kind of record, such as member.
+	TargetType *AuditTargetTypeQuery `form:"targetType,omitempty" json:"targetType,omitempty"`
+
+	// TargetId Only include actions on this record.
+	TargetId *AuditTargetIDQuery `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// Since Only include actions at or after this time.
+	Since *AuditSinceQuery `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only include actions before this time.
+	Until *AuditUntilQuery `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// AdminListEventsParams defines parameters for AdminListEvents.
+type AdminListEventsParams struct {
+	// From Only include events ending at or after this time. Defaults to now.
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include events starting before this time. Defaults to a year after `from`.
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// Unit Only include district-wide events and events for this unit.
+	Unit *UnitQuery `form:"unit,omitempty" json:"unit,omitempty"`
+}
+
+// AdminGetMoveUpReportParams defines parameters for AdminGetMoveUpReport.
+type AdminGetMoveUpReportParams struct {
+	// Term The term, such as 2026-autumn. Defaults to the current term. Spring runs from January to March, summer
+	// from April to August and autumn from September to December.
+	Term *string `form:"term,omitempty" json:"term,omitempty"`
+}
+
+// AdminListSessionsParams defines parameters for AdminListSessions.
+type AdminListSessionsParams struct {
+	// Email Only list this admin's sessions.
+	Email *openapi_types.Email `form:"email,omitempty" json:"email,omitempty"`
+}
+
+// AdminGetWaitingListParams defines parameters for AdminGetWaitingList.
+type AdminGetWaitingListParams struct {
+	// Order Order by registration date, oldest first, or by age-out date, soonest first. Defaults to registered.
+	Order *AdminGetWaitingListParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// AdminGetWaitingListParamsOrder defines parameters for AdminGetWaitingList.
+type AdminGetWaitingListParamsOrder string
+
+// GetCalendarParams defines parameters for GetCalendar.
+type GetCalendarParams struct {
+	// Unit Only include district-wide events and events for this unit.
+	Unit *UnitQuery `form:"unit,omitempty" json:"unit,omitempty"`
+}
+
+// ListEventsParams defines parameters for ListEvents.
+type ListEventsParams struct {
+	// From Only include events ending at or after this time. Defaults to now.
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include events starting before this time. Defaults to a year after `from`.
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// Unit Only include district-wide events and events for this unit.
+	Unit *UnitQuery `form:"unit,omitempty" json:"unit,omitempty"`
+}
+
+// AdminCreateAPIKeyJSONRequestBody defines body for AdminCreateAPIKey for application/json ContentType.
+type AdminCreateAPIKeyJSONRequestBody = APIKeyInput
+
+// AdminCreateEventJSONRequestBody defines body for AdminCreateEvent for application/json ContentType.
+type AdminCreateEventJSONRequestBody = EventInput
+
+// AdminUpdateEventJSONRequestBody defines body for AdminUpdateEvent for application/json ContentType.
+type AdminUpdateEventJSONRequestBody = EventInput
+
+// AdminCreateInvitationJSONRequestBody defines body for AdminCreateInvitation for application/json ContentType.
+type AdminCreateInvitationJSONRequestBody = InvitationInput
+
+// AdminUpdateMemberJSONRequestBody defines body for AdminUpdateMember for application/json ContentType.
+type AdminUpdateMemberJSONRequestBody = MemberInput
+
+// AdminSetMemberSensitiveJSONRequestBody defines body for AdminSetMemberSensitive for application/json ContentType.
+type AdminSetMemberSensitiveJSONRequestBody = MemberSensitive
+
+// AdminTransferMemberJSONRequestBody defines body for AdminTransferMember for application/json ContentType.
+type AdminTransferMemberJSONRequestBody = MemberTransferRequest
+
+// AdminCheckRatioJSONRequestBody defines body for AdminCheckRatio for application/json ContentType.
+type AdminCheckRatioJSONRequestBody = RatioCheckRequest
+
+// AdminSaveRatioRulesJSONRequestBody defines body for AdminSaveRatioRules for application/json ContentType.
+type AdminSaveRatioRulesJSONRequestBody = RatioRules
+
+// AdminRevokeUserJSONRequestBody defines body for AdminRevokeUser for application/json ContentType.
+type AdminRevokeUserJSONRequestBody = RevocationInput
+
+// AdminAssignRoleJSONRequestBody defines body for AdminAssignRole for application/json ContentType.
+type AdminAssignRoleJSONRequestBody = RoleAssignmentInput
+
+// AdminUpdateUnitJSONRequestBody defines body for AdminUpdateUnit for application/json ContentType.
+type AdminUpdateUnitJSONRequestBody = UnitSettings
+
+// AdminCreateMemberJSONRequestBody defines body for AdminCreateMember for application/json ContentType.
+type AdminCreateMemberJSONRequestBody = MemberInput
+
+// ContactUsJSONRequestBody defines body for ContactUs for application/json ContentType.
+type ContactUsJSONRequestBody = ContactUsMessage
+
+// SignUpForEventJSONRequestBody defines body for SignUpForEvent for application/json ContentType.
+type SignUpForEventJSONRequestBody = EventSignupInput
+
+// CancelEventSignupJSONRequestBody defines body for CancelEventSignup for application/json ContentType.
+type CancelEventSignupJSONRequestBody = CancelEventSignupRequest
+
+// CreateJoinRequestJSONRequestBody defines body for CreateJoinRequest for application/json ContentType.
+type CreateJoinRequestJSONRequestBody = JoinRequestInput
+
+// CreateParentLoginJSONRequestBody defines body for CreateParentLogin for application/json ContentType.
+type CreateParentLoginJSONRequestBody = ParentLoginInput
+
+// CreateParentSessionJSONRequestBody defines body for CreateParentSession for application/json ContentType.
+type CreateParentSessionJSONRequestBody = ParentSessionInput
+
+// RespondToPlaceOfferJSONRequestBody defines body for RespondToPlaceOffer for application/json ContentType.
+type RespondToPlaceOfferJSONRequestBody = PlaceOfferResponse
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AdminListAPIKeys request
+	AdminListAPIKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateAPIKeyWithBody request with any body
+	AdminCreateAPIKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCreateAPIKey(ctx context.Context, body AdminCreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDeleteAPIKey request
+	AdminDeleteAPIKey(ctx context.Context, apiKeyID APIKeyID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListAuditLog request
+	AdminListAuditLog(ctx context.Context, params *AdminListAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminExportAuditLog request
+	AdminExportAuditLog(ctx context.Context, params *AdminExportAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListEvents request
+	AdminListEvents(ctx context.Context, params *AdminListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateEventWithBody request with any body
+	AdminCreateEventWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCreateEvent(ctx context.Context, body AdminCreateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDeleteEvent request
+	AdminDeleteEvent(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetEvent request
+	AdminGetEvent(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminUpdateEventWithBody request with any body
+	AdminUpdateEventWithBody(ctx context.Context, eventID EventID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminUpdateEvent(ctx context.Context, eventID EventID, body AdminUpdateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminExportEventAttendees request
+	AdminExportEventAttendees(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListEventSignups request
+	AdminListEventSignups(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCancelEventSignup request
+	AdminCancelEventSignup(ctx context.Context, eventID EventID, signupID SignupID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListInvitations request
+	AdminListInvitations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateInvitationWithBody request with any body
+	AdminCreateInvitationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCreateInvitation(ctx context.Context, body AdminCreateInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminRemoveInvitation request
+	AdminRemoveInvitation(ctx context.Context, invitationID InvitationID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetJoinRequestHistory request
+	AdminGetJoinRequestHistory(ctx context.Context, joinRequestID JoinRequestID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetMe request
+	AdminGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetMember request
+	AdminGetMember(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminUpdateMemberWithBody request with any body
+	AdminUpdateMemberWithBody(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminUpdateMember(ctx context.Context, memberID MemberID, body AdminUpdateMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetMemberAccessLog request
+	AdminGetMemberAccessLog(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetMemberSensitive request
+	AdminGetMemberSensitive(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminSetMemberSensitiveWithBody request with any body
+	AdminSetMemberSensitiveWithBody(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminSetMemberSensitive(ctx context.Context, memberID MemberID, body AdminSetMemberSensitiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminTransferMemberWithBody request with any body
+	AdminTransferMemberWithBody(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminTransferMember(ctx context.Context, memberID MemberID, body AdminTransferMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetMoveUpReport request
+	AdminGetMoveUpReport(ctx context.Context, params *AdminGetMoveUpReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCheckRatioWithBody request with any body
+	AdminCheckRatioWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCheckRatio(ctx context.Context, body AdminCheckRatioJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListRatioRules request
+	AdminListRatioRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminSaveRatioRulesWithBody request with any body
+	AdminSaveRatioRulesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminSaveRatioRules(ctx context.Context, body AdminSaveRatioRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListRevocations request
+	AdminListRevocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminRevokeUserWithBody request with any body
+	AdminRevokeUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminRevokeUser(ctx context.Context, body AdminRevokeUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminRemoveRevocation request
+	AdminRemoveRevocation(ctx context.Context, revocationID RevocationID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListRoles request
+	AdminListRoles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminAssignRoleWithBody request with any body
+	AdminAssignRoleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminAssignRole(ctx context.Context, body AdminAssignRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminRemoveRole request
+	AdminRemoveRole(ctx context.Context, roleID RoleID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDeleteSession request
+	AdminDeleteSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateSession request
+	AdminCreateSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListSessions request
+	AdminListSessions(ctx context.Context, params *AdminListSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminRevokeSession request
+	AdminRevokeSession(ctx context.Context, sessionID SessionID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListUnits request
+	AdminListUnits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminUpdateUnitWithBody request with any body
+	AdminUpdateUnitWithBody(ctx context.Context, unitID UnitID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminUpdateUnit(ctx context.Context, unitID UnitID, body AdminUpdateUnitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListUnitMembers request
+	AdminListUnitMembers(ctx context.Context, unitID UnitID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateMemberWithBody request with any body
+	AdminCreateMemberWithBody(ctx context.Context, unitID UnitID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCreateMember(ctx context.Context, unitID UnitID, body AdminCreateMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetWaitingList request
+	AdminGetWaitingList(ctx context.Context, unitID UnitID, params *AdminGetWaitingListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminOfferPlace request
+	AdminOfferPlace(ctx context.Context, unitID UnitID, joinRequestID JoinRequestID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendar request
+	GetCalendar(ctx context.Context, params *GetCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ContactUsWithBody request with any body
+	ContactUsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ContactUs(ctx context.Context, body ContactUsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListEvents request
+	ListEvents(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SignUpForEventWithBody request with any body
+	SignUpForEventWithBody(ctx context.Context, eventID EventID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SignUpForEvent(ctx context.Context, eventID EventID, body SignUpForEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelEventSignupWithBody request with any body
+	CancelEventSignupWithBody(ctx context.Context, eventID EventID, signupID SignupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CancelEventSignup(ctx context.Context, eventID EventID, signupID SignupID, body CancelEventSignupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFamily request
+	GetFamily(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteParentSession request
+	DeleteParentSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateJoinRequestWithBody request with any body
+	CreateJoinRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateJoinRequest(ctx context.Context, body CreateJoinRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateParentLoginWithBody request with any body
+	CreateParentLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateParentLogin(ctx context.Context, body CreateParentLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateParentSessionWithBody request with any body
+	CreateParentSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateParentSession(ctx context.Context, body CreateParentSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RespondToPlaceOfferWithBody request with any body
+	RespondToPlaceOfferWithBody(ctx context.Context, offerID OfferID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RespondToPlaceOffer(ctx context.Context, offerID OfferID, body RespondToPlaceOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUnits request
+	ListUnits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AdminListAPIKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListAPIKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateAPIKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateAPIKeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateAPIKey(ctx context.Context, body AdminCreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateAPIKeyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminDeleteAPIKey(ctx context.Context, apiKeyID APIKeyID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDeleteAPIKeyRequest(c.Server, apiKeyID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListAuditLog(ctx context.Context, params *AdminListAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListAuditLogRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminExportAuditLog(ctx context.Context, params *AdminExportAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminExportAuditLogRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListEvents(ctx context.Context, params *AdminListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateEventWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateEventRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateEvent(ctx context.Context, body AdminCreateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateEventRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminDeleteEvent(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDeleteEventRequest(c.Server, eventID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetEvent(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetEventRequest(c.Server, eventID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminUpdateEventWithBody(ctx context.Context, eventID EventID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateEventRequestWithBody(c.Server, eventID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminUpdateEvent(ctx context.Context, eventID EventID, body AdminUpdateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateEventRequest(c.Server, eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminExportEventAttendees(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminExportEventAttendeesRequest(c.Server, eventID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListEventSignups(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListEventSignupsRequest(c.Server, eventID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCancelEventSignup(ctx context.Context, eventID EventID, signupID SignupID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCancelEventSignupRequest(c.Server, eventID, signupID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListInvitations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListInvitationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateInvitationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateInvitationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateInvitation(ctx context.Context, body AdminCreateInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateInvitationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminRemoveInvitation(ctx context.Context, invitationID InvitationID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRemoveInvitationRequest(c.Server, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetJoinRequestHistory(ctx context.Context, joinRequestID JoinRequestID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetJoinRequestHistoryRequest(c.Server, joinRequestID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetMember(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetMemberRequest(c.Server, memberID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminUpdateMemberWithBody(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateMemberRequestWithBody(c.Server, memberID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminUpdateMember(ctx context.Context, memberID MemberID, body AdminUpdateMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateMemberRequest(c.Server, memberID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetMemberAccessLog(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetMemberAccessLogRequest(c.Server, memberID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetMemberSensitive(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetMemberSensitiveRequest(c.Server, memberID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminSetMemberSensitiveWithBody(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminSetMemberSensitiveRequestWithBody(c.Server, memberID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminSetMemberSensitive(ctx context.Context, memberID MemberID, body AdminSetMemberSensitiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminSetMemberSensitiveRequest(c.Server, memberID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminTransferMemberWithBody(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminTransferMemberRequestWithBody(c.Server, memberID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminTransferMember(ctx context.Context, memberID MemberID, body AdminTransferMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminTransferMemberRequest(c.Server, memberID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetMoveUpReport(ctx context.Context, params *AdminGetMoveUpReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetMoveUpReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCheckRatioWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCheckRatioRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCheckRatio(ctx context.Context, body AdminCheckRatioJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCheckRatioRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListRatioRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListRatioRulesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminSaveRatioRulesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminSaveRatioRulesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminSaveRatioRules(ctx context.Context, body AdminSaveRatioRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminSaveRatioRulesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListRevocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListRevocationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminRevokeUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRevokeUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminRevokeUser(ctx context.Context, body AdminRevokeUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRevokeUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminRemoveRevocation(ctx context.Context, revocationID RevocationID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRemoveRevocationRequest(c.Server, revocationID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListRoles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListRolesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminAssignRoleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminAssignRoleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminAssignRole(ctx context.Context, body AdminAssignRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminAssignRoleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminRemoveRole(ctx context.Context, roleID RoleID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRemoveRoleRequest(c.Server, roleID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminDeleteSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDeleteSessionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateSessionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListSessions(ctx context.Context, params *AdminListSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListSessionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminRevokeSession(ctx context.Context, sessionID SessionID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRevokeSessionRequest(c.Server, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListUnits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListUnitsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminUpdateUnitWithBody(ctx context.Context, unitID UnitID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateUnitRequestWithBody(c.Server, unitID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminUpdateUnit(ctx context.Context, unitID UnitID, body AdminUpdateUnitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateUnitRequest(c.Server, unitID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListUnitMembers(ctx context.Context, unitID UnitID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListUnitMembersRequest(c.Server, unitID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateMemberWithBody(ctx context.Context, unitID UnitID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateMemberRequestWithBody(c.Server, unitID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateMember(ctx context.Context, unitID UnitID, body AdminCreateMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateMemberRequest(c.Server, unitID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetWaitingList(ctx context.Context, unitID UnitID, params *AdminGetWaitingListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetWaitingListRequest(c.Server, unitID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminOfferPlace(ctx context.Context, unitID UnitID, joinRequestID JoinRequestID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminOfferPlaceRequest(c.Server, unitID, joinRequestID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCalendar(ctx context.Context, params *GetCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ContactUsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewContactUsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ContactUs(ctx context.Context, body ContactUsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewContactUsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListEvents(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SignUpForEventWithBody(ctx context.Context, eventID EventID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignUpForEventRequestWithBody(c.Server, eventID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SignUpForEvent(ctx context.Context, eventID EventID, body SignUpForEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignUpForEventRequest(c.Server, eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelEventSignupWithBody(ctx context.Context, eventID EventID, signupID SignupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelEventSignupRequestWithBody(c.Server, eventID, signupID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelEventSignup(ctx context.Context, eventID EventID, signupID SignupID, body CancelEventSignupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelEventSignupRequest(c.Server, eventID, signupID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFamily(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFamilyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteParentSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteParentSessionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateJoinRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateJoinRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateJoinRequest(ctx context.Context, body CreateJoinRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateJoinRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateParentLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateParentLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateParentLogin(ctx context.Context, body CreateParentLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateParentLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateParentSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateParentSessionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateParentSession(ctx context.Context, body CreateParentSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateParentSessionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RespondToPlaceOfferWithBody(ctx context.Context, offerID OfferID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRespondToPlaceOfferRequestWithBody(c.Server, offerID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RespondToPlaceOffer(ctx context.Context, offerID OfferID, body RespondToPlaceOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRespondToPlaceOfferRequest(c.Server, offerID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListUnits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUnitsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAdminListAPIKeysRequest generates requests for AdminListAPIKeys
+func NewAdminListAPIKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminCreateAPIKeyRequest calls the generic AdminCreateAPIKey builder with application/json body
+func NewAdminCreateAPIKeyRequest(server string, body AdminCreateAPIKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminCreateAPIKeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminCreateAPIKeyRequestWithBody generates requests for AdminCreateAPIKey with any type of body
+func NewAdminCreateAPIKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminDeleteAPIKeyRequest generates requests for AdminDeleteAPIKey
+func NewAdminDeleteAPIKeyRequest(server string, apiKeyID APIKeyID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiKeyID", runtime.ParamLocationPath, apiKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/api-keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListAuditLogRequest generates requests for AdminListAuditLog
+func NewAdminListAuditLogRequest(server string, params *AdminListAuditLogParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/audit-log")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "targetType", runtime.ParamLocationQuery, *params.TargetType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "targetId", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminExportAuditLogRequest generates requests for AdminExportAuditLog
+func NewAdminExportAuditLogRequest(server string, params *AdminExportAuditLogParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/audit-log.csv")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "targetType", runtime.ParamLocationQuery, *params.TargetType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "targetId", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListEventsRequest generates requests for AdminListEvents
+func NewAdminListEventsRequest(server string, params *AdminListEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Unit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminCreateEventRequest calls the generic AdminCreateEvent builder with application/json body
+func NewAdminCreateEventRequest(server string, body AdminCreateEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminCreateEventRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminCreateEventRequestWithBody generates requests for AdminCreateEvent with any type of body
+func NewAdminCreateEventRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminDeleteEventRequest generates requests for AdminDeleteEvent
+func NewAdminDeleteEventRequest(server string, eventID EventID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventID", runtime.ParamLocationPath, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/events/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminGetEventRequest generates requests for AdminGetEvent
+func NewAdminGetEventRequest(server string, eventID EventID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventID", runtime.ParamLocationPath, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/events/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminUpdateEventRequest calls the generic AdminUpdateEvent builder with application/json body
+func NewAdminUpdateEventRequest(server string, eventID EventID, body AdminUpdateEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminUpdateEventRequestWithBody(server, eventID, "application/json", bodyReader)
+}
+
+// NewAdminUpdateEventRequestWithBody generates requests for AdminUpdateEvent with any type of body
+func NewAdminUpdateEventRequestWithBody(server string, eventID EventID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventID", runtime.ParamLocationPath, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/events/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminExportEventAttendeesRequest generates requests for AdminExportEventAttendees
+func NewAdminExportEventAttendeesRequest(server string, eventID EventID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventID", runtime.ParamLocationPath, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/events/%s/attendees.csv", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListEventSignupsRequest generates requests for AdminListEventSignups
+func NewAdminListEventSignupsRequest(server string, eventID EventID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventID", runtime.ParamLocationPath, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/events/%s/signups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminCancelEventSignupRequest generates requests for AdminCancelEventSignup
+func NewAdminCancelEventSignupRequest(server string, eventID EventID, signupID SignupID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventID", runtime.ParamLocationPath, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "signupID", runtime.ParamLocationPath, signupID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/events/%s/signups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListInvitationsRequest generates requests for AdminListInvitations
+func NewAdminListInvitationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/invitations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminCreateInvitationRequest calls the generic AdminCreateInvitation builder with application/json body
+func NewAdminCreateInvitationRequest(server string, body AdminCreateInvitationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminCreateInvitationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminCreateInvitationRequestWithBody generates requests for AdminCreateInvitation with any type of body
+func NewAdminCreateInvitationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/invitations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminRemoveInvitationRequest generates requests for AdminRemoveInvitation
+func NewAdminRemoveInvitationRequest(server string, invitationID InvitationID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "invitationID", runtime.ParamLocationPath, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/invitations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminGetJoinRequestHistoryRequest generates requests for AdminGetJoinRequestHistory
+func NewAdminGetJoinRequestHistoryRequest(server string, joinRequestID JoinRequestID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "joinRequestID", runtime.ParamLocationPath, joinRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/join-requests/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminGetMeRequest generates requests for AdminGetMe
+func NewAdminGetMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminGetMemberRequest generates requests for AdminGetMember
+func NewAdminGetMemberRequest(server string, memberID MemberID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "memberID", runtime.ParamLocationPath, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/members/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminUpdateMemberRequest calls the generic AdminUpdateMember builder with application/json body
+func NewAdminUpdateMemberRequest(server string, memberID MemberID, body AdminUpdateMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminUpdateMemberRequestWithBody(server, memberID, "application/json", bodyReader)
+}
+
+// NewAdminUpdateMemberRequestWithBody generates requests for AdminUpdateMember with any type of body
+func NewAdminUpdateMemberRequestWithBody(server string, memberID MemberID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "memberID", runtime.ParamLocationPath, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/members/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminGetMemberAccessLogRequest generates requests for AdminGetMemberAccessLog
+func NewAdminGetMemberAccessLogRequest(server string, memberID MemberID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "memberID", runtime.ParamLocationPath, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/members/%s/access-log", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminGetMemberSensitiveRequest generates requests for AdminGetMemberSensitive
+func NewAdminGetMemberSensitiveRequest(server string, memberID MemberID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "memberID", runtime.ParamLocationPath, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/members/%s/sensitive", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminSetMemberSensitiveRequest calls the generic AdminSetMemberSensitive builder with application/json body
+func NewAdminSetMemberSensitiveRequest(server string, memberID MemberID, body AdminSetMemberSensitiveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminSetMemberSensitiveRequestWithBody(server, memberID, "application/json", bodyReader)
+}
+
+// NewAdminSetMemberSensitiveRequestWithBody generates requests for AdminSetMemberSensitive with any type of body
+func NewAdminSetMemberSensitiveRequestWithBody(server string, memberID MemberID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "memberID", runtime.ParamLocationPath, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/members/%s/sensitive", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminTransferMemberRequest calls the generic AdminTransferMember builder with application/json body
+func NewAdminTransferMemberRequest(server string, memberID MemberID, body AdminTransferMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminTransferMemberRequestWithBody(server, memberID, "application/json", bodyReader)
+}
+
+// NewAdminTransferMemberRequestWithBody generates requests for AdminTransferMember with any type of body
+func NewAdminTransferMemberRequestWithBody(server string, memberID MemberID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "memberID", runtime.ParamLocationPath, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/members/%s/transfer", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminGetMoveUpReportRequest generates requests for AdminGetMoveUpReport
+func NewAdminGetMoveUpReportRequest(server string, params *AdminGetMoveUpReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/move-ups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Term != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "term", runtime.ParamLocationQuery, *params.Term); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminCheckRatioRequest calls the generic AdminCheckRatio builder with application/json body
+func NewAdminCheckRatioRequest(server string, body AdminCheckRatioJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminCheckRatioRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminCheckRatioRequestWithBody generates requests for AdminCheckRatio with any type of body
+func NewAdminCheckRatioRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/ratio-check")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminListRatioRulesRequest generates requests for AdminListRatioRules
+func NewAdminListRatioRulesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/ratio-rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminSaveRatioRulesRequest calls the generic AdminSaveRatioRules builder with application/json body
+func NewAdminSaveRatioRulesRequest(server string, body AdminSaveRatioRulesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminSaveRatioRulesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminSaveRatioRulesRequestWithBody generates requests for AdminSaveRatioRules with any type of body
+func NewAdminSaveRatioRulesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/ratio-rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminListRevocationsRequest generates requests for AdminListRevocations
+func NewAdminListRevocationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/revocations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminRevokeUserRequest calls the generic AdminRevokeUser builder with application/json body
+func NewAdminRevokeUserRequest(server string, body AdminRevokeUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminRevokeUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminRevokeUserRequestWithBody generates requests for AdminRevokeUser with any type of body
+func NewAdminRevokeUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/revocations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminRemoveRevocationRequest generates requests for AdminRemoveRevocation
+func NewAdminRemoveRevocationRequest(server string, revocationID RevocationID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "revocationID", runtime.ParamLocationPath, revocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/revocations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListRolesRequest generates requests for AdminListRoles
+func NewAdminListRolesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/roles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminAssignRoleRequest calls the generic AdminAssignRole builder with application/json body
+func NewAdminAssignRoleRequest(server string, body AdminAssignRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminAssignRoleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminAssignRoleRequestWithBody generates requests for AdminAssignRole with any type of body
+func NewAdminAssignRoleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/roles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminRemoveRoleRequest generates requests for AdminRemoveRole
+func NewAdminRemoveRoleRequest(server string, roleID RoleID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roleID", runtime.ParamLocationPath, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/roles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminDeleteSessionRequest generates requests for AdminDeleteSession
+func NewAdminDeleteSessionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminCreateSessionRequest generates requests for AdminCreateSession
+func NewAdminCreateSessionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListSessionsRequest generates requests for AdminListSessions
+func NewAdminListSessionsRequest(server string, params *AdminListSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Email != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "email", runtime.ParamLocationQuery, *params.Email); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminRevokeSessionRequest generates requests for AdminRevokeSession
+func NewAdminRevokeSessionRequest(server string, sessionID SessionID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionID", runtime.ParamLocationPath, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListUnitsRequest generates requests for AdminListUnits
+func NewAdminListUnitsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/units")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminUpdateUnitRequest calls the generic AdminUpdateUnit builder with application/json body
+func NewAdminUpdateUnitRequest(server string, unitID UnitID, body AdminUpdateUnitJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminUpdateUnitRequestWithBody(server, unitID, "application/json", bodyReader)
+}
+
+// NewAdminUpdateUnitRequestWithBody generates requests for AdminUpdateUnit with any type of body
+func NewAdminUpdateUnitRequestWithBody(server string, unitID UnitID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "unitID", runtime.ParamLocationPath, unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/units/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminListUnitMembersRequest generates requests for AdminListUnitMembers
+func NewAdminListUnitMembersRequest(server string, unitID UnitID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "unitID", runtime.ParamLocationPath, unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/units/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminCreateMemberRequest calls the generic AdminCreateMember builder with application/json body
+func NewAdminCreateMemberRequest(server string, unitID UnitID, body AdminCreateMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminCreateMemberRequestWithBody(server, unitID, "application/json", bodyReader)
+}
+
+// NewAdminCreateMemberRequestWithBody generates requests for AdminCreateMember with any type of body
+func NewAdminCreateMemberRequestWithBody(server string, unitID UnitID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "unitID", runtime.ParamLocationPath, unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/units/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminGetWaitingListRequest generates requests for AdminGetWaitingList
+func NewAdminGetWaitingListRequest(server string, unitID UnitID, params *AdminGetWaitingListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "unitID", runtime.ParamLocationPath, unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/units/%s/waiting-list", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminOfferPlaceRequest generates requests for AdminOfferPlace
+func NewAdminOfferPlaceRequest(server string, unitID UnitID, joinRequestID JoinRequestID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "unitID", runtime.ParamLocationPath, unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "joinRequestID", runtime.ParamLocationPath, joinRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/units/%s/waiting-list/%s/offer", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarRequest generates requests for GetCalendar
+func NewGetCalendarRequest(server string, params *GetCalendarParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/calendar.ics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Unit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewContactUsRequest calls the generic ContactUs builder with application/json body
+func NewContactUsRequest(server string, body ContactUsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewContactUsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewContactUsRequestWithBody generates requests for ContactUs with any type of body
+func NewContactUsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/contact-us")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListEventsRequest generates requests for ListEvents
+func NewListEventsRequest(server string, params *ListEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Unit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSignUpForEventRequest calls the generic SignUpForEvent builder with application/json body
+func NewSignUpForEventRequest(server string, eventID EventID, body SignUpForEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSignUpForEventRequestWithBody(server, eventID, "application/json", bodyReader)
+}
+
+// NewSignUpForEventRequestWithBody generates requests for SignUpForEvent with any type of body
+func NewSignUpForEventRequestWithBody(server string, eventID EventID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventID", runtime.ParamLocationPath, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/events/%s/signups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelEventSignupRequest calls the generic CancelEventSignup builder with application/json body
+func NewCancelEventSignupRequest(server string, eventID EventID, signupID SignupID, body CancelEventSignupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCancelEventSignupRequestWithBody(server, eventID, signupID, "application/json", bodyReader)
+}
+
+// NewCancelEventSignupRequestWithBody generates requests for CancelEventSignup with any type of body
+func NewCancelEventSignupRequestWithBody(server string, eventID EventID, signupID SignupID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventID", runtime.ParamLocationPath, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "signupID", runtime.ParamLocationPath, signupID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/events/%s/signups/%s/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFamilyRequest generates requests for GetFamily
+func NewGetFamilyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/family")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteParentSessionRequest generates requests for DeleteParentSession
+func NewDeleteParentSessionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/family/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateJoinRequestRequest calls the generic CreateJoinRequest builder with application/json body
+func NewCreateJoinRequestRequest(server string, body CreateJoinRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateJoinRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateJoinRequestRequestWithBody generates requests for CreateJoinRequest with any type of body
+func NewCreateJoinRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/join-requests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateParentLoginRequest calls the generic CreateParentLogin builder with application/json body
+func NewCreateParentLoginRequest(server string, body CreateParentLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateParentLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateParentLoginRequestWithBody generates requests for CreateParentLogin with any type of body
+func NewCreateParentLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/parent-login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateParentSessionRequest calls the generic CreateParentSession builder with application/json body
+func NewCreateParentSessionRequest(server string, body CreateParentSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateParentSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateParentSessionRequestWithBody generates requests for CreateParentSession with any type of body
+func NewCreateParentSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/parent-login/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRespondToPlaceOfferRequest calls the generic RespondToPlaceOffer builder with application/json body
+func NewRespondToPlaceOfferRequest(server string, offerID OfferID, body RespondToPlaceOfferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRespondToPlaceOfferRequestWithBody(server, offerID, "application/json", bodyReader)
+}
+
+// NewRespondToPlaceOfferRequestWithBody generates requests for RespondToPlaceOffer with any type of body
+func NewRespondToPlaceOfferRequestWithBody(server string, offerID OfferID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "offerID", runtime.ParamLocationPath, offerID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/place-offers/%s/response", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListUnitsRequest generates requests for ListUnits
+func NewListUnitsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/units")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AdminListAPIKeysWithResponse request
+	AdminListAPIKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListAPIKeysResult, error)
+
+	// AdminCreateAPIKeyWithBodyWithResponse request with any body
+	AdminCreateAPIKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateAPIKeyResult, error)
+
+	AdminCreateAPIKeyWithResponse(ctx context.Context, body AdminCreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateAPIKeyResult, error)
+
+	// AdminDeleteAPIKeyWithResponse request
+	AdminDeleteAPIKeyWithResponse(ctx context.Context, apiKeyID APIKeyID, reqEditors ...RequestEditorFn) (*AdminDeleteAPIKeyResult, error)
+
+	// AdminListAuditLogWithResponse request
+	AdminListAuditLogWithResponse(ctx context.Context, params *AdminListAuditLogParams, reqEditors ...RequestEditorFn) (*AdminListAuditLogResult, error)
+
+	// AdminExportAuditLogWithResponse request
+	AdminExportAuditLogWithResponse(ctx context.Context, params *AdminExportAuditLogParams, reqEditors ...RequestEditorFn) (*AdminExportAuditLogResult, error)
+
+	// AdminListEventsWithResponse request
+	AdminListEventsWithResponse(ctx context.Context, params *AdminListEventsParams, reqEditors ...RequestEditorFn) (*AdminListEventsResult, error)
+
+	// AdminCreateEventWithBodyWithResponse request with any body
+	AdminCreateEventWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateEventResult, error)
+
+	AdminCreateEventWithResponse(ctx context.Context, body AdminCreateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateEventResult, error)
+
+	// AdminDeleteEventWithResponse request
+	AdminDeleteEventWithResponse(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*AdminDeleteEventResult, error)
+
+	// AdminGetEventWithResponse request
+	AdminGetEventWithResponse(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*AdminGetEventResult, error)
+
+	// AdminUpdateEventWithBodyWithResponse request with any body
+	AdminUpdateEventWithBodyWithResponse(ctx context.Context, eventID EventID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateEventResult, error)
+
+	AdminUpdateEventWithResponse(ctx context.Context, eventID EventID, body AdminUpdateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateEventResult, error)
+
+	// AdminExportEventAttendeesWithResponse request
+	AdminExportEventAttendeesWithResponse(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*AdminExportEventAttendeesResult, error)
+
+	// AdminListEventSignupsWithResponse request
+	AdminListEventSignupsWithResponse(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*AdminListEventSignupsResult, error)
+
+	// AdminCancelEventSignupWithResponse request
+	AdminCancelEventSignupWithResponse(ctx context.Context, eventID EventID, signupID SignupID, reqEditors ...RequestEditorFn) (*AdminCancelEventSignupResult, error)
+
+	// AdminListInvitationsWithResponse request
+	AdminListInvitationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListInvitationsResult, error)
+
+	// AdminCreateInvitationWithBodyWithResponse request with any body
+	AdminCreateInvitationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateInvitationResult, error)
+
+	AdminCreateInvitationWithResponse(ctx context.Context, body AdminCreateInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateInvitationResult, error)
+
+	// AdminRemoveInvitationWithResponse request
+	AdminRemoveInvitationWithResponse(ctx context.Context, invitationID InvitationID, reqEditors ...RequestEditorFn) (*AdminRemoveInvitationResult, error)
+
+	// AdminGetJoinRequestHistoryWithResponse request
+	AdminGetJoinRequestHistoryWithResponse(ctx context.Context, joinRequestID JoinRequestID, reqEditors ...RequestEditorFn) (*AdminGetJoinRequestHistoryResult, error)
+
+	// AdminGetMeWithResponse request
+	AdminGetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetMeResult, error)
+
+	// AdminGetMemberWithResponse request
+	AdminGetMemberWithResponse(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*AdminGetMemberResult, error)
+
+	// AdminUpdateMemberWithBodyWithResponse request with any body
+	AdminUpdateMemberWithBodyWithResponse(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateMemberResult, error)
+
+	AdminUpdateMemberWithResponse(ctx context.Context, memberID MemberID, body AdminUpdateMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateMemberResult, error)
+
+	// AdminGetMemberAccessLogWithResponse request
+	AdminGetMemberAccessLogWithResponse(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*AdminGetMemberAccessLogResult, error)
+
+	// AdminGetMemberSensitiveWithResponse request
+	AdminGetMemberSensitiveWithResponse(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*AdminGetMemberSensitiveResult, error)
+
+	// AdminSetMemberSensitiveWithBodyWithResponse request with any body
+	AdminSetMemberSensitiveWithBodyWithResponse(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminSetMemberSensitiveResult, error)
+
+	AdminSetMemberSensitiveWithResponse(ctx context.Context, memberID MemberID, body AdminSetMemberSensitiveJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminSetMemberSensitiveResult, error)
+
+	// AdminTransferMemberWithBodyWithResponse request with any body
+	AdminTransferMemberWithBodyWithResponse(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminTransferMemberResult, error)
+
+	AdminTransferMemberWithResponse(ctx context.Context, memberID MemberID, body AdminTransferMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminTransferMemberResult, error)
+
+	// AdminGetMoveUpReportWithResponse request
+	AdminGetMoveUpReportWithResponse(ctx context.Context, params *AdminGetMoveUpReportParams, reqEditors ...RequestEditorFn) (*AdminGetMoveUpReportResult, error)
+
+	// AdminCheckRatioWithBodyWithResponse request with any body
+	AdminCheckRatioWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCheckRatioResult, error)
+
+	AdminCheckRatioWithResponse(ctx context.Context, body AdminCheckRatioJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCheckRatioResult, error)
+
+	// AdminListRatioRulesWithResponse request
+	AdminListRatioRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListRatioRulesResult, error)
+
+	// AdminSaveRatioRulesWithBodyWithResponse request with any body
+	AdminSaveRatioRulesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminSaveRatioRulesResult, error)
+
+	AdminSaveRatioRulesWithResponse(ctx context.Context, body AdminSaveRatioRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminSaveRatioRulesResult, error)
+
+	// AdminListRevocationsWithResponse request
+	AdminListRevocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListRevocationsResult, error)
+
+	// AdminRevokeUserWithBodyWithResponse request with any body
+	AdminRevokeUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminRevokeUserResult, error)
+
+	AdminRevokeUserWithResponse(ctx context.Context, body AdminRevokeUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminRevokeUserResult, error)
+
+	// AdminRemoveRevocationWithResponse request
+	AdminRemoveRevocationWithResponse(ctx context.Context, revocationID RevocationID, reqEditors ...RequestEditorFn) (*AdminRemoveRevocationResult, error)
+
+	// AdminListRolesWithResponse request
+	AdminListRolesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListRolesResult, error)
+
+	// AdminAssignRoleWithBodyWithResponse request with any body
+	AdminAssignRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminAssignRoleResult, error)
+
+	AdminAssignRoleWithResponse(ctx context.Context, body AdminAssignRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminAssignRoleResult, error)
+
+	// AdminRemoveRoleWithResponse request
+	AdminRemoveRoleWithResponse(ctx context.Context, roleID RoleID, reqEditors ...RequestEditorFn) (*AdminRemoveRoleResult, error)
+
+	// AdminDeleteSessionWithResponse request
+	AdminDeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminDeleteSessionResult, error)
+
+	// AdminCreateSessionWithResponse request
+	AdminCreateSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminCreateSessionResult, error)
+
+	// AdminListSessionsWithResponse request
+	AdminListSessionsWithResponse(ctx context.Context, params *AdminListSessionsParams, reqEditors ...RequestEditorFn) (*AdminListSessionsResult, error)
+
+	// AdminRevokeSessionWithResponse request
+	AdminRevokeSessionWithResponse(ctx context.Context, sessionID SessionID, reqEditors ...RequestEditorFn) (*AdminRevokeSessionResult, error)
+
+	// AdminListUnitsWithResponse request
+	AdminListUnitsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListUnitsResult, error)
+
+	// AdminUpdateUnitWithBodyWithResponse request with any body
+	AdminUpdateUnitWithBodyWithResponse(ctx context.Context, unitID UnitID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateUnitResult, error)
+
+	AdminUpdateUnitWithResponse(ctx context.Context, unitID UnitID, body AdminUpdateUnitJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateUnitResult, error)
+
+	// AdminListUnitMembersWithResponse request
+	AdminListUnitMembersWithResponse(ctx context.Context, unitID UnitID, reqEditors ...RequestEditorFn) (*AdminListUnitMembersResult, error)
+
+	// AdminCreateMemberWithBodyWithResponse request with any body
+	AdminCreateMemberWithBodyWithResponse(ctx context.Context, unitID UnitID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateMemberResult, error)
+
+	AdminCreateMemberWithResponse(ctx context.Context, unitID UnitID, body AdminCreateMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateMemberResult, error)
+
+	// AdminGetWaitingListWithResponse request
+	AdminGetWaitingListWithResponse(ctx context.Context, unitID UnitID, params *AdminGetWaitingListParams, reqEditors ...RequestEditorFn) (*AdminGetWaitingListResult, error)
+
+	// AdminOfferPlaceWithResponse request
+	AdminOfferPlaceWithResponse(ctx context.Context, unitID UnitID, joinRequestID JoinRequestID, reqEditors ...RequestEditorFn) (*AdminOfferPlaceResult, error)
+
+	// GetCalendarWithResponse request
+	GetCalendarWithResponse(ctx context.Context, params *GetCalendarParams, reqEditors ...RequestEditorFn) (*GetCalendarResult, error)
+
+	// ContactUsWithBodyWithResponse request with any body
+	ContactUsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ContactUsResult, error)
+
+	ContactUsWithResponse(ctx context.Context, body ContactUsJSONRequestBody, reqEditors ...RequestEditorFn) (*ContactUsResult, error)
+
+	// ListEventsWithResponse request
+	ListEventsWithResponse(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*ListEventsResult, error)
+
+	// SignUpForEventWithBodyWithResponse request with any body
+	SignUpForEventWithBodyWithResponse(ctx context.Context, eventID EventID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignUpForEventResult, error)
+
+	SignUpForEventWithResponse(ctx context.Context, eventID EventID, body SignUpForEventJSONRequestBody, reqEditors ...RequestEditorFn) (*SignUpForEventResult, error)
+
+	// CancelEventSignupWithBodyWithResponse request with any body
+	CancelEventSignupWithBodyWithResponse(ctx context.Context, eventID EventID, signupID SignupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelEventSignupResult, error)
+
+	CancelEventSignupWithResponse(ctx context.Context, eventID EventID, signupID SignupID, body CancelEventSignupJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelEventSignupResult, error)
+
+	// GetFamilyWithResponse request
+	GetFamilyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFamilyResult, error)
+
+	// DeleteParentSessionWithResponse request
+	DeleteParentSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteParentSessionResult, error)
+
+	// CreateJoinRequestWithBodyWithResponse request with any body
+	CreateJoinRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateJoinRequestResult, error)
+
+	CreateJoinRequestWithResponse(ctx context.Context, body CreateJoinRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateJoinRequestResult, error)
+
+	// CreateParentLoginWithBodyWithResponse request with any body
+	CreateParentLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateParentLoginResult, error)
+
+	CreateParentLoginWithResponse(ctx context.Context, body CreateParentLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateParentLoginResult, error)
+
+	// CreateParentSessionWithBodyWithResponse request with any body
+	CreateParentSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateParentSessionResult, error)
+
+	CreateParentSessionWithResponse(ctx context.Context, body CreateParentSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateParentSessionResult, error)
+
+	// RespondToPlaceOfferWithBodyWithResponse request with any body
+	RespondToPlaceOfferWithBodyWithResponse(ctx context.Context, offerID OfferID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RespondToPlaceOfferResult, error)
+
+	RespondToPlaceOfferWithResponse(ctx context.Context, offerID OfferID, body RespondToPlaceOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*RespondToPlaceOfferResult, error)
+
+	// ListUnitsWithResponse request
+	ListUnitsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUnitsResult, error)
+}
+
+type AdminListAPIKeysResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIKeys
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListAPIKeysResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListAPIKeysResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateAPIKeyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedAPIKey
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateAPIKeyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateAPIKeyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDeleteAPIKeyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDeleteAPIKeyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDeleteAPIKeyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListAuditLogResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLog
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListAuditLogResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListAuditLogResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminExportAuditLogResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminExportAuditLogResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminExportAuditLogResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListEventsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminListEventsResponse
+	JSON403      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListEventsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListEventsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateEventResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AdminEvent
+	JSON403      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateEventResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateEventResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDeleteEventResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDeleteEventResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDeleteEventResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetEventResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminEvent
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetEventResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetEventResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminUpdateEventResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminEvent
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminUpdateEventResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminUpdateEventResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminExportEventAttendeesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminExportEventAttendeesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminExportEventAttendeesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListEventSignupsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminListEventSignupsResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListEventSignupsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListEventSignupsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCancelEventSignupResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCancelEventSignupResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCancelEventSignupResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListInvitationsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invitations
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListInvitationsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListInvitationsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateInvitationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Invitation
+	JSON403      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateInvitationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateInvitationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminRemoveInvitationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminRemoveInvitationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminRemoveInvitationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetJoinRequestHistoryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JoinRequestHistory
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetJoinRequestHistoryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetJoinRequestHistoryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetMeResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminUser
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetMeResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetMeResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetMemberResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Member
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetMemberResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetMemberResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminUpdateMemberResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Member
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminUpdateMemberResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminUpdateMemberResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetMemberAccessLogResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemberAccessLog
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetMemberAccessLogResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetMemberAccessLogResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetMemberSensitiveResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemberSensitive
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetMemberSensitiveResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetMemberSensitiveResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminSetMemberSensitiveResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminSetMemberSensitiveResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminSetMemberSensitiveResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminTransferMemberResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Member
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminTransferMemberResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminTransferMemberResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetMoveUpReportResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MoveUpReport
+	JSON403      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetMoveUpReportResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetMoveUpReportResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCheckRatioResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RatioCheck
+	JSON403      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCheckRatioResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCheckRatioResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListRatioRulesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RatioRules
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListRatioRulesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListRatioRulesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminSaveRatioRulesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RatioRules
+	JSON403      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminSaveRatioRulesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminSaveRatioRulesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListRevocationsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Revocations
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListRevocationsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListRevocationsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminRevokeUserResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Revocation
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminRevokeUserResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminRevokeUserResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminRemoveRevocationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminRemoveRevocationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminRemoveRevocationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListRolesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoleAssignments
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListRolesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListRolesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminAssignRoleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RoleAssignment
+	JSON403      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminAssignRoleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminAssignRoleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminRemoveRoleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminRemoveRoleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminRemoveRoleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDeleteSessionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDeleteSessionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDeleteSessionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateSessionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AdminUser
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateSessionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateSessionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListSessionsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminSessions
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListSessionsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListSessionsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminRevokeSessionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminRevokeSessionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminRevokeSessionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListUnitsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminListUnitsResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListUnitsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListUnitsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminUpdateUnitResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminUnit
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminUpdateUnitResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminUpdateUnitResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListUnitMembersResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListMembersResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListUnitMembersResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListUnitMembersResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateMemberResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Member
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateMemberResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateMemberResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetWaitingListResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WaitingList
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetWaitingListResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetWaitingListResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminOfferPlaceResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PlaceOffer
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminOfferPlaceResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminOfferPlaceResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ContactUsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ContactUsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ContactUsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEventsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListEventsResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEventsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEventsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SignUpForEventResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *EventSignupResult
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SignUpForEventResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SignUpForEventResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelEventSignupResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelEventSignupResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelEventSignupResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFamilyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Family
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFamilyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFamilyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteParentSessionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteParentSessionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteParentSessionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateJoinRequestResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *JoinRequestResult
+	JSON422      *ErrorResponse
+	JSON429      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateJoinRequestResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateJoinRequestResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateParentLoginResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON422      *ErrorResponse
+	JSON429      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateParentLoginResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateParentLoginResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateParentSessionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Parent
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateParentSessionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateParentSessionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RespondToPlaceOfferResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PlaceOffer
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RespondToPlaceOfferResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RespondToPlaceOfferResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUnitsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListUnitsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUnitsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUnitsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AdminListAPIKeysWithResponse request returning *AdminListAPIKeysResult
+func (c *ClientWithResponses) AdminListAPIKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListAPIKeysResult, error) {
+	rsp, err := c.AdminListAPIKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListAPIKeysResult(rsp)
+}
+
+// AdminCreateAPIKeyWithBodyWithResponse request with arbitrary body returning *AdminCreateAPIKeyResult
+func (c *ClientWithResponses) AdminCreateAPIKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateAPIKeyResult, error) {
+	rsp, err := c.AdminCreateAPIKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateAPIKeyResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateAPIKeyWithResponse(ctx context.Context, body AdminCreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateAPIKeyResult, error) {
+	rsp, err := c.AdminCreateAPIKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateAPIKeyResult(rsp)
+}
+
+// AdminDeleteAPIKeyWithResponse request returning *AdminDeleteAPIKeyResult
+func (c *ClientWithResponses) AdminDeleteAPIKeyWithResponse(ctx context.Context, apiKeyID APIKeyID, reqEditors ...RequestEditorFn) (*AdminDeleteAPIKeyResult, error) {
+	rsp, err := c.AdminDeleteAPIKey(ctx, apiKeyID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDeleteAPIKeyResult(rsp)
+}
+
+// AdminListAuditLogWithResponse request returning *AdminListAuditLogResult
+func (c *ClientWithResponses) AdminListAuditLogWithResponse(ctx context.Context, params *AdminListAuditLogParams, reqEditors ...RequestEditorFn) (*AdminListAuditLogResult, error) {
+	rsp, err := c.AdminListAuditLog(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListAuditLogResult(rsp)
+}
+
+// AdminExportAuditLogWithResponse request returning *AdminExportAuditLogResult
+func (c *ClientWithResponses) AdminExportAuditLogWithResponse(ctx context.Context, params *AdminExportAuditLogParams, reqEditors ...RequestEditorFn) (*AdminExportAuditLogResult, error) {
+	rsp, err := c.AdminExportAuditLog(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminExportAuditLogResult(rsp)
+}
+
+// AdminListEventsWithResponse request returning *AdminListEventsResult
+func (c *ClientWithResponses) AdminListEventsWithResponse(ctx context.Context, params *AdminListEventsParams, reqEditors ...RequestEditorFn) (*AdminListEventsResult, error) {
+	rsp, err := c.AdminListEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListEventsResult(rsp)
+}
+
+// AdminCreateEventWithBodyWithResponse request with arbitrary body returning *AdminCreateEventResult
+func (c *ClientWithResponses) AdminCreateEventWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateEventResult, error) {
+	rsp, err := c.AdminCreateEventWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateEventResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateEventWithResponse(ctx context.Context, body AdminCreateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateEventResult, error) {
+	rsp, err := c.AdminCreateEvent(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateEventResult(rsp)
+}
+
+// AdminDeleteEventWithResponse request returning *AdminDeleteEventResult
+func (c *ClientWithResponses) AdminDeleteEventWithResponse(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*AdminDeleteEventResult, error) {
+	rsp, err := c.AdminDeleteEvent(ctx, eventID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDeleteEventResult(rsp)
+}
+
+// AdminGetEventWithResponse request returning *AdminGetEventResult
+func (c *ClientWithResponses) AdminGetEventWithResponse(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*AdminGetEventResult, error) {
+	rsp, err := c.AdminGetEvent(ctx, eventID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetEventResult(rsp)
+}
+
+// AdminUpdateEventWithBodyWithResponse request with arbitrary body returning *AdminUpdateEventResult
+func (c *ClientWithResponses) AdminUpdateEventWithBodyWithResponse(ctx context.Context, eventID EventID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateEventResult, error) {
+	rsp, err := c.AdminUpdateEventWithBody(ctx, eventID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateEventResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminUpdateEventWithResponse(ctx context.Context, eventID EventID, body AdminUpdateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateEventResult, error) {
+	rsp, err := c.AdminUpdateEvent(ctx, eventID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateEventResult(rsp)
+}
+
+// AdminExportEventAttendeesWithResponse request returning *AdminExportEventAttendeesResult
+func (c *ClientWithResponses) AdminExportEventAttendeesWithResponse(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*AdminExportEventAttendeesResult, error) {
+	rsp, err := c.AdminExportEventAttendees(ctx, eventID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminExportEventAttendeesResult(rsp)
+}
+
+// AdminListEventSignupsWithResponse request returning *AdminListEventSignupsResult
+func (c *ClientWithResponses) AdminListEventSignupsWithResponse(ctx context.Context, eventID EventID, reqEditors ...RequestEditorFn) (*AdminListEventSignupsResult, error) {
+	rsp, err := c.AdminListEventSignups(ctx, eventID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListEventSignupsResult(rsp)
+}
+
+// AdminCancelEventSignupWithResponse request returning *AdminCancelEventSignupResult
+func (c *ClientWithResponses) AdminCancelEventSignupWithResponse(ctx context.Context, eventID EventID, signupID SignupID, reqEditors ...RequestEditorFn) (*AdminCancelEventSignupResult, error) {
+	rsp, err := c.AdminCancelEventSignup(ctx, eventID, signupID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCancelEventSignupResult(rsp)
+}
+
+// AdminListInvitationsWithResponse request returning *AdminListInvitationsResult
+func (c *ClientWithResponses) AdminListInvitationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListInvitationsResult, error) {
+	rsp, err := c.AdminListInvitations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListInvitationsResult(rsp)
+}
+
+// AdminCreateInvitationWithBodyWithResponse request with arbitrary body returning *AdminCreateInvitationResult
+func (c *ClientWithResponses) AdminCreateInvitationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateInvitationResult, error) {
+	rsp, err := c.AdminCreateInvitationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateInvitationResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateInvitationWithResponse(ctx context.Context, body AdminCreateInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateInvitationResult, error) {
+	rsp, err := c.AdminCreateInvitation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateInvitationResult(rsp)
+}
+
+// AdminRemoveInvitationWithResponse request returning *AdminRemoveInvitationResult
+func (c *ClientWithResponses) AdminRemoveInvitationWithResponse(ctx context.Context, invitationID InvitationID, reqEditors ...RequestEditorFn) (*AdminRemoveInvitationResult, error) {
+	rsp, err := c.AdminRemoveInvitation(ctx, invitationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRemoveInvitationResult(rsp)
+}
+
+// AdminGetJoinRequestHistoryWithResponse request returning *AdminGetJoinRequestHistoryResult
+func (c *ClientWithResponses) AdminGetJoinRequestHistoryWithResponse(ctx context.Context, joinRequestID JoinRequestID, reqEditors ...RequestEditorFn) (*AdminGetJoinRequestHistoryResult, error) {
+	rsp, err := c.AdminGetJoinRequestHistory(ctx, joinRequestID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetJoinRequestHistoryResult(rsp)
+}
+
+// AdminGetMeWithResponse request returning *AdminGetMeResult
+func (c *ClientWithResponses) AdminGetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetMeResult, error) {
+	rsp, err := c.AdminGetMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetMeResult(rsp)
+}
+
+// AdminGetMemberWithResponse request returning *AdminGetMemberResult
+func (c *ClientWithResponses) AdminGetMemberWithResponse(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*AdminGetMemberResult, error) {
+	rsp, err := c.AdminGetMember(ctx, memberID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetMemberResult(rsp)
+}
+
+// AdminUpdateMemberWithBodyWithResponse request with arbitrary body returning *AdminUpdateMemberResult
+func (c *ClientWithResponses) AdminUpdateMemberWithBodyWithResponse(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateMemberResult, error) {
+	rsp, err := c.AdminUpdateMemberWithBody(ctx, memberID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateMemberResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminUpdateMemberWithResponse(ctx context.Context, memberID MemberID, body AdminUpdateMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateMemberResult, error) {
+	rsp, err := c.AdminUpdateMember(ctx, memberID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateMemberResult(rsp)
+}
+
+// AdminGetMemberAccessLogWithResponse request returning *AdminGetMemberAccessLogResult
+func (c *ClientWithResponses) AdminGetMemberAccessLogWithResponse(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*AdminGetMemberAccessLogResult, error) {
+	rsp, err := c.AdminGetMemberAccessLog(ctx, memberID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetMemberAccessLogResult(rsp)
+}
+
+// AdminGetMemberSensitiveWithResponse request returning *AdminGetMemberSensitiveResult
+func (c *ClientWithResponses) AdminGetMemberSensitiveWithResponse(ctx context.Context, memberID MemberID, reqEditors ...RequestEditorFn) (*AdminGetMemberSensitiveResult, error) {
+	rsp, err := c.AdminGetMemberSensitive(ctx, memberID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetMemberSensitiveResult(rsp)
+}
+
+// AdminSetMemberSensitiveWithBodyWithResponse request with arbitrary body returning *AdminSetMemberSensitiveResult
+func (c *ClientWithResponses) AdminSetMemberSensitiveWithBodyWithResponse(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminSetMemberSensitiveResult, error) {
+	rsp, err := c.AdminSetMemberSensitiveWithBody(ctx, memberID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminSetMemberSensitiveResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminSetMemberSensitiveWithResponse(ctx context.Context, memberID MemberID, body AdminSetMemberSensitiveJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminSetMemberSensitiveResult, error) {
+	rsp, err := c.AdminSetMemberSensitive(ctx, memberID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminSetMemberSensitiveResult(rsp)
+}
+
+// AdminTransferMemberWithBodyWithResponse request with arbitrary body returning *AdminTransferMemberResult
+func (c *ClientWithResponses) AdminTransferMemberWithBodyWithResponse(ctx context.Context, memberID MemberID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminTransferMemberResult, error) {
+	rsp, err := c.AdminTransferMemberWithBody(ctx, memberID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminTransferMemberResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminTransferMemberWithResponse(ctx context.Context, memberID MemberID, body AdminTransferMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminTransferMemberResult, error) {
+	rsp, err := c.AdminTransferMember(ctx, memberID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminTransferMemberResult(rsp)
+}
+
+// AdminGetMoveUpReportWithResponse request returning *AdminGetMoveUpReportResult
+func (c *ClientWithResponses) AdminGetMoveUpReportWithResponse(ctx context.Context, params *AdminGetMoveUpReportParams, reqEditors ...RequestEditorFn) (*AdminGetMoveUpReportResult, error) {
+	rsp, err := c.AdminGetMoveUpReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetMoveUpReportResult(rsp)
+}
+
+// AdminCheckRatioWithBodyWithResponse request with arbitrary body returning *AdminCheckRatioResult
+func (c *ClientWithResponses) AdminCheckRatioWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCheckRatioResult, error) {
+	rsp, err := c.AdminCheckRatioWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCheckRatioResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminCheckRatioWithResponse(ctx context.Context, body AdminCheckRatioJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCheckRatioResult, error) {
+	rsp, err := c.AdminCheckRatio(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCheckRatioResult(rsp)
+}
+
+// AdminListRatioRulesWithResponse request returning *AdminListRatioRulesResult
+func (c *ClientWithResponses) AdminListRatioRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListRatioRulesResult, error) {
+	rsp, err := c.AdminListRatioRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListRatioRulesResult(rsp)
+}
+
+// AdminSaveRatioRulesWithBodyWithResponse request with arbitrary body returning *AdminSaveRatioRulesResult
+func (c *ClientWithResponses) AdminSaveRatioRulesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminSaveRatioRulesResult, error) {
+	rsp, err := c.AdminSaveRatioRulesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminSaveRatioRulesResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminSaveRatioRulesWithResponse(ctx context.Context, body AdminSaveRatioRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminSaveRatioRulesResult, error) {
+	rsp, err := c.AdminSaveRatioRules(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminSaveRatioRulesResult(rsp)
+}
+
+// AdminListRevocationsWithResponse request returning *AdminListRevocationsResult
+func (c *ClientWithResponses) AdminListRevocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListRevocationsResult, error) {
+	rsp, err := c.AdminListRevocations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListRevocationsResult(rsp)
+}
+
+// AdminRevokeUserWithBodyWithResponse request with arbitrary body returning *AdminRevokeUserResult
+func (c *ClientWithResponses) AdminRevokeUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminRevokeUserResult, error) {
+	rsp, err := c.AdminRevokeUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRevokeUserResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminRevokeUserWithResponse(ctx context.Context, body AdminRevokeUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminRevokeUserResult, error) {
+	rsp, err := c.AdminRevokeUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRevokeUserResult(rsp)
+}
+
+// AdminRemoveRevocationWithResponse request returning *AdminRemoveRevocationResult
+func (c *ClientWithResponses) AdminRemoveRevocationWithResponse(ctx context.Context, revocationID RevocationID, reqEditors ...RequestEditorFn) (*AdminRemoveRevocationResult, error) {
+	rsp, err := c.AdminRemoveRevocation(ctx, revocationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRemoveRevocationResult(rsp)
+}
+
+// AdminListRolesWithResponse request returning *AdminListRolesResult
+func (c *ClientWithResponses) AdminListRolesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListRolesResult, error) {
+	rsp, err := c.AdminListRoles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListRolesResult(rsp)
+}
+
+// AdminAssignRoleWithBodyWithResponse request with arbitrary body returning *AdminAssignRoleResult
+func (c *ClientWithResponses) AdminAssignRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminAssignRoleResult, error) {
+	rsp, err := c.AdminAssignRoleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminAssignRoleResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminAssignRoleWithResponse(ctx context.Context, body AdminAssignRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminAssignRoleResult, error) {
+	rsp, err := c.AdminAssignRole(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminAssignRoleResult(rsp)
+}
+
+// AdminRemoveRoleWithResponse request returning *AdminRemoveRoleResult
+func (c *ClientWithResponses) AdminRemoveRoleWithResponse(ctx context.Context, roleID RoleID, reqEditors ...RequestEditorFn) (*AdminRemoveRoleResult, error) {
+	rsp, err := c.AdminRemoveRole(ctx, roleID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRemoveRoleResult(rsp)
+}
+
+// AdminDeleteSessionWithResponse request returning *AdminDeleteSessionResult
+func (c *ClientWithResponses) AdminDeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminDeleteSessionResult, error) {
+	rsp, err := c.AdminDeleteSession(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDeleteSessionResult(rsp)
+}
+
+// AdminCreateSessionWithResponse request returning *AdminCreateSessionResult
+func (c *ClientWithResponses) AdminCreateSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminCreateSessionResult, error) {
+	rsp, err := c.AdminCreateSession(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateSessionResult(rsp)
+}
+
+// AdminListSessionsWithResponse request returning *AdminListSessionsResult
+func (c *ClientWithResponses) AdminListSessionsWithResponse(ctx context.Context, params *AdminListSessionsParams, reqEditors ...RequestEditorFn) (*AdminListSessionsResult, error) {
+	rsp, err := c.AdminListSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListSessionsResult(rsp)
+}
+
+// AdminRevokeSessionWithResponse request returning *AdminRevokeSessionResult
+func (c *ClientWithResponses) AdminRevokeSessionWithResponse(ctx context.Context, sessionID SessionID, reqEditors ...RequestEditorFn) (*AdminRevokeSessionResult, error) {
+	rsp, err := c.AdminRevokeSession(ctx, sessionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRevokeSessionResult(rsp)
+}
+
+// AdminListUnitsWithResponse request returning *AdminListUnitsResult
+func (c *ClientWithResponses) AdminListUnitsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListUnitsResult, error) {
+	rsp, err := c.AdminListUnits(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListUnitsResult(rsp)
+}
+
+// AdminUpdateUnitWithBodyWithResponse request with arbitrary body returning *AdminUpdateUnitResult
+func (c *ClientWithResponses) AdminUpdateUnitWithBodyWithResponse(ctx context.Context, unitID UnitID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateUnitResult, error) {
+	rsp, err := c.AdminUpdateUnitWithBody(ctx, unitID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateUnitResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminUpdateUnitWithResponse(ctx context.Context, unitID UnitID, body AdminUpdateUnitJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateUnitResult, error) {
+	rsp, err := c.AdminUpdateUnit(ctx, unitID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateUnitResult(rsp)
+}
+
+// AdminListUnitMembersWithResponse request returning *AdminListUnitMembersResult
+func (c *ClientWithResponses) AdminListUnitMembersWithResponse(ctx context.Context, unitID UnitID, reqEditors ...RequestEditorFn) (*AdminListUnitMembersResult, error) {
+	rsp, err := c.AdminListUnitMembers(ctx, unitID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListUnitMembersResult(rsp)
+}
+
+// AdminCreateMemberWithBodyWithResponse request with arbitrary body returning *AdminCreateMemberResult
+func (c *ClientWithResponses) AdminCreateMemberWithBodyWithResponse(ctx context.Context, unitID UnitID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateMemberResult, error) {
+	rsp, err := c.AdminCreateMemberWithBody(ctx, unitID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateMemberResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateMemberWithResponse(ctx context.Context, unitID UnitID, body AdminCreateMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateMemberResult, error) {
+	rsp, err := c.AdminCreateMember(ctx, unitID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateMemberResult(rsp)
+}
+
+// AdminGetWaitingListWithResponse request returning *AdminGetWaitingListResult
+func (c *ClientWithResponses) AdminGetWaitingListWithResponse(ctx context.Context, unitID UnitID, params *AdminGetWaitingListParams, reqEditors ...RequestEditorFn) (*AdminGetWaitingListResult, error) {
+	rsp, err := c.AdminGetWaitingList(ctx, unitID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetWaitingListResult(rsp)
+}
+
+// AdminOfferPlaceWithResponse request returning *AdminOfferPlaceResult
+func (c *ClientWithResponses) AdminOfferPlaceWithResponse(ctx context.Context, unitID UnitID, joinRequestID JoinRequestID, reqEditors ...RequestEditorFn) (*AdminOfferPlaceResult, error) {
+	rsp, err := c.AdminOfferPlace(ctx, unitID, joinRequestID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminOfferPlaceResult(rsp)
+}
+
+// GetCalendarWithResponse request returning *GetCalendarResult
+func (c *ClientWithResponses) GetCalendarWithResponse(ctx context.Context, params *GetCalendarParams, reqEditors ...RequestEditorFn) (*GetCalendarResult, error) {
+	rsp, err := c.GetCalendar(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarResult(rsp)
+}
+
+// ContactUsWithBodyWithResponse request with arbitrary body returning *ContactUsResult
+func (c *ClientWithResponses) ContactUsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ContactUsResult, error) {
+	rsp, err := c.ContactUsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseContactUsResult(rsp)
+}
+
+func (c *ClientWithResponses) ContactUsWithResponse(ctx context.Context, body ContactUsJSONRequestBody, reqEditors ...RequestEditorFn) (*ContactUsResult, error) {
+	rsp, err := c.ContactUs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseContactUsResult(rsp)
+}
+
+// ListEventsWithResponse request returning *ListEventsResult
+func (c *ClientWithResponses) ListEventsWithResponse(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*ListEventsResult, error) {
+	rsp, err := c.ListEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEventsResult(rsp)
+}
+
+// SignUpForEventWithBodyWithResponse request with arbitrary body returning *SignUpForEventResult
+func (c *ClientWithResponses) SignUpForEventWithBodyWithResponse(ctx context.Context, eventID EventID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignUpForEventResult, error) {
+	rsp, err := c.SignUpForEventWithBody(ctx, eventID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignUpForEventResult(rsp)
+}
+
+func (c *ClientWithResponses) SignUpForEventWithResponse(ctx context.Context, eventID EventID, body SignUpForEventJSONRequestBody, reqEditors ...RequestEditorFn) (*SignUpForEventResult, error) {
+	rsp, err := c.SignUpForEvent(ctx, eventID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignUpForEventResult(rsp)
+}
+
+// CancelEventSignupWithBodyWithResponse request with arbitrary body returning *CancelEventSignupResult
+func (c *ClientWithResponses) CancelEventSignupWithBodyWithResponse(ctx context.Context, eventID EventID, signupID SignupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelEventSignupResult, error) {
+	rsp, err := c.CancelEventSignupWithBody(ctx, eventID, signupID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelEventSignupResult(rsp)
+}
+
+func (c *ClientWithResponses) CancelEventSignupWithResponse(ctx context.Context, eventID EventID, signupID SignupID, body CancelEventSignupJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelEventSignupResult, error) {
+	rsp, err := c.CancelEventSignup(ctx, eventID, signupID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelEventSignupResult(rsp)
+}
+
+// GetFamilyWithResponse request returning *GetFamilyResult
+func (c *ClientWithResponses) GetFamilyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFamilyResult, error) {
+	rsp, err := c.GetFamily(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFamilyResult(rsp)
+}
+
+// DeleteParentSessionWithResponse request returning *DeleteParentSessionResult
+func (c *ClientWithResponses) DeleteParentSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteParentSessionResult, error) {
+	rsp, err := c.DeleteParentSession(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteParentSessionResult(rsp)
+}
+
+// CreateJoinRequestWithBodyWithResponse request with arbitrary body returning *CreateJoinRequestResult
+func (c *ClientWithResponses) CreateJoinRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateJoinRequestResult, error) {
+	rsp, err := c.CreateJoinRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateJoinRequestResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateJoinRequestWithResponse(ctx context.Context, body CreateJoinRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateJoinRequestResult, error) {
+	rsp, err := c.CreateJoinRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateJoinRequestResult(rsp)
+}
+
+// CreateParentLoginWithBodyWithResponse request with arbitrary body returning *CreateParentLoginResult
+func (c *ClientWithResponses) CreateParentLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateParentLoginResult, error) {
+	rsp, err := c.CreateParentLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateParentLoginResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateParentLoginWithResponse(ctx context.Context, body CreateParentLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateParentLoginResult, error) {
+	rsp, err := c.CreateParentLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateParentLoginResult(rsp)
+}
+
+// CreateParentSessionWithBodyWithResponse request with arbitrary body returning *CreateParentSessionResult
+func (c *ClientWithResponses) CreateParentSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateParentSessionResult, error) {
+	rsp, err := c.CreateParentSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateParentSessionResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateParentSessionWithResponse(ctx context.Context, body CreateParentSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateParentSessionResult, error) {
+	rsp, err := c.CreateParentSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateParentSessionResult(rsp)
+}
+
+// RespondToPlaceOfferWithBodyWithResponse request with arbitrary body returning *RespondToPlaceOfferResult
+func (c *ClientWithResponses) RespondToPlaceOfferWithBodyWithResponse(ctx context.Context, offerID OfferID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RespondToPlaceOfferResult, error) {
+	rsp, err := c.RespondToPlaceOfferWithBody(ctx, offerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRespondToPlaceOfferResult(rsp)
+}
+
+func (c *ClientWithResponses) RespondToPlaceOfferWithResponse(ctx context.Context, offerID OfferID, body RespondToPlaceOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*RespondToPlaceOfferResult, error) {
+	rsp, err := c.RespondToPlaceOffer(ctx, offerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRespondToPlaceOfferResult(rsp)
+}
+
+// ListUnitsWithResponse request returning *ListUnitsResult
+func (c *ClientWithResponses) ListUnitsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUnitsResult, error) {
+	rsp, err := c.ListUnits(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUnitsResult(rsp)
+}
+
+// ParseAdminListAPIKeysResult parses an HTTP response from a AdminListAPIKeysWithResponse call
+func ParseAdminListAPIKeysResult(rsp *http.Response) (*AdminListAPIKeysResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListAPIKeysResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIKeys
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateAPIKeyResult parses an HTTP response from a AdminCreateAPIKeyWithResponse call
+func ParseAdminCreateAPIKeyResult(rsp *http.Response) (*AdminCreateAPIKeyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateAPIKeyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedAPIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDeleteAPIKeyResult parses an HTTP response from a AdminDeleteAPIKeyWithResponse call
+func ParseAdminDeleteAPIKeyResult(rsp *http.Response) (*AdminDeleteAPIKeyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDeleteAPIKeyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListAuditLogResult parses an HTTP response from a AdminListAuditLogWithResponse call
+func ParseAdminListAuditLogResult(rsp *http.Response) (*AdminListAuditLogResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListAuditLogResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditLog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminExportAuditLogResult parses an HTTP response from a AdminExportAuditLogWithResponse call
+func ParseAdminExportAuditLogResult(rsp *http.Response) (*AdminExportAuditLogResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminExportAuditLogResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListEventsResult parses an HTTP response from a AdminListEventsWithResponse call
+func ParseAdminListEventsResult(rsp *http.Response) (*AdminListEventsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListEventsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminListEventsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateEventResult parses an HTTP response from a AdminCreateEventWithResponse call
+func ParseAdminCreateEventResult(rsp *http.Response) (*AdminCreateEventResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateEventResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AdminEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDeleteEventResult parses an HTTP response from a AdminDeleteEventWithResponse call
+func ParseAdminDeleteEventResult(rsp *http.Response) (*AdminDeleteEventResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDeleteEventResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetEventResult parses an HTTP response from a AdminGetEventWithResponse call
+func ParseAdminGetEventResult(rsp *http.Response) (*AdminGetEventResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetEventResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminUpdateEventResult parses an HTTP response from a AdminUpdateEventWithResponse call
+func ParseAdminUpdateEventResult(rsp *http.Response) (*AdminUpdateEventResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminUpdateEventResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminExportEventAttendeesResult parses an HTTP response from a AdminExportEventAttendeesWithResponse call
+func ParseAdminExportEventAttendeesResult(rsp *http.Response) (*AdminExportEventAttendeesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminExportEventAttendeesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListEventSignupsResult parses an HTTP response from a AdminListEventSignupsWithResponse call
+func ParseAdminListEventSignupsResult(rsp *http.Response) (*AdminListEventSignupsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListEventSignupsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminListEventSignupsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCancelEventSignupResult parses an HTTP response from a AdminCancelEventSignupWithResponse call
+func ParseAdminCancelEventSignupResult(rsp *http.Response) (*AdminCancelEventSignupResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCancelEventSignupResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListInvitationsResult parses an HTTP response from a AdminListInvitationsWithResponse call
+func ParseAdminListInvitationsResult(rsp *http.Response) (*AdminListInvitationsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListInvitationsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invitations
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateInvitationResult parses an HTTP response from a AdminCreateInvitationWithResponse call
+func ParseAdminCreateInvitationResult(rsp *http.Response) (*AdminCreateInvitationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateInvitationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Invitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminRemoveInvitationResult parses an HTTP response from a AdminRemoveInvitationWithResponse call
+func ParseAdminRemoveInvitationResult(rsp *http.Response) (*AdminRemoveInvitationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminRemoveInvitationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetJoinRequestHistoryResult parses an HTTP response from a AdminGetJoinRequestHistoryWithResponse call
+func ParseAdminGetJoinRequestHistoryResult(rsp *http.Response) (*AdminGetJoinRequestHistoryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetJoinRequestHistoryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JoinRequestHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetMeResult parses an HTTP response from a AdminGetMeWithResponse call
+func ParseAdminGetMeResult(rsp *http.Response) (*AdminGetMeResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetMeResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetMemberResult parses an HTTP response from a AdminGetMemberWithResponse call
+func ParseAdminGetMemberResult(rsp *http.Response) (*AdminGetMemberResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetMemberResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Member
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminUpdateMemberResult parses an HTTP response from a AdminUpdateMemberWithResponse call
+func ParseAdminUpdateMemberResult(rsp *http.Response) (*AdminUpdateMemberResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminUpdateMemberResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Member
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetMemberAccessLogResult parses an HTTP response from a AdminGetMemberAccessLogWithResponse call
+func ParseAdminGetMemberAccessLogResult(rsp *http.Response) (*AdminGetMemberAccessLogResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetMemberAccessLogResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemberAccessLog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetMemberSensitiveResult parses an HTTP response from a AdminGetMemberSensitiveWithResponse call
+func ParseAdminGetMemberSensitiveResult(rsp *http.Response) (*AdminGetMemberSensitiveResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetMemberSensitiveResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemberSensitive
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminSetMemberSensitiveResult parses an HTTP response from a AdminSetMemberSensitiveWithResponse call
+func ParseAdminSetMemberSensitiveResult(rsp *http.Response) (*AdminSetMemberSensitiveResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminSetMemberSensitiveResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminTransferMemberResult parses an HTTP response from a AdminTransferMemberWithResponse call
+func ParseAdminTransferMemberResult(rsp *http.Response) (*AdminTransferMemberResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminTransferMemberResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Member
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetMoveUpReportResult parses an HTTP response from a AdminGetMoveUpReportWithResponse call
+func ParseAdminGetMoveUpReportResult(rsp *http.Response) (*AdminGetMoveUpReportResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetMoveUpReportResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MoveUpReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCheckRatioResult parses an HTTP response from a AdminCheckRatioWithResponse call
+func ParseAdminCheckRatioResult(rsp *http.Response) (*AdminCheckRatioResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCheckRatioResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RatioCheck
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListRatioRulesResult parses an HTTP response from a AdminListRatioRulesWithResponse call
+func ParseAdminListRatioRulesResult(rsp *http.Response) (*AdminListRatioRulesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListRatioRulesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RatioRules
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminSaveRatioRulesResult parses an HTTP response from a AdminSaveRatioRulesWithResponse call
+func ParseAdminSaveRatioRulesResult(rsp *http.Response) (*AdminSaveRatioRulesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminSaveRatioRulesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RatioRules
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListRevocationsResult parses an HTTP response from a AdminListRevocationsWithResponse call
+func ParseAdminListRevocationsResult(rsp *http.Response) (*AdminListRevocationsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListRevocationsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Revocations
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminRevokeUserResult parses an HTTP response from a AdminRevokeUserWithResponse call
+func ParseAdminRevokeUserResult(rsp *http.Response) (*AdminRevokeUserResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminRevokeUserResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Revocation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminRemoveRevocationResult parses an HTTP response from a AdminRemoveRevocationWithResponse call
+func ParseAdminRemoveRevocationResult(rsp *http.Response) (*AdminRemoveRevocationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminRemoveRevocationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListRolesResult parses an HTTP response from a AdminListRolesWithResponse call
+func ParseAdminListRolesResult(rsp *http.Response) (*AdminListRolesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListRolesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RoleAssignments
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminAssignRoleResult parses an HTTP response from a AdminAssignRoleWithResponse call
+func ParseAdminAssignRoleResult(rsp *http.Response) (*AdminAssignRoleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminAssignRoleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RoleAssignment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminRemoveRoleResult parses an HTTP response from a AdminRemoveRoleWithResponse call
+func ParseAdminRemoveRoleResult(rsp *http.Response) (*AdminRemoveRoleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminRemoveRoleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDeleteSessionResult parses an HTTP response from a AdminDeleteSessionWithResponse call
+func ParseAdminDeleteSessionResult(rsp *http.Response) (*AdminDeleteSessionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDeleteSessionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateSessionResult parses an HTTP response from a AdminCreateSessionWithResponse call
+func ParseAdminCreateSessionResult(rsp *http.Response) (*AdminCreateSessionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateSessionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AdminUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListSessionsResult parses an HTTP response from a AdminListSessionsWithResponse call
+func ParseAdminListSessionsResult(rsp *http.Response) (*AdminListSessionsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListSessionsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminSessions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminRevokeSessionResult parses an HTTP response from a AdminRevokeSessionWithResponse call
+func ParseAdminRevokeSessionResult(rsp *http.Response) (*AdminRevokeSessionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminRevokeSessionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListUnitsResult parses an HTTP response from a AdminListUnitsWithResponse call
+func ParseAdminListUnitsResult(rsp *http.Response) (*AdminListUnitsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListUnitsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminListUnitsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminUpdateUnitResult parses an HTTP response from a AdminUpdateUnitWithResponse call
+func ParseAdminUpdateUnitResult(rsp *http.Response) (*AdminUpdateUnitResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminUpdateUnitResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminUnit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListUnitMembersResult parses an HTTP response from a AdminListUnitMembersWithResponse call
+func ParseAdminListUnitMembersResult(rsp *http.Response) (*AdminListUnitMembersResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListUnitMembersResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListMembersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateMemberResult parses an HTTP response from a AdminCreateMemberWithResponse call
+func ParseAdminCreateMemberResult(rsp *http.Response) (*AdminCreateMemberResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateMemberResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Member
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetWaitingListResult parses an HTTP response from a AdminGetWaitingListWithResponse call
+func ParseAdminGetWaitingListResult(rsp *http.Response) (*AdminGetWaitingListResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetWaitingListResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WaitingList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminOfferPlaceResult parses an HTTP response from a AdminOfferPlaceWithResponse call
+func ParseAdminOfferPlaceResult(rsp *http.Response) (*AdminOfferPlaceResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminOfferPlaceResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PlaceOffer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarResult parses an HTTP response from a GetCalendarWithResponse call
+func ParseGetCalendarResult(rsp *http.Response) (*GetCalendarResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseContactUsResult parses an HTTP response from a ContactUsWithResponse call
+func ParseContactUsResult(rsp *http.Response) (*ContactUsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ContactUsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListEventsResult parses an HTTP response from a ListEventsWithResponse call
+func ParseListEventsResult(rsp *http.Response) (*ListEventsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListEventsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListEventsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSignUpForEventResult parses an HTTP response from a SignUpForEventWithResponse call
+func ParseSignUpForEventResult(rsp *http.Response) (*SignUpForEventResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignUpForEventResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest EventSignupResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
 
-	ContactUs(ctx context.Context, body ContactUsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	return response, nil
 }
 
-func (c *Client) ContactUsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewContactUsRequestWithBody(c.Server, contentType, body)
+// ParseCancelEventSignupResult parses an HTTP response from a CancelEventSignupWithResponse call
+func ParseCancelEventSignupResult(rsp *http.Response) (*CancelEventSignupResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &CancelEventSignupResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) ContactUs(ctx context.Context, body ContactUsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewContactUsRequest(c.Server, body)
+// ParseGetFamilyResult parses an HTTP response from a GetFamilyWithResponse call
+func ParseGetFamilyResult(rsp *http.Response) (*GetFamilyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &GetFamilyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
-}
 
-// NewContactUsRequest calls the generic ContactUs builder with application/json body
-func NewContactUsRequest(server string, body ContactUsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Family
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewContactUsRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewContactUsRequestWithBody generates requests for ContactUs with any type of body
-func NewContactUsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	return response, nil
+}
 
-	serverURL, err := url.Parse(server)
+// ParseDeleteParentSessionResult parses an HTTP response from a DeleteParentSessionWithResponse call
+func ParseDeleteParentSessionResult(rsp *http.Response) (*DeleteParentSessionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/contact-us")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &DeleteParentSessionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	return response, nil
+}
+
+// ParseCreateJoinRequestResult parses an HTTP response from a CreateJoinRequestWithResponse call
+func ParseCreateJoinRequestResult(rsp *http.Response) (*CreateJoinRequestResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	response := &CreateJoinRequestResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest JoinRequestResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON500 = &dest
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return response, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// ParseCreateParentLoginResult parses an HTTP response from a CreateParentLoginWithResponse call
+func ParseCreateParentLoginResult(rsp *http.Response) (*CreateParentLoginResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	response := &CreateParentLoginResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ContactUsWithBodyWithResponse request with any body
-	ContactUsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ContactUsResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
-	ContactUsWithResponse(ctx context.Context, body ContactUsJSONRequestBody, reqEditors ...RequestEditorFn) (*ContactUsResponse, error)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
 
-type ContactUsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON422      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ContactUsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ContactUsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// ContactUsWithBodyWithResponse request with arbitrary body returning *ContactUsResponse
-func (c *ClientWithResponses) ContactUsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ContactUsResponse, error) {
-	rsp, err := c.ContactUsWithBody(ctx, contentType, body, reqEditors...)
+// ParseCreateParentSessionResult parses an HTTP response from a CreateParentSessionWithResponse call
+func ParseCreateParentSessionResult(rsp *http.Response) (*CreateParentSessionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseContactUsResponse(rsp)
+
+	response := &CreateParentSessionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Parent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ContactUsWithResponse(ctx context.Context, body ContactUsJSONRequestBody, reqEditors ...RequestEditorFn) (*ContactUsResponse, error) {
-	rsp, err := c.ContactUs(ctx, body, reqEditors...)
+// ParseRespondToPlaceOfferResult parses an HTTP response from a RespondToPlaceOfferWithResponse call
+func ParseRespondToPlaceOfferResult(rsp *http.Response) (*RespondToPlaceOfferResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseContactUsResponse(rsp)
+
+	response := &RespondToPlaceOfferResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PlaceOffer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseContactUsResponse parses an HTTP response from a ContactUsWithResponse call
-func ParseContactUsResponse(rsp *http.Response) (*ContactUsResponse, error) {
+// ParseListUnitsResult parses an HTTP response from a ListUnitsWithResponse call
+func ParseListUnitsResult(rsp *http.Response) (*ListUnitsResult, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ContactUsResponse{
+	response := &ListUnitsResult{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ErrorResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListUnitsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
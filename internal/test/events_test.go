@@ -0,0 +1,127 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegration_ListUnits(t *testing.T) {
+	h := newHarness(t)
+
+	resp, err := h.ListUnitsWithResponse(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+	var ids []string
+	for _, u := range resp.JSON200.Units {
+		ids = append(ids, u.Id)
+	}
+	assert.Equal(t, []string{"2nd-rainbows", "1st-brownies", "4th-brownies", "1st-guides", "1st-rangers"}, ids)
+}
+
+func TestIntegration_SignUpForEvent(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+	dc := h.admin(t, "dc@"+domain, rest.DistrictCommissioner, nil)
+
+	start := time.Now().AddDate(0, 1, 0).Truncate(time.Second)
+	capacity := 1
+	created, err := h.AdminCreateEventWithResponse(ctx, AdminCreateEventJSONRequestBody{
+		Title:   "Summer Camp",
+		Start:   start,
+		End:     start.Add(48 * time.Hour),
+		Status:  EventStatusApproved,
+		Signups: &EventSignupSettings{Capacity: &capacity, Deadline: start.Add(-24 * time.Hour)},
+	}, dc)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode(), string(created.Body))
+	event := created.JSON201.Id
+
+	events, err := h.ListEventsWithResponse(ctx, &ListEventsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, events.StatusCode(), string(events.Body))
+	require.Len(t, events.JSON200.Events, 1)
+	assert.Equal(t, "Summer Camp", events.JSON200.Events[0].Title)
+
+	signup := SignUpForEventJSONRequestBody{
+		CaptchaToken:    passingCaptcha,
+		ContactEmail:    "parent@example.org",
+		ContactName:     "Parent",
+		DateOfBirth:     openapi_types.Date{Time: start.AddDate(-8, 0, 0)},
+		ParticipantName: "Ada",
+		Section:         Brownies,
+	}
+
+	t.Run("the first sign-up is confirmed", func(t *testing.T) {
+		resp, err := h.SignUpForEventWithResponse(ctx, event, signup)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode(), string(resp.Body))
+		assert.Equal(t, SignupStatusConfirmed, resp.JSON201.Status)
+
+		sent := h.email.Sent()
+		require.Len(t, sent, 1)
+		assert.Equal(t, "parent@example.org", sent[0].To)
+		assert.Equal(t, "event-signup-confirmed", sent[0].Subject)
+	})
+
+	t.Run("once full, sign-ups join the waiting list", func(t *testing.T) {
+		resp, err := h.SignUpForEventWithResponse(ctx, event, signup)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode(), string(resp.Body))
+		assert.Equal(t, SignupStatusWaitlisted, resp.JSON201.Status)
+	})
+
+	t.Run("the captcha has to pass", func(t *testing.T) {
+		failing := signup
+		failing.CaptchaToken = "failing"
+
+		resp, err := h.SignUpForEventWithResponse(ctx, event, failing)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode())
+	})
+
+	t.Run("admins see every sign-up", func(t *testing.T) {
+		resp, err := h.AdminListEventSignupsWithResponse(ctx, event, dc)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+		assert.Len(t, resp.JSON200.Signups, 2)
+	})
+}
+
+func TestIntegration_AdminSignIn(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	t.Run("without a token", func(t *testing.T) {
+		resp, err := h.AdminGetMeWithResponse(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+
+	t.Run("without a role", func(t *testing.T) {
+		resp, err := h.AdminGetMeWithResponse(ctx, h.signIn("new@"+domain))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("from outside the domain", func(t *testing.T) {
+		resp, err := h.AdminGetMeWithResponse(ctx, h.admin(t, "someone@example.org", rest.Helper, nil))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+
+	t.Run("with a role", func(t *testing.T) {
+		unit := "1st-brownies"
+		resp, err := h.AdminGetMeWithResponse(ctx, h.admin(t, "leader@"+domain, rest.UnitLeader, &unit))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+		assert.Equal(t, openapi_types.Email("leader@"+domain), resp.JSON200.Email)
+	})
+}
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/girlguidingstaplehurst/district/internal/consts"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+)
+
+// passingCaptcha is the only captcha token fakeCaptcha accepts.
+const passingCaptcha = "passing"
+
+type fakeCaptcha struct{}
+
+func (fakeCaptcha) Verify(_ context.Context, token, _ string) error {
+	if token != passingCaptcha {
+		return errors.New("captcha failed")
+	}
+
+	return nil
+}
+
+// fakeContent has every email template, with the template's key as its subject.
+type fakeContent struct{}
+
+func (fakeContent) EmailTemplate(_ context.Context, key string, vars map[string]any) (rest.EmailContent, error) {
+	return rest.EmailContent{Subject: key, Body: fmt.Sprint(vars)}, nil
+}
+
+type sentEmail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// fakeMailer keeps the emails sent, rather than sending them.
+type fakeMailer struct {
+	mu   sync.Mutex
+	sent []sentEmail
+}
+
+func (m *fakeMailer) Send(_ context.Context, to, subject, body string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sent = append(m.sent, sentEmail{To: to, Subject: subject, Body: body})
+
+	return nil
+}
+
+func (m *fakeMailer) Sent() []sentEmail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]sentEmail(nil), m.sent...)
+}
+
+// fakeTokens accepts an admin's email as their ID token, once they've signed in. Their hosted domain is their email's.
+type fakeTokens struct {
+	mu     sync.Mutex
+	emails map[string]bool
+}
+
+func (f *fakeTokens) signIn(email string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.emails == nil {
+		f.emails = map[string]bool{}
+	}
+	f.emails[email] = true
+}
+
+func (f *fakeTokens) Verify(_ context.Context, token string) (rest.Identity, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.emails[token] {
+		return rest.Identity{}, consts.ErrInvalidToken
+	}
+
+	_, domain, _ := strings.Cut(token, "@")
+
+	return rest.Identity{
+		Issuer:       "https://accounts.google.com",
+		Subject:      token,
+		Email:        token,
+		HostedDomain: domain,
+		Expiry:       time.Now().Add(time.Hour),
+	}, nil
+}
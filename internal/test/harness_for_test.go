@@ -0,0 +1,140 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/girlguidingstaplehurst/district/internal/config"
+	"github.com/girlguidingstaplehurst/district/internal/database/memdb"
+	"github.com/girlguidingstaplehurst/district/internal/rest"
+	"github.com/girlguidingstaplehurst/district/internal/service"
+	"github.com/gofiber/fiber/v2"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// domain is the Google Workspace domain whose admins can sign in.
+	domain = "example.com"
+
+	testEncryptionKeys = "test:MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+)
+
+// harness is the whole service, built the same way as when it's deployed, but in memory. Requests go through the
+// generated client and every middleware, and reach fakes instead of Postgres, Contentful, SMTP, the captcha and Google.
+type harness struct {
+	*ClientWithResponses
+	db     *memdb.Database
+	email  *fakeMailer
+	tokens *fakeTokens
+}
+
+// newHarness starts a new service for the test, so nothing a test does is seen by any other.
+func newHarness(t *testing.T) *harness {
+	t.Helper()
+
+	cfg := loadConfig(t, "")
+	cfg.Auth.Domains = []string{domain}
+	cfg.Auth.AllowList = nil
+	cfg.Encryption.KeyID = "test"
+	cfg.Encryption.Keys = testEncryptionKeys
+
+	return startHarness(t, cfg, nil)
+}
+
+// newWatchedHarness starts a new service configured from the YAML file at path, which must be valid. Changes to the
+// file are applied when the watcher returned checks it, as they are when deployed.
+func newWatchedHarness(t *testing.T, path string) (*harness, *config.Watcher) {
+	t.Helper()
+
+	cfg := loadConfig(t, path)
+	require.NoError(t, cfg.Validate())
+
+	watcher, err := config.NewWatcher(path, cfg)
+	require.NoError(t, err)
+
+	return startHarness(t, cfg, watcher), watcher
+}
+
+// loadConfig loads the config the way the service does, from the defaults and the YAML file at path if there is one,
+// but not from the environment, so the tests don't change with the BOOKING_ variables of whoever runs them.
+func loadConfig(t *testing.T, path string) *config.Config {
+	t.Helper()
+
+	for _, kv := range os.Environ() {
+		if name, _, _ := strings.Cut(kv, "="); strings.HasPrefix(name, config.EnvPrefix) {
+			// Setenv restores the variable after the test, but an empty variable would still be loaded.
+			t.Setenv(name, "")
+			require.NoError(t, os.Unsetenv(name))
+		}
+	}
+
+	cfg := new(config.Config)
+	require.NoError(t, config.Load(cfg, path))
+
+	return cfg
+}
+
+func startHarness(t *testing.T, cfg *config.Config, watcher *config.Watcher) *harness {
+	t.Helper()
+
+	h := &harness{
+		db:     memdb.New(),
+		email:  &fakeMailer{},
+		tokens: &fakeTokens{},
+	}
+
+	app, lc, err := service.NewBuilder(cfg).
+		WithDatabase(h.db).
+		WithCaptcha(fakeCaptcha{}).
+		WithContent(fakeContent{}).
+		WithEmail(h.email).
+		WithTokenVerifier(h.tokens).
+		WithWatcher(watcher).
+		Build(context.Background())
+	require.NoError(t, err)
+
+	lc.Start(context.Background())
+	t.Cleanup(func() { require.NoError(t, lc.Stop(context.Background())) })
+
+	h.ClientWithResponses, err = NewClientWithResponses("http://district.test", WithHTTPClient(appDoer{app}))
+	require.NoError(t, err)
+
+	return h
+}
+
+// admin signs in the admin with the role, returning what adds their ID token to requests.
+func (h *harness) admin(t *testing.T, email string, role rest.RoleName, unit *string) RequestEditorFn {
+	t.Helper()
+
+	_, err := h.db.AddRoleAssignment(context.Background(), rest.RoleAssignmentInput{
+		Email: openapi_types.Email(email),
+		Role:  role,
+		Unit:  unit,
+	}, "test")
+	require.NoError(t, err)
+
+	return h.signIn(email)
+}
+
+// signIn signs in the account, whatever its roles, returning what adds its ID token to requests.
+func (h *harness) signIn(email string) RequestEditorFn {
+	h.tokens.signIn(email)
+
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set(fiber.HeaderAuthorization, "Bearer "+email)
+		return nil
+	}
+}
+
+// appDoer sends requests straight to the app, without a network.
+type appDoer struct {
+	app *fiber.App
+}
+
+func (d appDoer) Do(req *http.Request) (*http.Response, error) {
+	return d.app.Test(req, -1)
+}
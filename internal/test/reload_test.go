@@ -0,0 +1,72 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// watchedYAML is a complete config for the harness, with the join request limit to fill in.
+const watchedYAML = `auth:
+  domains: [` + domain + `]
+  googleclientid: district-admin
+captcha:
+  armed: false
+content:
+  url: https://content.district.test
+  token: content-token
+database:
+  url: postgres://district.test/district
+smtp:
+  server: smtp.district.test
+  username: district
+  password: smtp-password
+encryption:
+  keyid: test
+  keys: ` + testEncryptionKeys + `
+ratelimits:
+  joinrequests:
+    limit: %d
+`
+
+func TestIntegration_ReloadRateLimits(t *testing.T) {
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	setLimit := func(limit int) {
+		require.NoError(t, os.WriteFile(path, fmt.Appendf(nil, watchedYAML, limit), 0o600))
+	}
+
+	setLimit(1)
+	h, watcher := newWatchedHarness(t, path)
+
+	join := func(child string) int {
+		resp, err := h.CreateJoinRequestWithResponse(ctx, CreateJoinRequestJSONRequestBody{
+			CaptchaToken:   passingCaptcha,
+			ChildName:      child,
+			DateOfBirth:    openapi_types.Date{Time: time.Now().AddDate(-8, 0, 0)},
+			ParentEmail:    "parent@example.org",
+			ParentName:     "Parent",
+			Postcode:       "TN12 0AA",
+			PreferredUnits: []string{"1st-brownies"},
+		})
+		require.NoError(t, err)
+		return resp.StatusCode()
+	}
+
+	assert.Equal(t, http.StatusCreated, join("Ada"))
+	assert.Equal(t, http.StatusTooManyRequests, join("Grace"))
+
+	setLimit(3)
+	require.NoError(t, watcher.Check(ctx))
+
+	assert.Equal(t, http.StatusCreated, join("Grace"), "the raised limit applies without a restart")
+}
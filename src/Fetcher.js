@@ -1,8 +1,9 @@
 export async function Fetcher(url, dummyData, headers = {}) {
   try {
-    const response = await fetch(url, {headers: headers});
+    const response = await fetch(url, {headers: headers, credentials: "same-origin"});
     if (response.status === 401) {
       sessionStorage.removeItem("token");
+      sessionStorage.removeItem("csrfToken");
       window.location.reload();
       return dummyData;
     }
@@ -16,7 +17,49 @@ export async function Fetcher(url, dummyData, headers = {}) {
   }
 }
 
+// AdminSignIn swaps the Google ID token for a session cookie, so the token doesn't need to be kept, and the sign in
+// lasts while the admin site is in use rather than until the token expires.
+export async function AdminSignIn(idToken) {
+  let response;
+  try {
+    response = await fetch("/api/v1/admin/session", {
+      method: "POST",
+      headers: {Authorization: "Bearer " + idToken},
+      credentials: "same-origin",
+    });
+  } catch (error) {
+    console.log("signing in failed", error);
+    return false;
+  }
+  if (!response.ok) {
+    return false;
+  }
+
+  const me = await response.json();
+  sessionStorage.setItem("csrfToken", me.csrfToken);
+  return true;
+}
+
+export async function AdminSignOut() {
+  await fetch("/api/v1/admin/session", {
+    method: "DELETE",
+    headers: {"X-CSRF-Token": sessionStorage.getItem("csrfToken") ?? ""},
+    credentials: "same-origin",
+  });
+  sessionStorage.removeItem("csrfToken");
+}
+
 export async function AdminFetcher(url, dummyData) {
+  // Sign ins from before sessions, or that haven't been swapped yet, leave the ID token here.
   const token = JSON.parse(sessionStorage.getItem("token"));
-  return Fetcher(url, dummyData, {Authorization: "Bearer " + token})
-}
\ No newline at end of file
+  if (token && !sessionStorage.getItem("csrfToken")) {
+    if (!await AdminSignIn(token)) {
+      // Without a session, the ID token is sent as before. It's still accepted, and a rejection is reported as it is
+      // rather than as a missing sign in.
+      return Fetcher(url, dummyData, {Authorization: "Bearer " + token});
+    }
+    sessionStorage.removeItem("token");
+  }
+
+  return Fetcher(url, dummyData, {"X-CSRF-Token": sessionStorage.getItem("csrfToken") ?? ""})
+}